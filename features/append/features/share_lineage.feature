@@ -0,0 +1,20 @@
+Feature: append a branch using the lineage that teammates shared
+
+  Background:
+    Given global Git Town setting "share-lineage" is "true"
+    And a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    And I ran "git-town sync"
+    And a coworker clones the repository
+    And the coworker is on the "alpha" branch
+    And the coworker is on the "beta" branch
+
+  Scenario: result
+    When the coworker runs "git-town append gamma"
+    Then it prints:
+      """
+      branch "alpha" now has parent "main" from the shared lineage
+      branch "beta" now has parent "alpha" from the shared lineage
+      """
+    And it does not print "Please select the parent"
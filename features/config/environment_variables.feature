@@ -0,0 +1,53 @@
+Feature: override settings through environment variables
+
+  Background:
+    Given local Git Town setting "sync-feature-strategy" is "merge"
+    And local Git Town setting "push-hook" is "true"
+
+  Scenario: environment variables override the Git configuration
+    When I run "git-town config" with these environment variables:
+      | NAME                           | VALUE  |
+      | GIT_TOWN_SYNC_FEATURE_STRATEGY | rebase |
+      | GIT_TOWN_PUSH_HOOK             | false  |
+      | GIT_TOWN_OFFLINE               | 1      |
+    Then it prints:
+      """
+      1. environment variables (except aliases): GIT_TOWN_OFFLINE, GIT_TOWN_PUSH_HOOK, GIT_TOWN_SYNC_FEATURE_STRATEGY
+      """
+    And it prints:
+      """
+        offline: yes
+        run pre-push hook: no
+      """
+    And it prints:
+      """
+        sync-feature strategy: rebase
+      """
+    And local Git Town setting "push-hook" is still "true"
+
+  Scenario: show where the values come from
+    When I run "git-town config --show-origin" with these environment variables:
+      | NAME                           | VALUE  |
+      | GIT_TOWN_SYNC_FEATURE_STRATEGY | rebase |
+    Then it prints:
+      """
+        sync-feature-strategy: rebase
+          env: rebase
+          local: merge (overridden)
+      """
+
+  Scenario: no environment variables
+    When I run "git-town config"
+    Then it prints:
+      """
+      1. environment variables (except aliases): (none)
+      """
+
+  Scenario: invalid value
+    When I run "git-town config" with these environment variables:
+      | NAME                           | VALUE |
+      | GIT_TOWN_SYNC_FEATURE_STRATEGY | zonk  |
+    Then it prints the error:
+      """
+      environment variable GIT_TOWN_SYNC_FEATURE_STRATEGY contains an invalid value: unknown sync-feature strategy: "zonk"
+      """
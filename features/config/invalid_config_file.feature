@@ -8,5 +8,36 @@ Feature: print nice error message for invalid config file
     When I run "git-town config"
     Then it prints the error:
       """
-      the configuration file ".git-branches.yml" does not contain TOML-formatted content
+      the configuration file .git-branches.toml contains 1 problem(s):
+        .git-branches.toml:1:19: unexpected EOF; expected value
+      """
+
+  Scenario: Config file with unknown keys and wrong types
+    Given the configuration file:
+      """
+      push-hook = "yes"
+
+      [branches]
+      main = "main"
+      mian = "main"
+      """
+    When I run "git-town config"
+    Then it prints the error:
+      """
+      the configuration file .git-branches.toml contains 1 problem(s):
+        .git-branches.toml: "push-hook" must be of type boolean, not string
+      """
+
+  Scenario: Config file with unknown keys
+    Given the configuration file:
+      """
+      [branches]
+      main = "main"
+      mian = "main"
+      """
+    When I run "git-town config"
+    Then it prints:
+      """
+      the configuration file .git-branches.toml contains 1 unknown key(s), Git Town ignores them:
+        .git-branches.toml: unknown key "branches.mian"
       """
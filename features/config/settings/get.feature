@@ -0,0 +1,59 @@
+Feature: display individual settings
+
+  Background:
+    Given global Git Town setting "sync-feature-strategy" is "rebase"
+    And the configuration file:
+      """
+      push-hook = false
+
+      [sync-strategy]
+      feature-branches = "merge"
+      """
+
+  Scenario: effective value
+    When I run "git-town config get sync-feature-strategy"
+    Then it prints:
+      """
+      rebase
+      """
+
+  Scenario: value in a specific location
+    When I run "git-town config get sync-feature-strategy --file"
+    Then it prints:
+      """
+      merge
+      """
+
+  Scenario: value as JSON
+    When I run "git-town config get push-hook --json"
+    Then it prints:
+      """
+      {
+        "source": "file",
+        "value": "false"
+      }
+      """
+
+  Scenario: default value
+    When I run "git-town config get sync-upstream --json"
+    Then it prints:
+      """
+      {
+        "source": "default",
+        "value": "true"
+      }
+      """
+
+  Scenario: setting not set in the given location
+    When I run "git-town config get push-hook --local"
+    Then it prints the error:
+      """
+      setting "push-hook" is not set in the local configuration
+      """
+
+  Scenario: unknown setting
+    When I run "git-town config get zonk"
+    Then it prints the error:
+      """
+      unknown setting "zonk"
+      """
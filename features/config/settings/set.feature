@@ -0,0 +1,71 @@
+Feature: change individual settings
+
+  Scenario: local Git configuration
+    When I run "git-town config set sync-feature-strategy rebase"
+    Then it runs the commands
+      | COMMAND                                          |
+      | git config git-town.sync-feature-strategy rebase |
+    And local Git Town setting "sync-feature-strategy" is now "rebase"
+
+  Scenario: global Git configuration
+    When I run "git-town config set sync-upstream no --global"
+    Then it runs the commands
+      | COMMAND                                       |
+      | git config --global git-town.sync-upstream no |
+    And global Git Town setting "sync-upstream" is now "false"
+
+  Scenario: configuration file
+    When I run "git-town config set perennial-branches 'qa staging' --file"
+    Then it prints:
+      """
+      setting "perennial-branches" is now "qa staging" in .git-branches.toml
+      """
+    And the configuration file is now:
+      """
+      # Git Town configuration file
+
+      [branches]
+      perennials = ["qa", "staging"]
+      """
+
+  Scenario: existing configuration file
+    Given the configuration file:
+      """
+      # The branches that Git Town syncs with the main branch
+      [branches]
+      main = "main"
+
+      # Perennial branches
+      # perennials = []
+      """
+    When I run "git-town config set perennial-branches 'qa staging' --file"
+    Then the configuration file is now:
+      """
+      # The branches that Git Town syncs with the main branch
+      [branches]
+      main = "main"
+
+      # Perennial branches
+      perennials = ["qa", "staging"]
+      """
+
+  Scenario: invalid value
+    When I run "git-town config set sync-feature-strategy zonk"
+    Then it runs no commands
+    And it prints the error:
+      """
+      unknown sync-feature strategy: "zonk"
+      """
+
+  Scenario: setting that the configuration file doesn't support
+    When I run "git-town config set github-token 123456 --file"
+    Then it prints the error:
+      """
+      the configuration file does not support setting "git-town.github-token"
+      """
+    And still no configuration file exists
+
+  Scenario: undo
+    Given I ran "git-town config set sync-feature-strategy rebase"
+    When I run "git-town undo"
+    Then local Git Town setting "sync-feature-strategy" now doesn't exist
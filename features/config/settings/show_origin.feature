@@ -0,0 +1,81 @@
+Feature: display where each setting comes from
+
+  Background:
+    Given global Git Town setting "sync-feature-strategy" is "rebase"
+    And local Git Town setting "sync-feature-strategy" is "merge"
+    And local Git Town setting "perennial-branches" is "qa"
+    And the configuration file:
+      """
+      push-hook = false
+
+      [branches]
+      perennials = [ "staging" ]
+
+      [sync-strategy]
+      feature-branches = "rebase"
+      """
+
+  Scenario: text output
+    When I run "git-town config --show-origin"
+    Then it prints:
+      """
+        perennial-branches: staging qa
+          local: qa
+          file: staging
+      """
+    And it prints:
+      """
+        push-hook: false
+          file: false
+      """
+    And it prints:
+      """
+        sync-feature-strategy: merge
+          local: merge
+          global: rebase (overridden)
+          file: rebase (overridden)
+      """
+    And it prints:
+      """
+        sync-upstream: true
+          default: true
+      """
+
+  Scenario: lineage and branch-specific settings
+    Given the current branch is a feature branch "feature"
+    And branch "feature" has the sync strategy "rebase"
+    When I run "git-town config --show-origin"
+    Then it prints:
+      """
+        git-town-branch.feature.parent: main
+          local: main
+        git-town-branch.feature.sync-strategy: rebase
+          local: rebase
+      """
+
+  Scenario: JSON output
+    When I run "git-town config --show-origin --json"
+    Then it prints:
+      """
+          "sync-feature-strategy": {
+            "origins": [
+              {
+                "active": true,
+                "source": "local",
+                "value": "merge"
+              },
+              {
+                "active": false,
+                "source": "global",
+                "value": "rebase"
+              },
+              {
+                "active": false,
+                "source": "file",
+                "value": "rebase"
+              }
+            ],
+            "source": "local",
+            "value": "merge"
+          },
+      """
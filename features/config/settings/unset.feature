@@ -0,0 +1,39 @@
+Feature: remove individual settings
+
+  Scenario: local Git configuration
+    Given local Git Town setting "sync-feature-strategy" is "rebase"
+    When I run "git-town config unset sync-feature-strategy"
+    Then it runs the commands
+      | COMMAND                                           |
+      | git config --unset git-town.sync-feature-strategy |
+    And local Git Town setting "sync-feature-strategy" now doesn't exist
+
+  Scenario: configuration file
+    Given the configuration file:
+      """
+      push-hook = false
+      sync-upstream = false
+      """
+    When I run "git-town config unset push-hook --file"
+    Then it prints:
+      """
+      removed setting "push-hook" from .git-branches.toml
+      """
+    And the configuration file is now:
+      """
+      sync-upstream = false
+      """
+
+  Scenario: setting that isn't set
+    When I run "git-town config unset offline --global"
+    Then it runs no commands
+    And it prints the error:
+      """
+      setting "offline" is not set in the global configuration
+      """
+
+  Scenario: undo
+    Given local Git Town setting "sync-feature-strategy" is "rebase"
+    And I ran "git-town config unset sync-feature-strategy"
+    When I run "git-town undo"
+    Then local Git Town setting "sync-feature-strategy" is now "rebase"
@@ -9,6 +9,9 @@ Feature: override an existing Git alias
       | main development branch     | enter   |
       | perennial branches          | enter   |
       | perennial regex             | enter   |
+      | contribution regex          | enter   |
+      | observed regex              | enter   |
+      | parked regex                | enter   |
       | hosting platform            | enter   |
       | origin hostname             | enter   |
       | sync-feature-strategy       | enter   |
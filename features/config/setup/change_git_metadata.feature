@@ -13,6 +13,9 @@ Feature: change existing information in Git metadata
       | accept the already configured main branch | enter                  |
       | change the perennial branches             | space down space enter |
       | enter a perennial regex                   | 3 3 6 6 enter          |
+      | contribution regex                        | enter                  |
+      | observed regex                            | enter                  |
+      | parked regex                              | enter                  |
       | set github as hosting service             | up up enter            |
       | github token                              | 1 2 3 4 5 6 enter      |
       | origin hostname                           | c o d e enter          |
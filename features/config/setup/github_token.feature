@@ -9,6 +9,9 @@ Feature: enter the GitHub API token
       | main development branch       | enter             |                                             |
       | perennial branches            |                   | no input here since the dialog doesn't show |
       | perennial regex               | enter             |                                             |
+      | contribution regex            | enter             |                                             |
+      | observed regex                | enter             |                                             |
+      | parked regex                  | enter             |                                             |
       | hosting platform: auto-detect | enter             |                                             |
       | github token                  | 1 2 3 4 5 6 enter |                                             |
       | origin hostname               | enter             |                                             |
@@ -34,6 +37,9 @@ Feature: enter the GitHub API token
       | main development branch     | enter                |                                             |
       | perennial branches          |                      | no input here since the dialog doesn't show |
       | perennial regex             | enter                |                                             |
+      | contribution regex          | enter                |                                             |
+      | observed regex              | enter                |                                             |
+      | parked regex                | enter                |                                             |
       | hosting platform            | down down down enter |                                             |
       | github token                | 1 2 3 4 5 6 enter    |                                             |
       | origin hostname             | enter                |                                             |
@@ -9,6 +9,9 @@ Feature: enter the GitLab API token
       | main development branch     | enter             |                                             |
       | perennial branches          |                   | no input here since the dialog doesn't show |
       | perennial regex             | enter             |                                             |
+      | contribution regex          | enter             |                                             |
+      | observed regex              | enter             |                                             |
+      | parked regex                | enter             |                                             |
       | hosting platform            | enter             |                                             |
       | gitlab token                | 1 2 3 4 5 6 enter |                                             |
       | origin hostname             | enter             |                                             |
@@ -34,6 +37,9 @@ Feature: enter the GitLab API token
       | main development branch     | enter             |                                             |
       | perennial branches          |                   | no input here since the dialog doesn't show |
       | perennial regex             | enter             |                                             |
+      | contribution regex          | enter             |                                             |
+      | observed regex              | enter             |                                             |
+      | parked regex                | enter             |                                             |
       | hosting platform            | up enter          |                                             |
       | gitlab token                | 1 2 3 4 5 6 enter |                                             |
       | origin hostname             | enter             |                                             |
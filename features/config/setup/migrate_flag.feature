@@ -0,0 +1,41 @@
+Feature: move the local Git configuration into the configuration file without prompting
+
+  Background:
+    Given local Git Town setting "perennial-branches" is "qa"
+    And local Git Town setting "contribution-branches" is "coworker"
+    And local Git Town setting "ship-message-template" is "{{proposal-title}}"
+    And local Git Town setting "github-token" is "secret"
+    And the configuration file:
+      """
+      [branches]
+      perennials = [ "staging" ]
+      """
+    When I run "git-town config setup --migrate"
+
+  Scenario: result
+    Then it runs the commands
+      | COMMAND                                           |
+      | git config --unset git-town.main-branch           |
+      | git config --unset git-town.perennial-branches    |
+      | git config --unset git-town.ship-message-template |
+    And it prints:
+      """
+      migrated 3 settings into .git-branches.toml
+      """
+    And the configuration file is now:
+      """
+      ship-message-template = "{{proposal-title}}"
+
+      [branches]
+      perennials = ["staging", "qa"]
+      main = "main"
+      """
+    And the main branch is now not set
+    And local Git Town setting "perennial-branches" now doesn't exist
+    And branch "coworker" is still a contribution branch
+    And local Git Town setting "github-token" is now "secret"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then local Git Town setting "perennial-branches" is now "qa"
+    And local Git Town setting "main-branch" is now "main"
@@ -0,0 +1,75 @@
+Feature: set up Git Town without prompting
+
+  Background:
+    Given the branches "qa" and "staging"
+
+  Scenario: accept all detected values
+    When I run "git-town config setup --yes"
+    Then it runs no commands
+    And the configuration file now contains:
+      """
+      main = "main"
+      """
+    And the configuration file now contains:
+      """
+      perennials = []
+      """
+
+  Scenario: answers through flags
+    When I run "git-town config setup --set config-storage=git --set perennial-branches=qa --set sync-feature-strategy=rebase --set aliases=hack,sync"
+    Then it runs the commands
+      | COMMAND                                    |
+      | git config --global alias.hack "town hack" |
+      | git config --global alias.sync "town sync" |
+    And local Git Town setting "main-branch" is now "main"
+    And local Git Town setting "perennial-branches" is now "qa"
+    And local Git Town setting "sync-feature-strategy" is now "rebase"
+    And still no configuration file exists
+
+  Scenario: answers file
+    Given a file "answers.json" with content:
+      """
+      {
+        "config-storage": "git",
+        "perennial-branches": ["qa", "staging"],
+        "sync-upstream": true
+      }
+      """
+    When I run "git-town config setup --answers answers.json --set sync-upstream=false"
+    Then it runs no commands
+    And local Git Town setting "perennial-branches" is now "qa staging"
+    And local Git Town setting "sync-upstream" is now "false"
+
+  Scenario: global settings don't become part of the configuration file
+    Given global Git Town setting "sync-feature-strategy" is "rebase"
+    And global Git Town setting "push-hook" is "false"
+    When I run "git-town config setup --yes"
+    Then the configuration file now contains:
+      """
+      push-hook = true
+      """
+    And the configuration file now contains:
+      """
+      feature-branches = "merge"
+      """
+
+  Scenario: global settings don't become part of the local Git configuration
+    Given global Git Town setting "sync-feature-strategy" is "rebase"
+    When I run "git-town config setup --set config-storage=git"
+    Then local Git Town setting "sync-feature-strategy" still doesn't exist
+    And local Git Town setting "main-branch" is now "main"
+
+  Scenario: unsupported setting
+    When I run "git-town config setup --set offline=true"
+    Then it prints the error:
+      """
+      the setup assistant doesn't configure setting "offline", please use "git town config set" instead
+      """
+    And still no configuration file exists
+
+  Scenario: main branch doesn't exist
+    When I run "git-town config setup --set main-branch=zonk"
+    Then it prints the error:
+      """
+      the main branch "zonk" doesn't exist
+      """
@@ -34,6 +34,9 @@ Feature: remove existing configuration in Git metadata
       | keep the already configured main branch | enter                                         |
       | change the perennial branches           | space down space enter                        |
       | remove the perennial regex              | backspace backspace backspace backspace enter |
+      | contribution regex                      | enter                                         |
+      | observed regex                          | enter                                         |
+      | parked regex                            | enter                                         |
       | remove hosting service override         | up up up enter                                |
       | remove origin hostname                  | backspace backspace backspace backspace enter |
       | sync-feature-strategy                   | down enter                                    |
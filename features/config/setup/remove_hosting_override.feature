@@ -9,6 +9,9 @@ Feature: remove an existing code hosting override
       | main development branch     | down enter     |                                             |
       | perennial branches          |                | no input here since the dialog doesn't show |
       | perennial regex             | enter          |                                             |
+      | contribution regex          | enter          |                                             |
+      | observed regex              | enter          |                                             |
+      | parked regex                | enter          |                                             |
       | hosting platform            | up up up enter |                                             |
       | origin hostname             | enter          |                                             |
       | sync-feature-strategy       | enter          |                                             |
@@ -9,6 +9,9 @@ Feature: don't ask for perennial branches if no branches that could be perennial
       | main development branch     | down enter |                                             |
       | perennial branches          |            | no input here since the dialog doesn't show |
       | perennial regex             | enter      |                                             |
+      | contribution regex          | enter      |                                             |
+      | observed regex              | enter      |                                             |
+      | parked regex                | enter      |                                             |
       | hosting platform            | enter      |                                             |
       | origin hostname             | enter      |                                             |
       | sync-feature-strategy       | enter      |                                             |
@@ -0,0 +1,62 @@
+Feature: personal defaults in the user configuration file
+
+  Background:
+    Given the user configuration file:
+      """
+      push-hook = false
+
+      [sync-strategy]
+      feature-branches = "rebase"
+      perennial-branches = "merge"
+      """
+    And the configuration file:
+      """
+      push-hook = true
+
+      [sync-strategy]
+      feature-branches = "merge"
+      """
+
+  Scenario: the user configuration file overrides the configuration file
+    When I run "git-town config"
+    Then it prints:
+      """
+      4. user configuration file:
+      """
+    And it prints:
+      """
+        run pre-push hook: no
+      """
+    And it prints:
+      """
+        sync-feature strategy: rebase
+        sync-perennial strategy: merge
+      """
+
+  Scenario: the Git configuration overrides the user configuration file
+    Given global Git Town setting "sync-feature-strategy" is "merge"
+    When I run "git-town config --show-origin"
+    Then it prints:
+      """
+        sync-feature-strategy: merge
+          global: merge
+          user: rebase (overridden)
+          file: merge (overridden)
+      """
+    And it prints:
+      """
+        push-hook: false
+          user: false
+          file: true (overridden)
+      """
+
+  Scenario: invalid user configuration file
+    Given the user configuration file:
+      """
+      offline = 1
+      """
+    When I run "git-town config"
+    Then it prints the error:
+      """
+      git-town/config.toml: "offline" must be of type boolean, not integer
+      """
@@ -0,0 +1,57 @@
+Feature: validate the configuration file
+
+  Scenario: valid configuration file
+    Given the configuration file:
+      """
+      push-new-branches = true
+
+      [branches]
+      main = "main"
+      perennials = ["qa"]
+      """
+    When I run "git-town config validate"
+    Then it prints:
+      """
+      The configuration file .git-branches.toml is valid.
+      """
+
+  Scenario: invalid configuration file
+    Given the configuration file:
+      """
+      push-new-branches = "yes"
+      offline = 1
+
+      [branches]
+      main = "main"
+      mian = "main"
+      default-type = "unknown"
+      perennial-regex = "release-("
+
+      [sync-strategy]
+      feature-branches = "squash"
+      """
+    When I run "git-town config validate"
+    Then it prints the error:
+      """
+      the configuration file .git-branches.toml contains 6 problem(s):
+        .git-branches.toml: "push-new-branches" must be of type boolean, not string
+        .git-branches.toml: "offline" must be of type boolean, not integer
+        .git-branches.toml: unknown key "branches.mian"
+        .git-branches.toml: invalid value for "branches.default-type": unknown branch type: "unknown", please use "feature", "contribution", "observed", "parked", or "prototype"
+        .git-branches.toml: invalid value for "branches.perennial-regex": error parsing regexp: missing closing ): `release-(`
+        .git-branches.toml: invalid value for "sync-strategy.feature-branches": unknown sync-feature strategy: "squash"
+      """
+
+  Scenario: no configuration file
+    When I run "git-town config validate"
+    Then it prints the error:
+      """
+      there is no configuration file .git-branches.toml
+      """
+
+  Scenario: print the JSON Schema
+    When I run "git-town config validate --schema"
+    Then it prints:
+      """
+      "$id": "https://www.git-town.com/git-branches.schema.json",
+      """
@@ -5,9 +5,15 @@ Feature: show the configuration
     Given the main branch is "main"
     And the perennial branches are "qa" and "staging"
     And local Git Town setting "perennial-regex" is "release-.*"
+    And local Git Town setting "contribution-regex" is "coworker-.*"
+    And local Git Town setting "observed-regex" is "renovate/.*"
+    And local Git Town setting "parked-regex" is "old-.*"
+    And local Git Town setting "default-branch-type" is "prototype"
+    And local Git Town setting "default-remote-branch-type" is "observed"
     And the observed branches "observed-1" and "observed-2"
     And the contribution branches "contribution-1" and "contribution-2"
     And the parked branches "parked-1" and "parked-2"
+    And the prototype branches "prototype-1" and "prototype-2"
     When I run "git-town config"
     Then it prints:
       """
@@ -16,18 +22,27 @@ Feature: show the configuration
         perennial branches: qa, staging
         perennial regex: release-.*
         parked branches: parked-1, parked-2
+        parked regex: old-.*
+        prototype branches: prototype-1, prototype-2
         contribution branches: contribution-1, contribution-2
+        contribution regex: coworker-.*
         observed branches: observed-1, observed-2
+        observed regex: renovate/.*
+        default branch type: prototype branch
+        default remote branch type: observed branch
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
         ship deletes the tracking branch: yes
+        ship message template: (not set)
+        ticket regex: (not set)
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync with upstream: yes
         sync before shipping: no
+        share lineage: no
 
       Hosting:
         hosting platform override: (not set)
@@ -40,6 +55,7 @@ Feature: show the configuration
     Given the configuration file:
       """
       push-new-branches = true
+      share-lineage = true
       ship-delete-tracking-branch = true
       sync-upstream = true
 
@@ -47,6 +63,11 @@ Feature: show the configuration
       main = "main"
       perennials = [ "public", "staging" ]
       perennial-regex = "release-.*"
+      contribution-regex = "coworker-.*"
+      observed-regex = "renovate/.*"
+      parked-regex = "old-.*"
+      default-type = "parked"
+      default-remote-type = "contribution"
 
       [hosting]
       platform = "github"
@@ -64,18 +85,27 @@ Feature: show the configuration
         perennial branches: public, staging
         perennial regex: release-.*
         parked branches: (none)
+        parked regex: old-.*
+        prototype branches: (none)
         contribution branches: (none)
+        contribution regex: coworker-.*
         observed branches: (none)
+        observed regex: renovate/.*
+        default branch type: parked branch
+        default remote branch type: contribution branch
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: yes
         ship deletes the tracking branch: yes
+        ship message template: (not set)
+        ticket regex: (not set)
         sync-feature strategy: rebase
         sync-perennial strategy: merge
         sync with upstream: yes
         sync before shipping: no
+        share lineage: yes
 
       Hosting:
         hosting platform override: github
@@ -90,6 +120,7 @@ Feature: show the configuration
     And the observed branches "observed-1" and "observed-2"
     And the contribution branches "contribution-1" and "contribution-2"
     And the parked branches "parked-1" and "parked-2"
+    And the prototype branches "prototype-1" and "prototype-2"
     And Git Town setting "perennial-regex" is "git-perennial-.*"
     And Git Town setting "push-new-branches" is "false"
     And Git Town setting "ship-delete-tracking-branch" is "false"
@@ -123,18 +154,27 @@ Feature: show the configuration
         perennial branches: config-perennial-1, config-perennial-2, git-perennial-1, git-perennial-2
         perennial regex: git-perennial-.*
         parked branches: parked-1, parked-2
+        parked regex: (not set)
+        prototype branches: prototype-1, prototype-2
         contribution branches: contribution-1, contribution-2
+        contribution regex: (not set)
         observed branches: observed-1, observed-2
+        observed regex: (not set)
+        default branch type: feature branch
+        default remote branch type: feature branch
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
         ship deletes the tracking branch: no
+        ship message template: (not set)
+        ticket regex: (not set)
         sync-feature strategy: merge
         sync-perennial strategy: merge
         sync with upstream: no
         sync before shipping: no
+        share lineage: no
 
       Hosting:
         hosting platform override: github
@@ -156,18 +196,27 @@ Feature: show the configuration
         perennial branches: qa, staging
         perennial regex: (not set)
         parked branches: (none)
+        parked regex: (not set)
+        prototype branches: (none)
         contribution branches: (none)
+        contribution regex: (not set)
         observed branches: (none)
+        observed regex: (not set)
+        default branch type: feature branch
+        default remote branch type: feature branch
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
         ship deletes the tracking branch: yes
+        ship message template: (not set)
+        ticket regex: (not set)
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync with upstream: yes
         sync before shipping: no
+        share lineage: no
 
       Hosting:
         hosting platform override: (not set)
@@ -195,18 +244,27 @@ Feature: show the configuration
         perennial branches: (none)
         perennial regex: (not set)
         parked branches: (none)
+        parked regex: (not set)
+        prototype branches: (none)
         contribution branches: (none)
+        contribution regex: (not set)
         observed branches: (none)
+        observed regex: (not set)
+        default branch type: feature branch
+        default remote branch type: feature branch
 
       Configuration:
         offline: no
         run pre-push hook: yes
         push new branches: no
         ship deletes the tracking branch: yes
+        ship message template: (not set)
+        ticket regex: (not set)
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync with upstream: yes
         sync before shipping: no
+        share lineage: no
 
       Hosting:
         hosting platform override: (not set)
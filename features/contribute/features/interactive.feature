@@ -0,0 +1,30 @@
+Feature: select the branches to make contribution branches in a dialog
+
+  Background:
+    Given the feature branches "feature-1", "feature-2", and "feature-3"
+    And an uncommitted file
+    When I run "git-town contribute --interactive" and enter into the dialog:
+      | DIALOG                        | KEYS                        |
+      | select branches to contribute | space down down space enter |
+
+  Scenario: result
+    Then it runs no commands
+    And it prints:
+      """
+      branch "feature-3" is now a contribution branch
+      """
+    And branch "feature-1" is now a contribution branch
+    And branch "feature-3" is now a contribution branch
+    And the current branch is still "main"
+    And the uncommitted file still exists
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND       |
+      | main   | git add -A    |
+      |        | git stash     |
+      |        | git stash pop |
+    And there are now no contribution branches
+    And the current branch is still "main"
+    And the uncommitted file still exists
@@ -0,0 +1,64 @@
+Feature: check the repository for problems
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a perennial branch "qa"
+    And Git Town parent setting for branch "qa" is "main"
+    And an observed branch "gamma"
+    And Git Town setting "parked-branches" is "gamma"
+    And I ran "git branch -D alpha"
+    And I ran "git push origin :alpha"
+
+  Scenario: report problems
+    When I run "git-town doctor"
+    Then it runs no commands
+    And it prints:
+      """
+      error: branch "alpha" doesn't exist but is the parent of beta
+      warning: perennial branch "qa" has parent "main"
+      error: branch "gamma" is configured as observed branch and parked branch, Git Town treats it as observed branch
+
+      Found 3 problem(s).
+      Run "git town doctor --fix" to repair 3 of them.
+      """
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
+      | qa     | main   |
+
+  Scenario: fix problems
+    When I run "git-town doctor --fix"
+    Then it prints:
+      """
+      Repairing 3 of them.
+      """
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | beta   | main   |
+    And branch "gamma" is still observed
+    And local Git Town setting "parked-branches" now doesn't exist
+    When I run "git-town doctor"
+    Then it prints:
+      """
+      No problems found.
+      """
+
+  Scenario: undo the fixes
+    Given I ran "git-town doctor --fix"
+    When I run "git-town undo"
+    Then this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
+      | qa     | main   |
+    And branch "gamma" is now parked
+
+  Scenario: healthy repository
+    Given I ran "git-town doctor --fix"
+    When I run "git-town doctor --fix"
+    Then it prints:
+      """
+      No problems found.
+      """
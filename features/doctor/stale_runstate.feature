@@ -0,0 +1,37 @@
+Feature: repair the runstate of an unfinished command whose branch no longer exists
+
+  Background:
+    Given a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME        | FILE CONTENT    |
+      | main    | local    | conflicting main commit    | conflicting_file | main content    |
+      | feature | local    | conflicting feature commit | conflicting_file | feature content |
+    And the current branch is "feature"
+    And I ran "git-town sync"
+    And I ran "git merge --abort"
+    And I ran "git checkout main"
+    And I ran "git branch -D feature"
+    And I ran "git push origin :feature"
+    And I ran "git config --unset git-town-branch.feature.parent"
+
+  Scenario: report the problem
+    When I run "git-town doctor"
+    Then it prints:
+      """
+      error: the unfinished "sync" command ended on branch "feature" which doesn't exist anymore
+
+      Found 1 problem(s).
+      Run "git town doctor --fix" to repair 1 of them.
+      """
+
+  Scenario: fix the problem
+    When I run "git-town doctor --fix"
+    Then it prints:
+      """
+      Repairing 1 of them.
+      """
+    When I run "git-town doctor"
+    Then it prints:
+      """
+      No problems found.
+      """
@@ -0,0 +1,16 @@
+Feature: cannot create a prototype branch that already exists
+
+  Background:
+    Given the current branch is a feature branch "existing"
+    When I run "git-town hack --prototype existing"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH   | COMMAND                  |
+      | existing | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "existing" already exists, run "git town prototype existing" to make it a prototype branch
+      """
+    And the current branch is still "existing"
+    And there are still no prototype branches
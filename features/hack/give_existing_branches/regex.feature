@@ -0,0 +1,21 @@
+Feature: cannot make branches matching a branch type regex feature branches
+
+  Background:
+    Given a branch "renovate/lodash" with a tracking branch
+    And local Git Town setting "observed-regex" is "^renovate/"
+    When I run "git-town hack renovate/lodash"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "renovate/lodash" matches the contribution-regex, observed-regex, or parked-regex setting and therefore cannot be a feature branch
+      """
+    And the current branch is still "main"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "main"
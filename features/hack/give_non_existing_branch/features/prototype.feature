@@ -0,0 +1,46 @@
+Feature: create a new prototype branch
+
+  Background:
+    Given Git Town setting "push-new-branches" is "true"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE     |
+      | main   | origin   | main commit |
+    And the current branch is "main"
+    And an uncommitted file
+    When I run "git-town hack --prototype new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+      |        | git add -A               |
+      |        | git stash                |
+      |        | git rebase origin/main   |
+      |        | git branch new main      |
+      |        | git checkout new         |
+      | new    | git stash pop            |
+    And the current branch is now "new"
+    And branch "new" is now a prototype branch
+    And the uncommitted file still exists
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE     |
+      | main   | local, origin | main commit |
+      | new    | local         | main commit |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | new    | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                     |
+      | new    | git add -A                                  |
+      |        | git stash                                   |
+      |        | git checkout main                           |
+      | main   | git reset --hard {{ sha 'initial commit' }} |
+      |        | git branch -D new                           |
+      |        | git stash pop                               |
+    And the current branch is now "main"
+    And there are now no prototype branches
+    And the initial commits exist
+    And the initial branches and lineage exist
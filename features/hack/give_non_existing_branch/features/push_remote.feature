@@ -0,0 +1,29 @@
+Feature: auto-push the new branch to its configured push remote
+
+  Background:
+    Given an upstream repo
+    And Git Town setting "push-new-branches" is "true"
+    And Git Town setting "sync-upstream" is "false"
+    And local Git setting "branch.new.pushRemote" is "upstream"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE       |
+      | main   | origin   | origin commit |
+    And the current branch is "main"
+    When I run "git-town hack new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+      |        | git rebase origin/main   |
+      |        | git branch new main      |
+      |        | git checkout new         |
+      | new    | git push -u upstream new |
+    And the current branch is now "new"
+    And these commits exist now
+      | BRANCH | LOCATION        | MESSAGE       |
+      | main   | local, origin   | origin commit |
+      | new    | local, upstream | origin commit |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | new    | main   |
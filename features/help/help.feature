@@ -14,6 +14,7 @@ Feature: help for commands
       | append        |
       | completions   |
       | config        |
+      | dashboard     |
       | diff-parent   |
       | hack          |
       | help          |
@@ -0,0 +1,42 @@
+Feature: record the lineage of branches created with plain Git commands
+
+  Background:
+    Given a feature branch "alpha"
+    And the current branch is "alpha"
+    When I run "git-town hooks install"
+
+  Scenario: install
+    Then it prints something like:
+      """
+      Installed the Git Town post-checkout hook in .*/.git/hooks
+      """
+
+  Scenario: create a branch using "git switch -c"
+    When I run "git switch -c beta"
+    Then it prints:
+      """
+      Git Town: branch "beta" has parent "alpha"
+      """
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
+
+  Scenario: check out an existing branch
+    When I run "git checkout main"
+    Then it does not print "Git Town: branch"
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+
+  Scenario: uninstall
+    When I run "git-town hooks uninstall"
+    Then it prints something like:
+      """
+      Removed the Git Town post-checkout hook from .*/.git/hooks
+      """
+    When I run "git switch -c beta"
+    Then it does not print "Git Town: branch"
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
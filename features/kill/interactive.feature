@@ -0,0 +1,43 @@
+Feature: select the branches to delete in a dialog
+
+  Background:
+    Given the feature branches "alpha", "beta", and "gamma"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+      | gamma  | local, origin | gamma commit |
+    And the current branch is "beta" and the previous branch is "alpha"
+    When I run "git-town kill --interactive" and enter into the dialog:
+      | DIALOG                  | KEYS        |
+      | select branches to kill | space enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+      |        | git push origin :alpha   |
+      |        | git push origin :beta    |
+      |        | git checkout main        |
+      | main   | git branch -D alpha      |
+      |        | git branch -D beta       |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, gamma |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | gamma  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | main   | git branch alpha {{ sha 'alpha commit' }} |
+      |        | git push -u origin alpha                  |
+      |        | git branch beta {{ sha 'beta commit' }}   |
+      |        | git push -u origin beta                   |
+      |        | git checkout beta                         |
+    And the current branch is now "beta"
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,24 @@
+@skipWindows
+Feature: propose a branch of a fork
+
+  Background:
+    Given tool "open" is installed
+    And Git Town setting "sync-upstream" is "false"
+    And the current branch is a feature branch "feature"
+    And the origin is "https://github.com/alice/git-town"
+    And my repo's "upstream" remote is "https://github.com/git-town/git-town"
+
+  Scenario: proposal goes to the upstream repository
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://github.com/git-town/git-town/compare/main...alice:feature?expand=1
+      """
+
+  Scenario: upstream is a different repository on another hosting platform
+    Given my repo's "upstream" remote is "https://gitlab.com/git-town/git-town"
+    When I run "git-town propose"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://github.com/alice/git-town/compare/feature?expand=1
+      """
@@ -0,0 +1,52 @@
+@skipWindows
+Feature: propose a branch other than the current one
+
+  Background:
+    Given the feature branches "current" and "other"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE             |
+      | other  | local    | local other commit  |
+      |        | origin   | origin other commit |
+    And tool "open" is installed
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the current branch is "current"
+    And an uncommitted file
+    When I run "git-town propose other"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                          |
+      | current | git fetch --prune --tags                                         |
+      |         | git add -A                                                       |
+      |         | git stash                                                        |
+      |         | git checkout main                                                |
+      | main    | git rebase origin/main                                           |
+      |         | git checkout other                                               |
+      | other   | git merge --no-edit origin/other                                 |
+      |         | git merge --no-edit main                                         |
+      |         | git push                                                         |
+      |         | git checkout current                                             |
+      | current | git stash pop                                                    |
+      | <none>  | open https://github.com/git-town/git-town/compare/other?expand=1 |
+    And "open" launches a new proposal with this url in my browser:
+      """
+      https://github.com/git-town/git-town/compare/other?expand=1
+      """
+    And the current branch is still "current"
+    And the uncommitted file still exists
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                  |
+      | current | git add -A                                                               |
+      |         | git stash                                                                |
+      |         | git checkout other                                                       |
+      | other   | git reset --hard {{ sha 'local other commit' }}                          |
+      |         | git push --force-with-lease origin {{ sha 'origin other commit' }}:other |
+      |         | git checkout current                                                     |
+      | current | git stash pop                                                            |
+    And the current branch is still "current"
+    And the uncommitted file still exists
+    And the initial commits exist
+    And the initial branches and lineage exist
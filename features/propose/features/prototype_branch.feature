@@ -0,0 +1,17 @@
+Feature: cannot propose prototype branches
+
+  Background:
+    Given the current branch is a local prototype branch "prototype"
+    And the origin is "https://github.com/git-town/git-town.git"
+    When I run "git-town propose"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH    | COMMAND                  |
+      | prototype | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot propose prototype branches, run "git town hack prototype" to make it a feature branch first
+      """
+    And the current branch is still "prototype"
+    And branch "prototype" is still a prototype branch
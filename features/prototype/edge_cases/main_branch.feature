@@ -0,0 +1,21 @@
+Feature: cannot make the main branch a prototype branch
+
+  Background:
+    Given an uncommitted file
+    When I run "git-town prototype"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot make the main branch a prototype branch
+      """
+    And the current branch is still "main"
+    And the uncommitted file still exists
+    And there are still no prototype branches
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "main"
+    And there are still no prototype branches
@@ -0,0 +1,14 @@
+Feature: making a prototype branch a prototype branch
+
+  Background:
+    Given the current branch is a prototype branch "prototype"
+    When I run "git-town prototype"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "prototype" is already a prototype branch
+      """
+    And branch "prototype" is still a prototype branch
+    And the current branch is still "prototype"
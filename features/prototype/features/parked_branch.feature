@@ -0,0 +1,22 @@
+Feature: make a parked branch a prototype branch
+
+  Background:
+    Given the current branch is a parked branch "parked"
+    When I run "git-town prototype"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints:
+      """
+      branch "parked" is now a prototype branch
+      """
+    And branch "parked" is now a prototype branch
+    And there are now no parked branches
+    And the current branch is still "parked"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And branch "parked" is now parked
+    And there are now no prototype branches
+    And the current branch is still "parked"
@@ -0,0 +1,33 @@
+Feature: rename a branch that has a sync strategy
+
+  Background:
+    Given the current branch is a feature branch "old"
+    And branch "old" has the sync strategy "merge"
+    When I run "git-town rename-branch new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | old    | git fetch --prune --tags |
+      |        | git branch new old       |
+      |        | git checkout new         |
+      | new    | git push -u origin new   |
+      |        | git push origin :old     |
+      |        | git branch -D old        |
+    And the current branch is now "new"
+    And branch "new" now has the sync strategy "merge"
+    And branch "old" now has no sync strategy
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | new    | git branch old {{ sha 'initial commit' }} |
+      |        | git push -u origin old                    |
+      |        | git push origin :new                      |
+      |        | git checkout old                          |
+      | old    | git branch -D new                         |
+    And the current branch is now "old"
+    And branch "old" now has the sync strategy "merge"
+    And branch "new" now has no sync strategy
+    And the initial branches and lineage exist
@@ -0,0 +1,29 @@
+@skipWindows
+Feature: credit the other branch authors via Co-authored-by trailers
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE          | AUTHOR                            |
+      | feature | local    | developer commit | developer <developer@example.com> |
+      |         |          | coworker commit  | coworker <coworker@example.com>   |
+    When I run "git-town ship -m 'feature done' --co-authors" and enter into the dialog:
+      | DIALOG                              | KEYS  |
+      | choose author for the squash commit | enter |
+
+  Scenario: result
+    Then these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      | AUTHOR                          |
+      | main   | local, origin | feature done | coworker <coworker@example.com> |
+    And the last commit on the "main" branch now has the message:
+      """
+      feature done
+
+      Co-authored-by: developer <developer@example.com>
+      """
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then the current branch is now "feature"
+    And the initial branches and lineage exist
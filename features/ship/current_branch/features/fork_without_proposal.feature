@@ -0,0 +1,32 @@
+Feature: does not ship a branch of a fork without a proposal
+
+  Background:
+    Given offline mode is enabled
+    And the origin is "git@github.com:forker/git-town.git"
+    And local Git setting "remote.upstream.url" is "git@github.com:git-town/git-town.git"
+    And the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    When I run "git-town ship -m 'feature done'"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "feature" lives in a fork of git-town/git-town and must be shipped via a proposal on that repository, but no proposal was found
+      """
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And it prints:
+      """
+      nothing to undo
+      """
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
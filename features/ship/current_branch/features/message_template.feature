@@ -0,0 +1,30 @@
+Feature: pre-populate the squash commit message from a template
+
+  Background:
+    Given the current branch is a feature branch "ABC-123-feature"
+    And the commits
+      | BRANCH          | LOCATION      | MESSAGE        |
+      | ABC-123-feature | local, origin | feature commit |
+    And local Git Town setting "ship-message-template" is "ship {{branch}} into {{parent}} (Refs: {{tickets}})"
+    And local Git Town setting "ticket-regex" is "[A-Z]+-[0-9]+"
+    When I run "git-town ship" and close the editor
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH          | COMMAND                            |
+      | ABC-123-feature | git fetch --prune --tags           |
+      |                 | git checkout main                  |
+      | main            | git merge --squash ABC-123-feature |
+      |                 | git commit                         |
+      |                 | git push                           |
+      |                 | git push origin :ABC-123-feature   |
+      |                 | git branch -D ABC-123-feature      |
+    And the current branch is now "main"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                                        |
+      | main   | local, origin | ship ABC-123-feature into main (Refs: ABC-123) |
+    And the last commit on the "main" branch now has the message:
+      """
+      ship ABC-123-feature into main (Refs: ABC-123)
+      """
+    And no lineage exists now
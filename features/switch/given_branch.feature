@@ -0,0 +1,32 @@
+Feature: switch to the given branch
+
+  Scenario: local branch
+    Given the feature branches "current" and "other"
+    And the current branch is "current"
+    When I run "git-town switch other"
+    Then it runs the commands
+      | BRANCH  | COMMAND            |
+      | current | git checkout other |
+    And the current branch is now "other"
+
+  Scenario: branch in another worktree
+    Given the feature branches "current" and "other"
+    And the current branch is "current"
+    And branch "other" is active in another worktree
+    When I run "git-town switch other"
+    Then it runs no commands
+    And it prints:
+      """
+      branch "other" is checked out in another worktree at:
+      """
+    And the current branch is still "current"
+
+  Scenario: non-existing branch
+    Given the current branch is a feature branch "current"
+    When I run "git-town switch zonk"
+    Then it runs no commands
+    And it prints the error:
+      """
+      there is no branch "zonk"
+      """
+    And the current branch is still "current"
@@ -0,0 +1,17 @@
+Feature: switch to a branch that is checked out in another worktree
+
+  Background:
+    Given the feature branches "current" and "other"
+    And the current branch is "current"
+    And branch "other" is active in another worktree
+    When I run "git-town switch" and enter into the dialog:
+      | DIALOG        | KEYS       |
+      | select branch | down enter |
+
+  Scenario: result
+    Then it runs no commands
+    And it prints:
+      """
+      branch "other" is checked out in another worktree at:
+      """
+    And the current branch is still "current"
@@ -0,0 +1,15 @@
+Feature: switch to a branch that exists only at the remote
+
+  Background:
+    Given the current branch is a feature branch "local"
+    And a remote feature branch "remote"
+    And I ran "git fetch"
+    When I run "git-town switch" and enter into the dialog:
+      | DIALOG        | KEYS              |
+      | select branch | r down down enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                            |
+      | local  | git checkout --track origin/remote |
+    And the current branch is now "remote"
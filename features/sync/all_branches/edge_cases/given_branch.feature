@@ -0,0 +1,11 @@
+Feature: does not sync a given branch together with all branches
+
+  Scenario:
+    Given a feature branch "feature"
+    When I run "git-town sync --all feature"
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot sync a given branch together with --all
+      """
+    And the current branch is still "main"
@@ -0,0 +1,35 @@
+Feature: sync a branch that matches the contribution regex
+
+  Background:
+    Given a branch "release-1" with a tracking branch
+    And local Git Town setting "contribution-regex" is "^release-"
+    And the current branch is "release-1"
+    And the commits
+      | BRANCH    | LOCATION      | MESSAGE       | FILE NAME   |
+      | main      | local, origin | main commit   | main_file   |
+      | release-1 | local         | local commit  | local_file  |
+      |           | origin        | origin commit | origin_file |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH    | COMMAND                     |
+      | release-1 | git fetch --prune --tags    |
+      |           | git rebase origin/release-1 |
+      |           | git push                    |
+    And the current branch is still "release-1"
+    And these commits exist now
+      | BRANCH    | LOCATION      | MESSAGE       |
+      | main      | local, origin | main commit   |
+      | release-1 | local, origin | origin commit |
+      |           |               | local commit  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH    | COMMAND                                                                |
+      | release-1 | git reset --hard {{ sha-before-run 'local commit' }}                   |
+      |           | git push --force-with-lease origin {{ sha 'origin commit' }}:release-1 |
+    And the current branch is still "release-1"
+    And the initial commits exist
+    And the initial branches and lineage exist
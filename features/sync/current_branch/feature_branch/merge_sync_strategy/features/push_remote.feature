@@ -0,0 +1,25 @@
+Feature: push the feature branch to its configured push remote
+
+  Background:
+    Given an upstream repo
+    And Git Town setting "sync-upstream" is "false"
+    And the current branch is a feature branch "feature"
+    And local Git setting "branch.feature.pushRemote" is "upstream"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE              |
+      | feature | local    | local feature commit |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      |         | git checkout main                  |
+      | main    | git rebase origin/main             |
+      |         | git checkout feature               |
+      | feature | git merge --no-edit origin/feature |
+      |         | git merge --no-edit main           |
+      |         | git push upstream feature          |
+    And these commits exist now
+      | BRANCH  | LOCATION        | MESSAGE              |
+      | feature | local, upstream | local feature commit |
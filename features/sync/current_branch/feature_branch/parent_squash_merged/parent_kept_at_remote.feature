@@ -0,0 +1,57 @@
+Feature: sync a branch whose parent branch was squash-merged at the remote without deleting it
+
+  Background:
+    Given a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | child  | local, origin | child commit | child_file | child content |
+    And origin squash-merges the "parent" branch
+    And the current branch is "child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                          |
+      | child  | git fetch --prune --tags                         |
+      |        | git checkout main                                |
+      | main   | git rebase origin/main                           |
+      |        | git branch -D parent                             |
+      |        | git checkout child                               |
+      | child  | git merge --no-edit origin/child                 |
+      |        | git rebase --onto main {{ sha 'parent commit' }} |
+      |        | git push --force-with-lease                      |
+    And it prints:
+      """
+      deleted branch "parent" because it was squash-merged into "main"
+      """
+    And the current branch is still "child"
+    And the branches are now
+      | REPOSITORY | BRANCHES            |
+      | local      | main, child         |
+      | origin     | main, child, parent |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE         |
+      | main   | local, origin | squashed parent |
+      | child  | local, origin | squashed parent |
+      |        |               | child commit    |
+      | parent | origin        | parent commit   |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                              |
+      | child  | git reset --hard {{ sha-before-run 'child commit' }} |
+      |        | git push --force-with-lease                          |
+      |        | git checkout main                                    |
+      | main   | git reset --hard {{ sha 'initial commit' }}          |
+      |        | git branch parent {{ sha 'parent commit' }}          |
+      |        | git checkout child                                   |
+    And the current branch is still "child"
+    And the initial branches and lineage exist
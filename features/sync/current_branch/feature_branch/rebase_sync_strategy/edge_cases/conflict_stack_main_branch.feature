@@ -0,0 +1,68 @@
+@skipWindows
+Feature: handle conflicts between a stack synced using "git rebase --update-refs" and the main branch
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE                   | FILE NAME        | FILE CONTENT   |
+      | parent | local, origin | conflicting parent commit | conflicting_file | parent content |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE                 | FILE NAME        | FILE CONTENT  |
+      | main   | local, origin | conflicting main commit | conflicting_file | main content  |
+      | child  | local, origin | child commit            | child_file       | child content |
+    And the current branch is "child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                       |
+      | child  | git fetch --prune --tags      |
+      |        | git checkout main             |
+      | main   | git rebase origin/main        |
+      |        | git checkout child            |
+      | child  | git rebase --update-refs main |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git-town continue".
+      To go back to where you started, run "git-town undo".
+      To continue by skipping the current branch, run "git-town skip".
+      """
+    And a rebase is now in progress
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | child  | git rebase --abort |
+    And the current branch is still "child"
+    And no rebase is in progress
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                   |
+      | main   | local, origin | conflicting main commit   |
+      | child  | local, origin | conflicting parent commit |
+      |        |               | child commit              |
+      | parent | local, origin | conflicting parent commit |
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and enter "resolved commit" for the commit message
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | child  | git rebase --continue                     |
+      |        | git push --force-with-lease origin parent |
+      |        | git push --force-with-lease origin child  |
+    And all branches are now synchronized
+    And the current branch is still "child"
+    And no rebase is in progress
+    And these committed files exist now
+      | BRANCH | NAME             | CONTENT          |
+      | main   | conflicting_file | main content     |
+      | child  | child_file       | child content    |
+      |        | conflicting_file | resolved content |
+      | parent | conflicting_file | resolved content |
@@ -0,0 +1,61 @@
+Feature: sync a stack of branches using "git rebase --update-refs"
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   |
+      | parent | local, origin | parent commit | parent_file |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE            | FILE NAME    |
+      | main   | origin        | origin main commit | main_file    |
+      | child  | local, origin | child commit       | child_file   |
+      | child  | local         | local child commit | child_file_2 |
+    And the current branch is "child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | child  | git fetch --prune --tags                  |
+      |        | git checkout main                         |
+      | main   | git rebase origin/main                    |
+      |        | git checkout child                        |
+      | child  | git rebase --update-refs main             |
+      |        | git push --force-with-lease origin parent |
+      |        | git push --force-with-lease origin child  |
+    And all branches are now synchronized
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE            |
+      | main   | local, origin | origin main commit |
+      | child  | local, origin | origin main commit |
+      |        |               | parent commit      |
+      |        |               | child commit       |
+      |        |               | local child commit |
+      | parent | local, origin | origin main commit |
+      |        |               | parent commit      |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                |
+      | child  | git checkout parent                                                                    |
+      | parent | git reset --hard {{ sha-before-run 'parent commit' }}                                  |
+      |        | git push --force-with-lease                                                            |
+      |        | git checkout child                                                                     |
+      | child  | git reset --hard {{ sha-before-run 'local child commit' }}                             |
+      |        | git push --force-with-lease origin {{ sha-in-origin-before-run 'child commit' }}:child |
+      |        | git checkout main                                                                      |
+      | main   | git reset --hard {{ sha 'initial commit' }}                                            |
+      |        | git checkout child                                                                     |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE            |
+      | main   | origin        | origin main commit |
+      | child  | local, origin | parent commit      |
+      |        |               | child commit       |
+      |        | local         | local child commit |
+      | parent | local, origin | parent commit      |
+    And the initial branches and lineage exist
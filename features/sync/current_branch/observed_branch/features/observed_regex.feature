@@ -0,0 +1,33 @@
+Feature: sync a branch that matches the observed regex
+
+  Background:
+    Given a branch "renovate/lodash" with a tracking branch
+    And local Git Town setting "observed-regex" is "^renovate/"
+    And the current branch is "renovate/lodash"
+    And the commits
+      | BRANCH          | LOCATION      | MESSAGE       | FILE NAME   |
+      | main            | local, origin | main commit   | main_file   |
+      | renovate/lodash | local         | local commit  | local_file  |
+      |                 | origin        | origin commit | origin_file |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH          | COMMAND                           |
+      | renovate/lodash | git fetch --prune --tags          |
+      |                 | git rebase origin/renovate/lodash |
+    And the current branch is still "renovate/lodash"
+    And these commits exist now
+      | BRANCH          | LOCATION      | MESSAGE       |
+      | main            | local, origin | main commit   |
+      | renovate/lodash | local, origin | origin commit |
+      |                 | local         | local commit  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH          | COMMAND                                              |
+      | renovate/lodash | git reset --hard {{ sha-before-run 'local commit' }} |
+    And the current branch is still "renovate/lodash"
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,37 @@
+Feature: sync a local prototype branch
+
+  Background:
+    Given Git Town setting "push-new-branches" is "true"
+    And the current branch is a local prototype branch "prototype"
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE          |
+      | main      | origin   | main commit      |
+      | prototype | local    | prototype commit |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH    | COMMAND                  |
+      | prototype | git fetch --prune --tags |
+      |           | git checkout main        |
+      | main      | git rebase origin/main   |
+      |           | git checkout prototype   |
+      | prototype | git merge --no-edit main |
+    And the current branch is still "prototype"
+    And these commits exist now
+      | BRANCH    | LOCATION      | MESSAGE                            |
+      | main      | local, origin | main commit                        |
+      | prototype | local         | prototype commit                   |
+      |           |               | main commit                        |
+      |           |               | Merge branch 'main' into prototype |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH    | COMMAND                                       |
+      | prototype | git checkout main                             |
+      | main      | git reset --hard {{ sha 'initial commit' }}   |
+      |           | git checkout prototype                        |
+      | prototype | git reset --hard {{ sha 'prototype commit' }} |
+    And the current branch is still "prototype"
+    And branch "prototype" is still a prototype branch
@@ -0,0 +1,25 @@
+Feature: apply the default-branch-type to local branches without a parent
+
+  Background:
+    Given a branch "experiment"
+    And local Git Town setting "default-branch-type" is "observed"
+    And local Git Town setting "default-remote-branch-type" is "contribution"
+    And the current branch is "experiment"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH     | COMMAND                  |
+      | experiment | git fetch --prune --tags |
+    And it prints:
+      """
+      branch "experiment" is now an observed branch
+      """
+    And branch "experiment" is now observed
+    And the current branch is still "experiment"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And there are now no observed branches
+    And the current branch is still "experiment"
@@ -0,0 +1,30 @@
+Feature: apply the default-remote-branch-type to branches checked out from the remote
+
+  Background:
+    Given a known remote feature branch "coworker"
+    And the commits
+      | BRANCH   | LOCATION | MESSAGE         | FILE NAME     |
+      | coworker | origin   | coworker commit | coworker_file |
+    And local Git Town setting "default-remote-branch-type" is "observed"
+    And I ran "git checkout coworker"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH   | COMMAND                    |
+      | coworker | git fetch --prune --tags   |
+      |          | git rebase origin/coworker |
+    And it prints:
+      """
+      branch "coworker" is now an observed branch
+      """
+    And branch "coworker" is now observed
+    And the current branch is still "coworker"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH   | COMMAND                                     |
+      | coworker | git reset --hard {{ sha 'initial commit' }} |
+    And there are now no observed branches
+    And the current branch is still "coworker"
@@ -0,0 +1,49 @@
+Feature: sync a branch other than the current one
+
+  Background:
+    Given the feature branches "current" and "other"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE             |
+      | other  | local    | local other commit  |
+      |        | origin   | origin other commit |
+    And the current branch is "current"
+    And an uncommitted file
+    When I run "git-town sync other"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                          |
+      | current | git fetch --prune --tags         |
+      |         | git add -A                       |
+      |         | git stash                        |
+      |         | git checkout main                |
+      | main    | git rebase origin/main           |
+      |         | git checkout other               |
+      | other   | git merge --no-edit origin/other |
+      |         | git merge --no-edit main         |
+      |         | git push                         |
+      |         | git checkout current             |
+      | current | git stash pop                    |
+    And the current branch is still "current"
+    And the uncommitted file still exists
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                                                |
+      | other  | local, origin | local other commit                                     |
+      |        |               | origin other commit                                    |
+      |        |               | Merge remote-tracking branch 'origin/other' into other |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                  |
+      | current | git add -A                                                               |
+      |         | git stash                                                                |
+      |         | git checkout other                                                       |
+      | other   | git reset --hard {{ sha 'local other commit' }}                          |
+      |         | git push --force-with-lease origin {{ sha 'origin other commit' }}:other |
+      |         | git checkout current                                                     |
+      | current | git stash pop                                                            |
+    And the current branch is still "current"
+    And the uncommitted file still exists
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,52 @@
+Feature: infer the parent of branches without lineage
+
+  Background:
+    Given a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+    And the current branch is "alpha"
+
+  Scenario: branch created with "git checkout -b" on top of a feature branch
+    Given I ran "git checkout -b beta"
+    When I run "git-town sync --infer-parents"
+    Then it prints:
+      """
+      branch "beta": inferred parent branch "alpha"
+      """
+    And it runs the commands
+      | BRANCH | COMMAND                          |
+      | beta   | git fetch --prune --tags         |
+      |        | git checkout main                |
+      | main   | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit alpha        |
+      |        | git push -u origin beta          |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
+
+  Scenario: branch created from the main branch
+    Given I ran "git branch beta main"
+    And I ran "git checkout beta"
+    When I run "git-town sync --infer-parents"
+    Then it prints:
+      """
+      branch "beta": inferred parent branch "main"
+      """
+    And it runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+      |        | git checkout main        |
+      | main   | git rebase origin/main   |
+      |        | git checkout beta        |
+      | beta   | git merge --no-edit main |
+      |        | git push -u origin beta  |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | main   |
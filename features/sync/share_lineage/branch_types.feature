@@ -0,0 +1,19 @@
+Feature: share branch types with teammates
+
+  Background:
+    Given global Git Town setting "share-lineage" is "true"
+    And a feature branch "alpha"
+    And a parked branch "old"
+    And the current branch is "alpha"
+    And I ran "git-town sync"
+    And a coworker clones the repository
+    And the coworker is on the "old" branch
+    And the coworker is on the "alpha" branch
+
+  Scenario: a teammate receives the shared branch types
+    When the coworker runs "git-town sync"
+    And the coworker runs "git config git-town.parked-branches"
+    Then it prints:
+      """
+      old
+      """
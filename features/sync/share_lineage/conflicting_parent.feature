@@ -0,0 +1,59 @@
+Feature: resolve conflicts between the local and the shared lineage
+
+  Background:
+    Given global Git Town setting "share-lineage" is "true"
+    And a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a coworker clones the repository
+    And the coworker is on the "alpha" branch
+    And the coworker is on the "beta" branch
+    And the coworker sets the parent branch of "alpha" as "main"
+    And the coworker sets the parent branch of "beta" as "main"
+    And the coworker runs "git-town sync"
+    And the current branch is "beta"
+
+  Scenario: select the shared parent
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG                 | KEYS       |
+      | parent branch for beta | down enter |
+    Then it prints:
+      """
+      Selected parent branch for "beta": main
+      """
+    And it runs the commands
+      | BRANCH | COMMAND                         |
+      | beta   | git fetch --prune --tags        |
+      |        | git checkout main               |
+      | main   | git rebase origin/main          |
+      |        | git checkout beta               |
+      | beta   | git merge --no-edit origin/beta |
+      |        | git merge --no-edit main        |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | main   |
+
+  Scenario: keep the local parent
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG                 | KEYS  |
+      | parent branch for beta | enter |
+    Then it prints:
+      """
+      Selected parent branch for "beta": alpha
+      """
+    And it runs the commands
+      | BRANCH | COMMAND                                                                                                                           |
+      | beta   | git fetch --prune --tags                                                                                                          |
+      |        | git checkout main                                                                                                                 |
+      | main   | git rebase origin/main                                                                                                            |
+      |        | git checkout alpha                                                                                                                |
+      | alpha  | git merge --no-edit origin/alpha                                                                                                  |
+      |        | git merge --no-edit main                                                                                                          |
+      |        | git checkout beta                                                                                                                 |
+      | beta   | git merge --no-edit origin/beta                                                                                                   |
+      |        | git merge --no-edit alpha                                                                                                         |
+      |        | git push --force-with-lease=refs/git-town/lineage:refs/git-town/origin-lineage origin refs/git-town/lineage:refs/git-town/lineage |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
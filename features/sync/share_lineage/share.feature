@@ -0,0 +1,35 @@
+Feature: share the lineage with teammates
+
+  Background:
+    Given global Git Town setting "share-lineage" is "true"
+    And a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                               |
+      | beta   | git fetch --prune --tags                                                                              |
+      |        | git checkout main                                                                                     |
+      | main   | git rebase origin/main                                                                                |
+      |        | git checkout alpha                                                                                    |
+      | alpha  | git merge --no-edit origin/alpha                                                                      |
+      |        | git merge --no-edit main                                                                              |
+      |        | git checkout beta                                                                                     |
+      | beta   | git merge --no-edit origin/beta                                                                       |
+      |        | git merge --no-edit alpha                                                                             |
+      |        | git push --force-with-lease=refs/git-town/lineage: origin refs/git-town/lineage:refs/git-town/lineage |
+    And all branches are now synchronized
+
+  Scenario: a teammate receives the shared lineage
+    Given a coworker clones the repository
+    And the coworker is on the "alpha" branch
+    And the coworker is on the "beta" branch
+    When the coworker runs "git-town sync"
+    Then it prints:
+      """
+      branch "alpha" now has parent "main" from the shared lineage
+      branch "beta" now has parent "alpha" from the shared lineage
+      """
+    And it does not print "Please select the parent"
@@ -0,0 +1,16 @@
+Feature: invalid sync strategy in the Git configuration
+
+  Scenario: result
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And the current branch is a feature branch "branch"
+    And branch "branch" has the sync strategy "zonk"
+    When I run "git-town sync-strategy"
+    Then it runs no commands
+    And it prints:
+      """
+      Notice: ignoring invalid value "zonk" for setting "git-town-branch.branch.sync-strategy"
+      """
+    And it prints:
+      """
+      rebase
+      """
@@ -0,0 +1,18 @@
+Feature: cannot set the sync strategy of the main branch
+
+  Background:
+    When I run "git-town sync-strategy merge"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      the main branch syncs using the sync-perennial-strategy
+      """
+    And the current branch is still "main"
+    And branch "main" still has no sync strategy
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "main"
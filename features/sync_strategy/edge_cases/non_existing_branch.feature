@@ -0,0 +1,10 @@
+Feature: cannot set the sync strategy of non-existing branches
+
+  Scenario: result
+    When I run "git-town sync-strategy merge zonk"
+    Then it runs no commands
+    And it prints the error:
+      """
+      there is no branch "zonk"
+      """
+    And branch "zonk" still has no sync strategy
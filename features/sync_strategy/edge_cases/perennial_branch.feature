@@ -0,0 +1,13 @@
+Feature: cannot set the sync strategy of perennial branches
+
+  Background:
+    Given a perennial branch "production"
+    When I run "git-town sync-strategy merge production"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      perennial branches sync using the sync-perennial-strategy
+      """
+    And branch "production" still has no sync strategy
@@ -0,0 +1,11 @@
+Feature: unknown sync strategy
+
+  Scenario: result
+    Given the current branch is a feature branch "branch"
+    When I run "git-town sync-strategy zonk"
+    Then it runs no commands
+    And it prints the error:
+      """
+      unknown sync-feature strategy: "zonk"
+      """
+    And branch "branch" still has no sync strategy
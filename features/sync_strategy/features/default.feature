@@ -0,0 +1,23 @@
+Feature: make branches use the sync-feature-strategy again
+
+  Background:
+    Given the feature branches "alpha" and "beta"
+    And branch "alpha" has the sync strategy "merge"
+    And branch "beta" has the sync strategy "rebase"
+    When I run "git-town sync-strategy default alpha beta"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints:
+      """
+      branch "alpha" now syncs using the sync-feature-strategy
+      branch "beta" now syncs using the sync-feature-strategy
+      """
+    And branch "alpha" now has no sync strategy
+    And branch "beta" now has no sync strategy
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And branch "alpha" now has the sync strategy "merge"
+    And branch "beta" now has the sync strategy "rebase"
@@ -0,0 +1,22 @@
+Feature: display the sync strategy of the current branch
+
+  Scenario: no override
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And the current branch is a feature branch "branch"
+    When I run "git-town sync-strategy"
+    Then it runs no commands
+    And it prints:
+      """
+      rebase
+      """
+
+  Scenario: with override
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And the current branch is a feature branch "branch"
+    And branch "branch" has the sync strategy "merge"
+    When I run "git-town sync-strategy"
+    Then it runs no commands
+    And it prints:
+      """
+      merge
+      """
@@ -0,0 +1,33 @@
+Feature: sync a branch that overrides the sync-feature-strategy
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And the current branch is a feature branch "feature"
+    And branch "feature" has the sync strategy "merge"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE               |
+      | main    | origin   | origin main commit    |
+      | feature | local    | local feature commit  |
+      |         | origin   | origin feature commit |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      |         | git checkout main                  |
+      | main    | git rebase origin/main             |
+      |         | git checkout feature               |
+      | feature | git merge --no-edit origin/feature |
+      |         | git merge --no-edit main           |
+      |         | git push                           |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE                                                    |
+      | main    | local, origin | origin main commit                                         |
+      | feature | local, origin | local feature commit                                       |
+      |         |               | origin feature commit                                      |
+      |         |               | Merge remote-tracking branch 'origin/feature' into feature |
+      |         |               | origin main commit                                         |
+      |         |               | Merge branch 'main' into feature                           |
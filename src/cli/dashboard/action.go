@@ -0,0 +1,52 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// GitTown is the placeholder for the Git Town executable in the commands of actions.
+const GitTown = "git-town"
+
+// Action is an activity that the dashboard performs on the selected branch.
+type Action struct {
+	Commands    [][]string // the commands to run, in order
+	Confirm     string     // question to ask the user before running the commands, empty if no confirmation is needed
+	Interactive bool       // whether the commands need the terminal, for example to open an editor
+}
+
+// ActionFor provides the action that the given key triggers for the given entry.
+// All actions run Git Town commands for the selected branch,
+// so that they can be undone and don't move uncommitted changes to other branches.
+func ActionFor(key string, entry Entry, currentBranch gitdomain.LocalBranchName) (Action, bool) {
+	branch := entry.Branch.String()
+	switch key {
+	case "enter":
+		if entry.Branch == currentBranch {
+			return Action{}, false //nolint:exhaustruct
+		}
+		return Action{Commands: [][]string{{GitTown, "switch", branch}}, Confirm: "", Interactive: false}, true
+	case "s":
+		return Action{Commands: [][]string{{GitTown, "sync", branch}}, Confirm: "", Interactive: false}, true
+	case "p":
+		return Action{Commands: [][]string{{GitTown, "propose", branch}}, Confirm: "", Interactive: false}, true
+	case "P":
+		return Action{Commands: [][]string{{GitTown, "park", branch}}, Confirm: "", Interactive: false}, true
+	case "S":
+		// shipping opens an editor for the commit message
+		return Action{Commands: [][]string{{GitTown, "ship", branch}}, Confirm: "", Interactive: true}, true
+	case "x":
+		return Action{Commands: [][]string{{GitTown, "kill", branch}}, Confirm: fmt.Sprintf("Kill branch %q?", branch), Interactive: false}, true
+	}
+	return Action{}, false //nolint:exhaustruct
+}
+
+// CommandStr provides the given command in human-readable form.
+func CommandStr(command []string) string {
+	if len(command) > 0 && command[0] == GitTown {
+		command = append([]string{"git", "town"}, command[1:]...)
+	}
+	return strings.Join(command, " ")
+}
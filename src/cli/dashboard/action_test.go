@@ -0,0 +1,68 @@
+package dashboard_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/dashboard"
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestAction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ActionFor", func(t *testing.T) {
+		t.Parallel()
+		current := gitdomain.NewLocalBranchName("current")
+		other := dashboard.Entry{SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "other"}} //nolint:exhaustruct
+		t.Run("sync another branch", func(t *testing.T) {
+			t.Parallel()
+			have, has := dashboard.ActionFor("s", other, current)
+			must.True(t, has)
+			must.Eq(t, [][]string{{"git-town", "sync", "other"}}, have.Commands)
+		})
+		t.Run("propose another branch", func(t *testing.T) {
+			t.Parallel()
+			have, has := dashboard.ActionFor("p", other, current)
+			must.True(t, has)
+			must.Eq(t, [][]string{{"git-town", "propose", "other"}}, have.Commands)
+		})
+		t.Run("switch", func(t *testing.T) {
+			t.Parallel()
+			have, has := dashboard.ActionFor("enter", other, current)
+			must.True(t, has)
+			must.Eq(t, [][]string{{"git-town", "switch", "other"}}, have.Commands)
+		})
+		t.Run("switch to the current branch", func(t *testing.T) {
+			t.Parallel()
+			entry := dashboard.Entry{SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: current}} //nolint:exhaustruct
+			_, has := dashboard.ActionFor("enter", entry, current)
+			must.False(t, has)
+		})
+		t.Run("kill asks for confirmation", func(t *testing.T) {
+			t.Parallel()
+			have, has := dashboard.ActionFor("x", other, current)
+			must.True(t, has)
+			must.Eq(t, [][]string{{"git-town", "kill", "other"}}, have.Commands)
+			must.EqOp(t, `Kill branch "other"?`, have.Confirm)
+		})
+		t.Run("ship runs interactively", func(t *testing.T) {
+			t.Parallel()
+			have, has := dashboard.ActionFor("S", other, current)
+			must.True(t, has)
+			must.True(t, have.Interactive)
+		})
+		t.Run("unknown key", func(t *testing.T) {
+			t.Parallel()
+			_, has := dashboard.ActionFor("z", other, current)
+			must.False(t, has)
+		})
+	})
+
+	t.Run("CommandStr", func(t *testing.T) {
+		t.Parallel()
+		must.EqOp(t, "git town park alpha", dashboard.CommandStr([]string{"git-town", "park", "alpha"}))
+		must.EqOp(t, "git checkout alpha", dashboard.CommandStr([]string{"git", "checkout", "alpha"}))
+	})
+}
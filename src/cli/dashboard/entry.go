@@ -0,0 +1,73 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+)
+
+// Data contains everything the dashboard displays about the repository.
+type Data struct {
+	CurrentBranch gitdomain.LocalBranchName
+	Entries       []Entry
+}
+
+// Entry describes a local branch that the dashboard displays.
+type Entry struct {
+	dialog.SwitchBranchEntry
+	Ahead    int                     // how many local commits the tracking branch doesn't have
+	Behind   int                     // how many commits of the tracking branch the local branch doesn't have
+	Proposal *hostingdomain.Proposal // the proposal for this branch, nil if none exists or the hosting platform is unknown
+}
+
+// Columns provides the texts to display in the table columns for this entry.
+func (self Entry) Columns() []string {
+	return []string{
+		self.String(),
+		self.TypeStr(),
+		self.SyncStr(),
+		self.ProposalStr(),
+	}
+}
+
+// ProposalStr provides a human-readable description of the proposal for this branch.
+func (self Entry) ProposalStr() string {
+	if self.Proposal == nil {
+		return ""
+	}
+	state := "open"
+	if self.Proposal.Draft {
+		state = "draft"
+	}
+	return fmt.Sprintf("#%d %s (%s)", self.Proposal.Number, self.Proposal.Title, state)
+}
+
+// SyncStr provides a human-readable description of how this branch relates to its tracking branch.
+func (self Entry) SyncStr() string {
+	if self.Worktree != "" {
+		return "worktree " + self.Worktree
+	}
+	counts := []string{}
+	if self.Ahead > 0 {
+		counts = append(counts, fmt.Sprintf("↑%d", self.Ahead))
+	}
+	if self.Behind > 0 {
+		counts = append(counts, fmt.Sprintf("↓%d", self.Behind))
+	}
+	if len(counts) > 0 {
+		return strings.Join(counts, " ")
+	}
+	return self.SyncStatus.String()
+}
+
+// TypeStr provides the type of this branch in human-readable form.
+func (self Entry) TypeStr() string {
+	if self.BranchType == configdomain.BranchTypeFeatureBranch {
+		return ""
+	}
+	return strings.TrimSuffix(self.BranchType.String(), " branch")
+}
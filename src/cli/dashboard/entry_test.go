@@ -0,0 +1,60 @@
+package dashboard_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/dashboard"
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestEntry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Columns", func(t *testing.T) {
+		t.Parallel()
+		t.Run("feature branch with proposal", func(t *testing.T) {
+			t.Parallel()
+			entry := dashboard.Entry{
+				SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "alpha", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", SyncStatus: gitdomain.SyncStatusNotInSync}, //nolint:exhaustruct
+				Ahead:             2,
+				Behind:            1,
+				Proposal:          &hostingdomain.Proposal{Number: 12, Title: "Alpha feature"}, //nolint:exhaustruct
+			}
+			must.Eq(t, []string{"  alpha", "", "↑2 ↓1", "#12 Alpha feature (open)"}, entry.Columns())
+		})
+		t.Run("feature branch with draft proposal", func(t *testing.T) {
+			t.Parallel()
+			entry := dashboard.Entry{
+				SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "alpha", BranchType: configdomain.BranchTypeFeatureBranch, SyncStatus: gitdomain.SyncStatusUpToDate}, //nolint:exhaustruct
+				Ahead:             0,
+				Behind:            0,
+				Proposal:          &hostingdomain.Proposal{Draft: true, Number: 12, Title: "Alpha feature"}, //nolint:exhaustruct
+			}
+			must.Eq(t, []string{"alpha", "", "up to date", "#12 Alpha feature (draft)"}, entry.Columns())
+		})
+		t.Run("parked branch in another worktree", func(t *testing.T) {
+			t.Parallel()
+			entry := dashboard.Entry{
+				SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "beta", BranchType: configdomain.BranchTypeParkedBranch, SyncStatus: gitdomain.SyncStatusOtherWorktree, Worktree: "/tmp/beta"}, //nolint:exhaustruct
+				Ahead:             0,
+				Behind:            0,
+				Proposal:          nil,
+			}
+			must.Eq(t, []string{"beta", "parked", "worktree /tmp/beta", ""}, entry.Columns())
+		})
+		t.Run("up to date main branch", func(t *testing.T) {
+			t.Parallel()
+			entry := dashboard.Entry{
+				SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, SyncStatus: gitdomain.SyncStatusUpToDate}, //nolint:exhaustruct
+				Ahead:             0,
+				Behind:            0,
+				Proposal:          nil,
+			}
+			must.Eq(t, []string{"main", "main", "up to date", ""}, entry.Columns())
+		})
+	})
+}
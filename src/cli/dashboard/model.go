@@ -0,0 +1,302 @@
+package dashboard
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
+)
+
+// how many lines of command output the dashboard displays at least
+const minOutputHeight = 3
+
+// Model is the BubbleTea model of the dashboard.
+type Model struct {
+	components.BubbleList[Entry]
+	CurrentBranch gitdomain.LocalBranchName
+	Executable    string               // path of the Git Town binary that executes the actions
+	Load          func() (Data, error) // loads the data to display
+	Loading       bool                 // whether the dashboard is currently loading data
+	Message       string               // status message to display below the output
+	Output        []string             // output of the commands that the dashboard has run
+	Pending       *Action              // action that waits for the user to confirm it
+	Running       bool                 // whether an action is currently running
+	cancel        context.CancelFunc   // stops the currently running action
+	height        int                  // height of the terminal
+	messages      chan tea.Msg         // receives the output of the currently running action
+	width         int                  // width of the terminal
+}
+
+func (self Model) Init() tea.Cmd {
+	return self.loadCmd()
+}
+
+func (self Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolint:ireturn
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		self.height = msg.Height
+		self.width = msg.Width
+		return self, nil
+	case loadedMsg:
+		self.applyData(msg.data)
+		self.Loading = false
+		if msg.err != nil {
+			self.Message = msg.err.Error()
+		}
+		return self, nil
+	case outputMsg:
+		self.Output = append(self.Output, string(msg))
+		return self, waitForMessage(self.messages)
+	case doneMsg:
+		self.Running = false
+		self.cancel = nil
+		if msg.err != nil {
+			self.Message = "Error: " + msg.err.Error()
+		} else {
+			self.Message = "Done."
+		}
+		self.Loading = true
+		return self, self.loadCmd()
+	case tea.KeyMsg:
+		return self.handleKey(msg)
+	}
+	return self, nil
+}
+
+func (self Model) View() string {
+	s := strings.Builder{}
+	s.WriteString(self.Colors.Title.Styled("Git Town dashboard"))
+	if self.Loading {
+		s.WriteString(self.Dim.Styled("  loading ..."))
+	}
+	s.WriteString("\n\n")
+	rows := self.windowRows()
+	widths := self.columnWidths()
+	for _, row := range rows {
+		s.WriteString(self.rowStr(row, widths))
+		s.WriteRune('\n')
+	}
+	if filter := self.FilterStr(); filter != "" {
+		s.WriteString(filter)
+		s.WriteRune('\n')
+	}
+	s.WriteRune('\n')
+	s.WriteString(self.Dim.Styled(strings.Repeat("─", max(self.width, 40))))
+	s.WriteRune('\n')
+	outputHeight := self.outputHeight(len(rows))
+	output := self.Output
+	if len(output) > outputHeight {
+		output = output[len(output)-outputHeight:]
+	}
+	for _, line := range output {
+		s.WriteString(self.truncate(line))
+		s.WriteRune('\n')
+	}
+	for i := len(output); i < outputHeight; i++ {
+		s.WriteRune('\n')
+	}
+	switch {
+	case self.Pending != nil:
+		s.WriteString(self.Colors.Selection.Styled(self.Pending.Confirm + " (y/n)"))
+	case self.Running:
+		s.WriteString(self.Dim.Styled("running ..."))
+	default:
+		s.WriteString(self.Message)
+	}
+	s.WriteString("\n\n  ")
+	s.WriteString(self.helpStr())
+	return s.String()
+}
+
+// applyData displays the given data, keeping the cursor on the selected branch if it still exists.
+func (self *Model) applyData(data Data) {
+	selected := gitdomain.EmptyLocalBranchName()
+	if len(self.Entries) > 0 {
+		selected = self.SelectedEntry().Branch
+	}
+	if selected.IsEmpty() {
+		selected = data.CurrentBranch
+	}
+	cursor := max(slices.IndexFunc(data.Entries, func(entry Entry) bool { return entry.Branch == selected }), 0)
+	filter := self.Filter
+	self.BubbleList = components.NewBubbleList(data.Entries, cursor)
+	self.Filter = filter
+	self.CurrentBranch = data.CurrentBranch
+}
+
+// columnWidths provides the widths of the table columns.
+func (self Model) columnWidths() []int {
+	result := []int{}
+	for _, entry := range self.Entries {
+		for c, column := range entry.Columns() {
+			if c == len(result) {
+				result = append(result, 0)
+			}
+			result[c] = max(result[c], len([]rune(column)))
+		}
+	}
+	return result
+}
+
+func (self Model) handleKey(key tea.KeyMsg) (tea.Model, tea.Cmd) { //nolint:ireturn
+	if key.Type == tea.KeyCtrlC {
+		if self.cancel != nil {
+			self.cancel()
+		}
+		self.Status = components.StatusAborted
+		return self, tea.Quit
+	}
+	if self.Running {
+		return self, nil
+	}
+	if self.Pending != nil {
+		action := *self.Pending
+		self.Pending = nil
+		if key.String() == "y" {
+			return self.startAction(action)
+		}
+		self.Message = ""
+		return self, nil
+	}
+	if handled, cmd := self.BubbleList.HandleKey(key); handled {
+		return self, cmd
+	}
+	if key.String() == "r" {
+		if self.Loading {
+			// a refresh is already underway
+			return self, nil
+		}
+		self.Loading = true
+		return self, self.loadCmd()
+	}
+	if len(self.Entries) == 0 {
+		return self, nil
+	}
+	action, hasAction := ActionFor(key.String(), self.SelectedEntry(), self.CurrentBranch)
+	if !hasAction {
+		return self, nil
+	}
+	if action.Confirm != "" {
+		self.Pending = &action
+		return self, nil
+	}
+	return self.startAction(action)
+}
+
+func (self Model) helpStr() string {
+	keys := [][]string{
+		{"enter", "switch"},
+		{"s", "sync"},
+		{"p", "propose"},
+		{"S", "ship"},
+		{"P", "park"},
+		{"x", "kill"},
+		{"r", "refresh"},
+		{"/", "filter"},
+		{"q", "quit"},
+	}
+	s := strings.Builder{}
+	for k, key := range keys {
+		if k > 0 {
+			s.WriteString("   ")
+		}
+		s.WriteString(self.Colors.HelpKey.Styled(key[0]))
+		s.WriteString(self.Colors.Help.Styled(" " + key[1]))
+	}
+	return s.String()
+}
+
+// loadCmd loads the dashboard data in the background.
+func (self Model) loadCmd() tea.Cmd {
+	load := self.Load
+	return func() tea.Msg {
+		data, err := load()
+		return loadedMsg{data: data, err: err}
+	}
+}
+
+// outputHeight provides how many lines of command output fit on the screen.
+func (self Model) outputHeight(rowCount int) int {
+	if self.height == 0 {
+		return components.WindowSize
+	}
+	// the title, filter, separator, status, help, and empty lines take up to 9 lines
+	return max(self.height-rowCount-9, minOutputHeight)
+}
+
+// rowStr provides the text to display the given row of the branch table.
+func (self Model) rowStr(row components.ListRow, widths []int) string {
+	entry := self.Entries[row.Index]
+	if !row.Match {
+		return self.AncestorStr(row.Index)
+	}
+	columns := entry.Columns()
+	for c := range columns {
+		columns[c] += strings.Repeat(" ", widths[c]-len([]rune(columns[c])))
+	}
+	text := strings.TrimRight(strings.Join(columns, "  "), " ")
+	cursor := " "
+	if row.Index == self.Cursor {
+		cursor = ">"
+	}
+	marker := " "
+	if entry.Branch == self.CurrentBranch {
+		marker = "*"
+	}
+	line := cursor + marker + " " + text
+	switch {
+	case row.Index == self.Cursor:
+		line = self.Colors.Selection.Styled(line)
+	case entry.Branch == self.CurrentBranch:
+		line = self.Colors.Initial.Styled(line)
+	}
+	return self.EntryNumberStr(row.Index) + line
+}
+
+// startAction runs the given action.
+func (self Model) startAction(action Action) (tea.Model, tea.Cmd) { //nolint:ireturn
+	self.Running = true
+	self.Message = ""
+	if action.Interactive {
+		command := action.Commands[0]
+		self.Output = append(self.Output, "$ "+CommandStr(command))
+		return self, tea.ExecProcess(commandFor(context.Background(), command, self.Executable), func(err error) tea.Msg {
+			return doneMsg{err: err}
+		})
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	self.cancel = cancel
+	self.messages = make(chan tea.Msg)
+	go streamCommands(ctx, action.Commands, self.Executable, self.messages)
+	return self, waitForMessage(self.messages)
+}
+
+// truncate shortens the given line of output to the width of the terminal.
+func (self Model) truncate(line string) string {
+	runes := []rune(line)
+	if self.width == 0 || len(runes) <= self.width {
+		return line
+	}
+	return string(runes[:self.width])
+}
+
+// windowRows provides the rows of the branch table that fit on the screen.
+func (self Model) windowRows() []components.ListRow {
+	rows := self.VisibleRows()
+	windowSize := components.WindowSize
+	if self.height > 0 {
+		// use up to two thirds of the screen for the branch table
+		windowSize = max(self.height*2/3-8, components.WindowSize)
+	}
+	cursorPos := slices.IndexFunc(rows, func(row components.ListRow) bool { return row.Index == self.Cursor })
+	window := slice.Window(slice.WindowArgs{
+		CursorPos:    max(cursorPos, 0),
+		ElementCount: len(rows),
+		WindowSize:   windowSize,
+	})
+	return rows[window.StartRow:window.EndRow]
+}
@@ -0,0 +1,120 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/git-town/git-town/v12/src/cli/dashboard"
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestModel(t *testing.T) {
+	t.Parallel()
+
+	newModel := func() dashboard.Model {
+		data := dashboard.Data{
+			CurrentBranch: "alpha",
+			Entries: []dashboard.Entry{
+				{SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "alpha"}}, //nolint:exhaustruct
+				{SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "beta"}},  //nolint:exhaustruct
+			},
+		}
+		return dashboard.Model{ //nolint:exhaustruct
+			BubbleList: components.NewBubbleList([]dashboard.Entry{}, 0),
+			Executable: "echo",
+			Load:       func() (dashboard.Data, error) { return data, nil },
+			Output:     []string{},
+		}
+	}
+
+	// update sends the given message to the given model and then runs all resulting commands to completion.
+	var update func(model tea.Model, msg tea.Msg) tea.Model
+	update = func(model tea.Model, msg tea.Msg) tea.Model {
+		model, cmd := model.Update(msg)
+		if cmd == nil {
+			return model
+		}
+		if next := cmd(); next != nil {
+			if _, isQuit := next.(tea.QuitMsg); !isQuit {
+				return update(model, next)
+			}
+		}
+		return model
+	}
+
+	t.Run("runs actions and streams their output", func(t *testing.T) {
+		t.Parallel()
+		model := newModel()
+		have := update(model, model.Init()())
+		have = update(have, tea.KeyMsg{Runes: []rune{'P'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+		result := have.(dashboard.Model)                                        //nolint:forcetypeassert
+		must.Eq(t, []string{"$ git town park alpha", "park alpha"}, result.Output)
+		must.False(t, result.Running)
+		must.EqOp(t, "Done.", result.Message)
+	})
+
+	t.Run("asks for confirmation before killing a branch", func(t *testing.T) {
+		t.Parallel()
+		model := newModel()
+		have := update(model, model.Init()())
+		have = update(have, tea.KeyMsg{Type: tea.KeyDown})                      //nolint:exhaustruct
+		have = update(have, tea.KeyMsg{Runes: []rune{'x'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+		result := have.(dashboard.Model)                                        //nolint:forcetypeassert
+		must.NotNil(t, result.Pending)
+		must.StrContains(t, result.View(), `Kill branch "beta"? (y/n)`)
+		have = update(have, tea.KeyMsg{Runes: []rune{'n'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+		result = have.(dashboard.Model)                                         //nolint:forcetypeassert
+		must.Nil(t, result.Pending)
+		must.Eq(t, []string{}, result.Output)
+		have = update(have, tea.KeyMsg{Runes: []rune{'x'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+		have = update(have, tea.KeyMsg{Runes: []rune{'y'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+		result = have.(dashboard.Model)                                         //nolint:forcetypeassert
+		must.Eq(t, []string{"$ git town kill beta", "kill beta"}, result.Output)
+	})
+
+	t.Run("ignores refreshes while loading", func(t *testing.T) {
+		t.Parallel()
+		model := newModel()
+		model.Loading = true
+		_, cmd := model.Update(tea.KeyMsg{Runes: []rune{'r'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+		must.Nil(t, cmd)
+	})
+
+	t.Run("View", func(t *testing.T) {
+		t.Parallel()
+		model := dashboard.Model{ //nolint:exhaustruct
+			BubbleList: components.BubbleList[dashboard.Entry]{ //nolint:exhaustruct
+				Cursor: 1,
+				Entries: []dashboard.Entry{
+					{SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, SyncStatus: gitdomain.SyncStatusUpToDate}},                                   //nolint:exhaustruct
+					{SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "alpha", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", SyncStatus: gitdomain.SyncStatusNotInSync}, Ahead: 1}, //nolint:exhaustruct
+					{SwitchBranchEntry: dialog.SwitchBranchEntry{Branch: "beta", BranchType: configdomain.BranchTypeParkedBranch, Indentation: "  ", SyncStatus: gitdomain.SyncStatusLocalOnly}},             //nolint:exhaustruct
+				},
+				MaxDigits:    1,
+				NumberFormat: "%d ",
+			},
+			CurrentBranch: "beta",
+			Message:       "Done.",
+			Output:        []string{"$ git town sync", "synced"},
+		}
+		have := model.View()
+		want := `Git Town dashboard
+
+0    main     main    up to date
+1 >    alpha          ↑1
+2  *   beta   parked  local only
+
+` + strings.Repeat("─", 40) + `
+$ git town sync
+synced
+` + strings.Repeat("\n", components.WindowSize-2) + `Done.
+
+  enter switch   s sync   p propose   S ship   P park   x kill   r refresh   / filter   q quit`
+		must.EqOp(t, want, have)
+	})
+}
@@ -0,0 +1,96 @@
+package dashboard
+
+import (
+	"bufio"
+	"context"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+)
+
+// Run displays the dashboard until the user quits it.
+func Run(args RunArgs) error {
+	model := Model{
+		BubbleList:    components.NewBubbleList([]Entry{}, 0),
+		CurrentBranch: "",
+		Executable:    args.Executable,
+		Load:          args.Load,
+		Loading:       true,
+		Message:       "",
+		Output:        []string{},
+		Pending:       nil,
+		Running:       false,
+		cancel:        nil,
+		height:        0,
+		messages:      nil,
+		width:         0,
+	}
+	_, err := tea.NewProgram(model, tea.WithAltScreen()).Run()
+	return err
+}
+
+type RunArgs struct {
+	Executable string               // path of the Git Town binary that executes the actions
+	Load       func() (Data, error) // loads the data to display
+}
+
+// doneMsg signals that the commands of an action have finished.
+type doneMsg struct {
+	err error
+}
+
+// loadedMsg delivers freshly loaded dashboard data.
+type loadedMsg struct {
+	data Data
+	err  error
+}
+
+// outputMsg contains a line of output of a running command.
+type outputMsg string
+
+// streamCommands runs the given commands one after the other
+// and sends their output line by line to the given channel.
+// It stops at the first failing command.
+func streamCommands(ctx context.Context, commands [][]string, executable string, messages chan<- tea.Msg) {
+	defer close(messages)
+	for _, command := range commands {
+		messages <- outputMsg("$ " + CommandStr(command))
+		cmd := commandFor(ctx, command, executable)
+		output, err := cmd.StdoutPipe()
+		if err != nil {
+			messages <- doneMsg{err: err}
+			return
+		}
+		cmd.Stderr = cmd.Stdout
+		if err = cmd.Start(); err != nil {
+			messages <- doneMsg{err: err}
+			return
+		}
+		scanner := bufio.NewScanner(output)
+		for scanner.Scan() {
+			messages <- outputMsg(scanner.Text())
+		}
+		if err = cmd.Wait(); err != nil {
+			messages <- doneMsg{err: err}
+			return
+		}
+	}
+	messages <- doneMsg{err: nil}
+}
+
+// commandFor provides an executable command for the given command of an action.
+func commandFor(ctx context.Context, command []string, executable string) *exec.Cmd {
+	name := command[0]
+	if name == GitTown {
+		name = executable
+	}
+	return exec.CommandContext(ctx, name, command[1:]...)
+}
+
+// waitForMessage provides the next message that the given channel receives.
+func waitForMessage(messages <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-messages
+	}
+}
@@ -2,6 +2,7 @@ package dialog
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -27,6 +28,9 @@ If you are not sure, select all :)
 // Aliases lets the user select which Git Town commands should have shorter aliases.
 // This includes asking the user and updating the respective settings based on the user selection.
 func Aliases(allAliasableCommands configdomain.AliasableCommands, existingAliases configdomain.Aliases, inputs components.TestInput) (configdomain.Aliases, bool, error) {
+	if components.UsePlainPrompt(inputs) {
+		return plainAliases(allAliasableCommands, existingAliases)
+	}
 	program := tea.NewProgram(AliasesModel{
 		AllAliasableCommands: allAliasableCommands,
 		BubbleList:           components.NewBubbleList(allAliasableCommands, 0),
@@ -139,7 +143,14 @@ func (self AliasesModel) View() string {
 	s.WriteString(self.Colors.Title.Styled(aliasesTitle))
 	s.WriteRune('\n')
 	s.WriteString(aliasesHelp)
-	for i, branch := range self.Entries {
+	for _, row := range self.VisibleRows() {
+		i := row.Index
+		branch := self.Entries[i]
+		if !row.Match {
+			s.WriteString(self.AncestorStr(i))
+			s.WriteRune('\n')
+			continue
+		}
 		s.WriteString(self.EntryNumberStr(i))
 		highlighted := self.Cursor == i
 		selection := self.CurrentSelections[i]
@@ -159,6 +170,7 @@ func (self AliasesModel) View() string {
 		}
 		s.WriteRune('\n')
 	}
+	s.WriteString(self.FilterStr())
 	s.WriteString("\n\n  ")
 	// up
 	s.WriteString(self.Colors.HelpKey.Styled("↑"))
@@ -180,6 +192,9 @@ func (self AliasesModel) View() string {
 	s.WriteString(self.Colors.Help.Styled("/"))
 	s.WriteString(self.Colors.HelpKey.Styled("n"))
 	s.WriteString(self.Colors.Help.Styled(" select all/none   "))
+	// filter
+	s.WriteString(self.Colors.HelpKey.Styled("/"))
+	s.WriteString(self.Colors.Help.Styled(" filter   "))
 	// numbers
 	s.WriteString(self.Colors.HelpKey.Styled("0"))
 	s.WriteString(self.Colors.Help.Styled("-"))
@@ -198,6 +213,34 @@ func (self AliasesModel) View() string {
 	return s.String()
 }
 
+// plainAliases asks for the Git Town commands to alias using the line-based prompt.
+// Commands that the user does not select keep aliases that call external commands.
+func plainAliases(allAliasableCommands configdomain.AliasableCommands, existingAliases configdomain.Aliases) (configdomain.Aliases, bool, error) {
+	currentSelections := NewAliasSelections(allAliasableCommands, existingAliases)
+	checked := []int{}
+	for s, selection := range currentSelections {
+		if selection == AliasSelectionGT {
+			checked = append(checked, s)
+		}
+	}
+	checked, aborted, err := components.StdinPrompt().MultiSelect(allAliasableCommands.Strings(), checked, aliasesTitle, aliasesHelp)
+	if err != nil || aborted {
+		return configdomain.Aliases{}, aborted, err
+	}
+	selectedCommands := configdomain.AliasableCommands{}
+	for s := range currentSelections {
+		switch {
+		case slices.Contains(checked, s):
+			currentSelections[s] = AliasSelectionGT
+			selectedCommands = append(selectedCommands, allAliasableCommands[s])
+		case currentSelections[s] == AliasSelectionGT:
+			currentSelections[s] = AliasSelectionNone
+		}
+	}
+	fmt.Printf(messages.AliasedCommands, components.FormattedSelection(DetermineAliasSelectionText(selectedCommands), aborted))
+	return DetermineAliasResult(currentSelections, allAliasableCommands, existingAliases), aborted, nil
+}
+
 func DetermineAliasResult(selections []AliasSelection, allAliasableCommands configdomain.AliasableCommands, existingAliases configdomain.Aliases) configdomain.Aliases {
 	result := configdomain.Aliases{}
 	for s, selection := range selections {
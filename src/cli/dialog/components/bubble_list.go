@@ -2,10 +2,13 @@ package components
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/git-town/git-town/v12/src/gohacks"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
 	"github.com/muesli/termenv"
 )
 
@@ -24,6 +27,8 @@ type BubbleList[S fmt.Stringer] struct {
 	Dim          termenv.Style // style for dim output
 	Entries      []S           // the entries to select from
 	EntryNumber  string        // the manually entered entry number
+	Filter       string        // the text that the entries must fuzzy-match to be selectable
+	Filtering    bool          // whether the user is currently typing the filter text
 	MaxDigits    int           // how many digits make up an entry number
 	NumberFormat string        // template for formatting the entry number
 	Status       status
@@ -38,23 +43,65 @@ func NewBubbleList[S fmt.Stringer](entries []S, cursor int) BubbleList[S] {
 		Dim:          termenv.String().Faint(),
 		Entries:      entries,
 		EntryNumber:  "",
+		Filter:       "",
+		Filtering:    false,
 		MaxDigits:    numberLen,
 		NumberFormat: fmt.Sprintf("%%0%dd ", numberLen),
 	}
 }
 
+// ListRow describes a row that a BubbleList displays.
+type ListRow struct {
+	Index int  // position of the displayed entry in the list
+	Match bool // whether the entry matches the filter, rows that don't match display ancestors of matching entries
+}
+
+// Nested is implemented by list entries that display as a tree.
+// When filtering, lists show the ancestors of matching entries.
+type Nested interface {
+	// NestingLevel provides how deep this entry is nested in the tree, 0 for root entries.
+	NestingLevel() int
+}
+
 // Aborted indicates whether the user has Aborted this components.
 func (self *BubbleList[S]) Aborted() bool {
 	return self.Status == StatusAborted
 }
 
+// AncestorStr provides a greyed out string to print the entry with the given index
+// as the ancestor of an entry that matches the filter.
+func (self *BubbleList[S]) AncestorStr(index int) string {
+	return strings.Repeat(" ", self.MaxDigits+1) + self.Dim.Styled("  "+self.Entries[index].String())
+}
+
 // EntryNumberStr provides a colorized string to print the given entry number.
 func (self *BubbleList[S]) EntryNumberStr(number int) string {
 	return self.Dim.Styled(fmt.Sprintf(self.NumberFormat, number))
 }
 
+// FilterStr provides the text to print the current filter,
+// or an empty string if the user doesn't filter.
+func (self *BubbleList[S]) FilterStr() string {
+	if self.Filter == "" && !self.Filtering {
+		return ""
+	}
+	result := "\n" + self.Colors.HelpKey.Styled("/") + self.Filter
+	if self.Filtering {
+		result += self.Colors.Help.Styled("_")
+	}
+	if len(self.matchingIndexes()) == 0 {
+		result += self.Dim.Styled("  (no matching entries)")
+	}
+	return result
+}
+
 // HandleKey handles keypresses that are common for all bubbleLists.
 func (self *BubbleList[S]) HandleKey(key tea.KeyMsg) (bool, tea.Cmd) {
+	if self.Filtering {
+		if handled, cmd := self.handleFilterKey(key); handled {
+			return handled, cmd
+		}
+	}
 	switch key.Type { //nolint:exhaustive
 	case tea.KeyUp, tea.KeyShiftTab:
 		self.moveCursorUp()
@@ -80,7 +127,7 @@ func (self *BubbleList[S]) HandleKey(key tea.KeyMsg) (bool, tea.Cmd) {
 		}
 		number64, _ := strconv.ParseInt(self.EntryNumber, 10, 0)
 		number := int(number64)
-		if number < len(self.Entries) {
+		if number < len(self.Entries) && self.IsMatch(number) {
 			self.Cursor = number
 		}
 	case "k":
@@ -98,40 +145,165 @@ func (self *BubbleList[S]) HandleKey(key tea.KeyMsg) (bool, tea.Cmd) {
 	case "q":
 		self.Status = StatusAborted
 		return true, tea.Quit
+	case "/":
+		self.Filtering = true
+		return true, nil
 	}
 	return false, nil
 }
 
+// IsMatch indicates whether the entry with the given index matches the current filter.
+func (self *BubbleList[S]) IsMatch(index int) bool {
+	return FuzzyMatch(strings.TrimSpace(self.Entries[index].String()), self.Filter)
+}
+
 func (self BubbleList[S]) SelectedEntry() S { //nolint:ireturn
 	return self.Entries[self.Cursor]
 }
 
+// VisibleRows provides the rows to display: all entries that match the filter
+// and the ancestors of nested matching entries.
+func (self *BubbleList[S]) VisibleRows() []ListRow {
+	result := make([]ListRow, 0, len(self.Entries))
+	if self.Filter == "" {
+		for e := range self.Entries {
+			result = append(result, ListRow{Index: e, Match: true})
+		}
+		return result
+	}
+	type ancestor struct {
+		index int
+		level int
+		shown bool
+	}
+	ancestors := []ancestor{}
+	for e, entry := range self.Entries {
+		level := 0
+		if nested, isNested := any(entry).(Nested); isNested {
+			level = nested.NestingLevel()
+		}
+		for len(ancestors) > 0 && ancestors[len(ancestors)-1].level >= level {
+			ancestors = ancestors[:len(ancestors)-1]
+		}
+		isMatch := self.IsMatch(e)
+		if isMatch {
+			for a := range ancestors {
+				if !ancestors[a].shown {
+					result = append(result, ListRow{Index: ancestors[a].index, Match: false})
+					ancestors[a].shown = true
+				}
+			}
+			result = append(result, ListRow{Index: e, Match: true})
+		}
+		ancestors = append(ancestors, ancestor{index: e, level: level, shown: isMatch})
+	}
+	return result
+}
+
+// WindowRows provides the visible rows around the cursor that fit into the dialog.
+func (self *BubbleList[S]) WindowRows() []ListRow {
+	rows := self.VisibleRows()
+	cursorPos := slices.IndexFunc(rows, func(row ListRow) bool { return row.Index == self.Cursor })
+	window := slice.Window(slice.WindowArgs{
+		CursorPos:    max(cursorPos, 0),
+		ElementCount: len(rows),
+		WindowSize:   WindowSize,
+	})
+	return rows[window.StartRow:window.EndRow]
+}
+
+// handleFilterKey handles keypresses while the user types the filter text.
+func (self *BubbleList[S]) handleFilterKey(key tea.KeyMsg) (bool, tea.Cmd) {
+	switch key.Type { //nolint:exhaustive
+	case tea.KeyRunes:
+		self.Filter += string(key.Runes)
+		self.MoveCursorToMatch()
+		return true, nil
+	case tea.KeyBackspace:
+		if self.Filter != "" {
+			runes := []rune(self.Filter)
+			self.Filter = string(runes[:len(runes)-1])
+		}
+		return true, nil
+	case tea.KeyEsc:
+		self.Filter = ""
+		self.Filtering = false
+		return true, nil
+	case tea.KeyEnter:
+		self.Filtering = false
+		// there is nothing to select if no entry matches
+		return len(self.matchingIndexes()) == 0, nil
+	}
+	return false, nil
+}
+
+// matchingIndexes provides the indexes of all entries that match the filter.
+func (self *BubbleList[S]) matchingIndexes() []int {
+	result := make([]int, 0, len(self.Entries))
+	for e := range self.Entries {
+		if self.IsMatch(e) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
 func (self *BubbleList[S]) moveCursorDown() {
-	if self.Cursor < len(self.Entries)-1 {
-		self.Cursor++
-	} else {
-		self.Cursor = 0
+	matches := self.matchingIndexes()
+	if len(matches) == 0 {
+		return
+	}
+	for _, match := range matches {
+		if match > self.Cursor {
+			self.Cursor = match
+			return
+		}
+	}
+	self.Cursor = matches[0]
+}
+
+// MoveCursorToMatch moves the cursor to the first matching entry if the entry at the cursor doesn't match the filter.
+func (self *BubbleList[S]) MoveCursorToMatch() {
+	matches := self.matchingIndexes()
+	if len(matches) > 0 && !slices.Contains(matches, self.Cursor) {
+		self.Cursor = matches[0]
 	}
 }
 
 func (self *BubbleList[S]) moveCursorUp() {
-	if self.Cursor > 0 {
-		self.Cursor--
-	} else {
-		self.Cursor = len(self.Entries) - 1
+	matches := self.matchingIndexes()
+	if len(matches) == 0 {
+		return
+	}
+	for m := len(matches) - 1; m >= 0; m-- {
+		if matches[m] < self.Cursor {
+			self.Cursor = matches[m]
+			return
+		}
 	}
+	self.Cursor = matches[len(matches)-1]
 }
 
 func (self *BubbleList[S]) movePageDown() {
-	self.Cursor += 10
-	if self.Cursor >= len(self.Entries) {
-		self.Cursor = len(self.Entries) - 1
+	matches := self.matchingIndexes()
+	if len(matches) == 0 {
+		return
+	}
+	pos := slices.Index(matches, self.Cursor) + 10
+	if pos >= len(matches) {
+		pos = len(matches) - 1
 	}
+	self.Cursor = matches[pos]
 }
 
 func (self *BubbleList[S]) movePageUp() {
-	self.Cursor -= 10
-	if self.Cursor < 0 {
-		self.Cursor = 0
+	matches := self.matchingIndexes()
+	if len(matches) == 0 {
+		return
+	}
+	pos := slices.Index(matches, self.Cursor) - 10
+	if pos < 0 {
+		pos = 0
 	}
+	self.Cursor = matches[pos]
 }
@@ -0,0 +1,122 @@
+package components_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestBubbleList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("HandleKey", func(t *testing.T) {
+		t.Parallel()
+		t.Run("typing a filter", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewBubbleList(gitdomain.NewLocalBranchNames("main", "alpha", "beta", "gamma"), 0)
+			handled, _ := list.HandleKey(tea.KeyMsg{Runes: []rune{'/'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+			must.True(t, handled)
+			must.True(t, list.Filtering)
+			handled, _ = list.HandleKey(tea.KeyMsg{Runes: []rune{'m', 'a'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+			must.True(t, handled)
+			must.EqOp(t, "ma", list.Filter)
+			must.EqOp(t, 0, list.Cursor)
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyDown}) //nolint:exhaustruct
+			must.EqOp(t, 3, list.Cursor)
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyDown}) //nolint:exhaustruct
+			must.EqOp(t, 0, list.Cursor)
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyBackspace}) //nolint:exhaustruct
+			must.EqOp(t, "m", list.Filter)
+			handled, _ = list.HandleKey(tea.KeyMsg{Type: tea.KeyEnter}) //nolint:exhaustruct
+			must.False(t, handled)
+			must.False(t, list.Filtering)
+		})
+		t.Run("the cursor moves to the first match", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewBubbleList(gitdomain.NewLocalBranchNames("main", "alpha", "beta", "gamma"), 0)
+			list.Filtering = true
+			list.HandleKey(tea.KeyMsg{Runes: []rune{'b'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+			must.EqOp(t, 2, list.Cursor)
+		})
+		t.Run("enter does nothing if no entry matches", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewBubbleList(gitdomain.NewLocalBranchNames("main", "alpha"), 0)
+			list.Filtering = true
+			list.HandleKey(tea.KeyMsg{Runes: []rune{'z'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+			handled, _ := list.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})       //nolint:exhaustruct
+			must.True(t, handled)
+			must.EqOp(t, components.StatusActive, list.Status)
+		})
+		t.Run("escape removes the filter instead of aborting", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewBubbleList(gitdomain.NewLocalBranchNames("main", "alpha"), 0)
+			list.Filtering = true
+			list.Filter = "al"
+			handled, _ := list.HandleKey(tea.KeyMsg{Type: tea.KeyEsc}) //nolint:exhaustruct
+			must.True(t, handled)
+			must.EqOp(t, "", list.Filter)
+			must.False(t, list.Filtering)
+			must.False(t, list.Aborted())
+		})
+	})
+
+	t.Run("VisibleRows", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no filter", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewBubbleList(gitdomain.NewLocalBranchNames("main", "alpha"), 0)
+			have := list.VisibleRows()
+			want := []components.ListRow{
+				{Index: 0, Match: true},
+				{Index: 1, Match: true},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("flat list", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewBubbleList(gitdomain.NewLocalBranchNames("main", "alpha", "beta", "gamma"), 0)
+			list.Filter = "ma"
+			have := list.VisibleRows()
+			want := []components.ListRow{
+				{Index: 0, Match: true},
+				{Index: 3, Match: true},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("nested list", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewBubbleList([]nestedEntry{
+				{level: 0, name: "main"},
+				{level: 1, name: "alpha"},
+				{level: 2, name: "alpha1"},
+				{level: 1, name: "beta"},
+				{level: 2, name: "beta1"},
+				{level: 0, name: "other"},
+			}, 0)
+			list.Filter = "alpha1"
+			have := list.VisibleRows()
+			want := []components.ListRow{
+				{Index: 0, Match: false},
+				{Index: 1, Match: false},
+				{Index: 2, Match: true},
+			}
+			must.Eq(t, want, have)
+		})
+	})
+}
+
+type nestedEntry struct {
+	level int
+	name  string
+}
+
+func (self nestedEntry) NestingLevel() int {
+	return self.level
+}
+
+func (self nestedEntry) String() string {
+	return self.name
+}
@@ -0,0 +1,180 @@
+package components
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
+	"github.com/muesli/termenv"
+)
+
+// CheckList lets the user select any number of the given entries.
+// The given selections contain the indexes of the preselected entries,
+// the result contains the indexes of the selected entries in list order.
+func CheckList[S fmt.Stringer](entries []S, selections []int, title, help string, inputs TestInput) (selected []int, aborted bool, err error) {
+	if UsePlainPrompt(inputs) {
+		selected, aborted, err = stdinPrompt.MultiSelect(slice.Strings(entries), selections, title, help)
+		slices.Sort(selected)
+		return selected, aborted, err
+	}
+	program := tea.NewProgram(CheckListModel[S]{
+		BubbleList:    NewBubbleList(entries, 0),
+		Selections:    slices.Clone(selections),
+		help:          help,
+		selectedColor: termenv.String().Foreground(termenv.ANSIGreen),
+		title:         title,
+	})
+	SendInputs(inputs, program)
+	dialogResult, err := program.Run()
+	if err != nil {
+		return selections, false, err
+	}
+	result := dialogResult.(CheckListModel[S]) //nolint:forcetypeassert
+	selected = slices.Clone(result.Selections)
+	slices.Sort(selected)
+	return selected, result.Aborted(), nil
+}
+
+// CheckListModel is a BubbleList whose entries have checkboxes.
+type CheckListModel[S fmt.Stringer] struct {
+	BubbleList[S]
+	Selections    []int  // indexes of the checked entries
+	help          string // help text to display before the list
+	selectedColor termenv.Style
+	title         string // title to display before the help text
+}
+
+func (self CheckListModel[S]) Init() tea.Cmd {
+	return nil
+}
+
+// IsRowChecked indicates whether the row with the given index is checked.
+func (self *CheckListModel[S]) IsRowChecked(row int) bool {
+	return slices.Contains(self.Selections, row)
+}
+
+// SelectAll checks all entries that match the current filter.
+func (self *CheckListModel[S]) SelectAll() {
+	for e := range self.Entries {
+		if self.IsMatch(e) {
+			self.Selections = slice.AppendAllMissing(self.Selections, e)
+		}
+	}
+}
+
+// SelectNone unchecks all entries that match the current filter.
+func (self *CheckListModel[S]) SelectNone() {
+	for e := range self.Entries {
+		if self.IsMatch(e) {
+			self.Selections = slice.Remove(self.Selections, e)
+		}
+	}
+}
+
+// ToggleCurrentEntry checks the currently selected entry if it is unchecked and unchecks it if it is checked.
+func (self *CheckListModel[S]) ToggleCurrentEntry() {
+	if self.IsRowChecked(self.Cursor) {
+		self.Selections = slice.Remove(self.Selections, self.Cursor)
+	} else {
+		self.Selections = slice.AppendAllMissing(self.Selections, self.Cursor)
+	}
+}
+
+func (self CheckListModel[S]) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolint:ireturn
+	keyMsg, isKeyMsg := msg.(tea.KeyMsg)
+	if !isKeyMsg {
+		return self, nil
+	}
+	if handled, cmd := self.BubbleList.HandleKey(keyMsg); handled {
+		return self, cmd
+	}
+	switch keyMsg.Type { //nolint:exhaustive
+	case tea.KeySpace:
+		self.ToggleCurrentEntry()
+		return self, nil
+	case tea.KeyEnter:
+		self.Status = StatusDone
+		return self, tea.Quit
+	}
+	switch keyMsg.String() {
+	case "a":
+		self.SelectAll()
+	case "n":
+		self.SelectNone()
+	case "o":
+		self.ToggleCurrentEntry()
+	}
+	return self, nil
+}
+
+func (self CheckListModel[S]) View() string {
+	if self.Status != StatusActive {
+		return ""
+	}
+	s := strings.Builder{}
+	s.WriteRune('\n')
+	s.WriteString(self.Colors.Title.Styled(self.title))
+	s.WriteRune('\n')
+	s.WriteString(self.help)
+	for _, row := range self.WindowRows() {
+		i := row.Index
+		entry := self.Entries[i]
+		if !row.Match {
+			s.WriteString(self.AncestorStr(i))
+			s.WriteRune('\n')
+			continue
+		}
+		selected := self.Cursor == i
+		checked := self.IsRowChecked(i)
+		s.WriteString(self.EntryNumberStr(i))
+		switch {
+		case selected && checked:
+			s.WriteString(self.Colors.Selection.Styled("> [x] " + entry.String()))
+		case selected && !checked:
+			s.WriteString(self.Colors.Selection.Styled("> [ ] " + entry.String()))
+		case !selected && checked:
+			s.WriteString(self.selectedColor.Styled("  [x] " + entry.String()))
+		case !selected && !checked:
+			s.WriteString("  [ ] " + entry.String())
+		}
+		s.WriteRune('\n')
+	}
+	s.WriteString(self.FilterStr())
+	s.WriteString("\n\n  ")
+	// up
+	s.WriteString(self.Colors.HelpKey.Styled("↑"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("k"))
+	s.WriteString(self.Colors.Help.Styled(" up   "))
+	// down
+	s.WriteString(self.Colors.HelpKey.Styled("↓"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("j"))
+	s.WriteString(self.Colors.Help.Styled(" down   "))
+	// toggle
+	s.WriteString(self.Colors.HelpKey.Styled("space"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("o"))
+	s.WriteString(self.Colors.Help.Styled(" toggle   "))
+	// select all/none
+	s.WriteString(self.Colors.HelpKey.Styled("a"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("n"))
+	s.WriteString(self.Colors.Help.Styled(" select all/none   "))
+	// filter
+	s.WriteString(self.Colors.HelpKey.Styled("/"))
+	s.WriteString(self.Colors.Help.Styled(" filter   "))
+	// accept
+	s.WriteString(self.Colors.HelpKey.Styled("enter"))
+	s.WriteString(self.Colors.Help.Styled(" accept   "))
+	// abort
+	s.WriteString(self.Colors.HelpKey.Styled("q"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("esc"))
+	s.WriteString(self.Colors.Help.Styled("/"))
+	s.WriteString(self.Colors.HelpKey.Styled("ctrl-c"))
+	s.WriteString(self.Colors.Help.Styled(" abort"))
+	return s.String()
+}
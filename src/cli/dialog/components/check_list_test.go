@@ -0,0 +1,85 @@
+package components_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestCheckList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("IsRowChecked", func(t *testing.T) {
+		t.Parallel()
+		model := components.CheckListModel[gitdomain.LocalBranchName]{ //nolint:exhaustruct
+			BubbleList: components.NewBubbleList(gitdomain.NewLocalBranchNames("alpha", "beta", "gamma"), 0),
+			Selections: []int{0, 2},
+		}
+		must.True(t, model.IsRowChecked(0))
+		must.False(t, model.IsRowChecked(1))
+		must.True(t, model.IsRowChecked(2))
+	})
+
+	t.Run("SelectAll", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no filter", func(t *testing.T) {
+			t.Parallel()
+			model := components.CheckListModel[gitdomain.LocalBranchName]{ //nolint:exhaustruct
+				BubbleList: components.NewBubbleList(gitdomain.NewLocalBranchNames("alpha", "beta", "gamma"), 0),
+				Selections: []int{1},
+			}
+			model.SelectAll()
+			must.SliceContainsAll(t, []int{0, 1, 2}, model.Selections)
+		})
+		t.Run("only selects the entries matching the filter", func(t *testing.T) {
+			t.Parallel()
+			model := components.CheckListModel[gitdomain.LocalBranchName]{ //nolint:exhaustruct
+				BubbleList: components.NewBubbleList(gitdomain.NewLocalBranchNames("alpha", "beta", "gamma"), 0),
+				Selections: []int{},
+			}
+			model.Filter = "ma"
+			model.SelectAll()
+			must.Eq(t, []int{2}, model.Selections)
+		})
+	})
+
+	t.Run("SelectNone", func(t *testing.T) {
+		t.Parallel()
+		model := components.CheckListModel[gitdomain.LocalBranchName]{ //nolint:exhaustruct
+			BubbleList: components.NewBubbleList(gitdomain.NewLocalBranchNames("alpha", "beta", "gamma"), 0),
+			Selections: []int{0, 2},
+		}
+		model.SelectNone()
+		must.Eq(t, []int{}, model.Selections)
+	})
+
+	t.Run("ToggleCurrentEntry", func(t *testing.T) {
+		t.Parallel()
+		model := components.CheckListModel[gitdomain.LocalBranchName]{ //nolint:exhaustruct
+			BubbleList: components.NewBubbleList(gitdomain.NewLocalBranchNames("alpha", "beta"), 1),
+			Selections: []int{},
+		}
+		model.ToggleCurrentEntry()
+		must.Eq(t, []int{1}, model.Selections)
+		model.ToggleCurrentEntry()
+		must.Eq(t, []int{}, model.Selections)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+		var model tea.Model = components.CheckListModel[gitdomain.LocalBranchName]{ //nolint:exhaustruct
+			BubbleList: components.NewBubbleList(gitdomain.NewLocalBranchNames("alpha", "beta"), 0),
+			Selections: []int{},
+		}
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeySpace})                     //nolint:exhaustruct
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})                      //nolint:exhaustruct
+		model, _ = model.Update(tea.KeyMsg{Runes: []rune{'o'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})                     //nolint:exhaustruct
+		result := model.(components.CheckListModel[gitdomain.LocalBranchName])      //nolint:forcetypeassert
+		must.Eq(t, []int{0, 1}, result.Selections)
+		must.EqOp(t, components.StatusDone, result.Status)
+	})
+}
@@ -0,0 +1,20 @@
+package components
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// FuzzyMatch indicates whether the given text contains all characters of the given filter in the same order.
+// The comparison ignores case.
+func FuzzyMatch(text, filter string) bool {
+	text = strings.ToLower(text)
+	for _, char := range strings.ToLower(filter) {
+		pos := strings.IndexRune(text, char)
+		if pos == -1 {
+			return false
+		}
+		text = text[pos+utf8.RuneLen(char):]
+	}
+	return true
+}
@@ -0,0 +1,25 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/shoenig/test/must"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	t.Parallel()
+	tests := map[string]bool{
+		"":          true,
+		"feat":      true,
+		"fb":        true,
+		"FEAT":      true,
+		"fbar":      true,
+		"barf":      false,
+		"feature-x": false,
+	}
+	for give, want := range tests {
+		have := components.FuzzyMatch("feature-bar", give)
+		must.EqOp(t, want, have, must.Sprint(give))
+	}
+}
@@ -0,0 +1,178 @@
+package components
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/messages"
+	"golang.org/x/term"
+)
+
+// PlainPrompt asks the user for input line by line.
+// Git Town uses it instead of the BubbleTea dialogs when STDIN is not a terminal,
+// for example when the answers get piped in or in CI environments.
+type PlainPrompt struct {
+	input  *bufio.Reader
+	output io.Writer
+}
+
+func NewPlainPrompt(input io.Reader, output io.Writer) PlainPrompt {
+	return PlainPrompt{
+		input:  bufio.NewReader(input),
+		output: output,
+	}
+}
+
+// the prompt that reads from STDIN, shared by all dialogs so that no buffered input gets lost
+var stdinPrompt = NewPlainPrompt(os.Stdin, os.Stdout) //nolint:gochecknoglobals
+
+// StdinPrompt provides the line-based prompt that reads from STDIN.
+func StdinPrompt() PlainPrompt {
+	return stdinPrompt
+}
+
+// UsePlainPrompt indicates whether dialogs should use the line-based prompt instead of BubbleTea.
+// End-to-end tests provide their keystrokes to the BubbleTea dialogs.
+func UsePlainPrompt(inputs TestInput) bool {
+	return len(inputs) == 0 && !term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// MultiSelect lets the user select any number of the given entries.
+// The given selections are the indexes of the preselected entries,
+// the result contains the indexes of the selected entries.
+func (self PlainPrompt) MultiSelect(entries []string, selections []int, title, help string) (selected []int, aborted bool, err error) {
+	self.printHeader(title, help)
+	for e, entry := range entries {
+		checkbox := "[ ]"
+		if slices.Contains(selections, e) {
+			checkbox = "[x]"
+		}
+		fmt.Fprintf(self.output, "  %d %s %s\n", e, checkbox, entry)
+	}
+	defaultText := joinInts(selections)
+	if defaultText == "" {
+		defaultText = "none"
+	}
+	for {
+		answer, aborted, err := self.readLine(fmt.Sprintf("\nEnter the numbers of the entries to select [%s]: ", defaultText))
+		if err != nil || aborted {
+			return selections, aborted, err
+		}
+		if answer == "" {
+			return selections, false, nil
+		}
+		switch answer {
+		case "all":
+			selected = make([]int, len(entries))
+			for e := range entries {
+				selected[e] = e
+			}
+			return selected, false, nil
+		case "none":
+			return []int{}, false, nil
+		}
+		selected, isValid := parseEntryNumbers(answer, len(entries))
+		if isValid {
+			return selected, false, nil
+		}
+		fmt.Fprintf(self.output, messages.DialogEntryNumbersInvalid, len(entries)-1)
+	}
+}
+
+// RadioList lets the user select one of the given entries.
+// The cursor is the index of the preselected entry.
+func (self PlainPrompt) RadioList(entries []string, cursor int, title, help string) (selected int, aborted bool, err error) {
+	self.printHeader(title, help)
+	for e, entry := range entries {
+		marker := " "
+		if e == cursor {
+			marker = ">"
+		}
+		fmt.Fprintf(self.output, "%s %d %s\n", marker, e, entry)
+	}
+	for {
+		answer, aborted, err := self.readLine(fmt.Sprintf("\nEnter a number [%d]: ", cursor))
+		if err != nil || aborted {
+			return cursor, aborted, err
+		}
+		if answer == "" {
+			return cursor, false, nil
+		}
+		number, err := strconv.Atoi(answer)
+		if err == nil && number >= 0 && number < len(entries) {
+			return number, false, nil
+		}
+		fmt.Fprintf(self.output, messages.DialogEntryNumberInvalid, len(entries)-1)
+	}
+}
+
+// TextDisplay shows the given text to the user.
+// Since there is nothing to decide, it does not wait for input.
+func (self PlainPrompt) TextDisplay(title, text string) {
+	self.printHeader(title, text)
+}
+
+// TextField lets the user enter a single line of text.
+// Entering nothing keeps the existing value.
+func (self PlainPrompt) TextField(args TextFieldArgs) (string, bool, error) {
+	self.printHeader(args.Title, args.Help)
+	prompt := args.Prompt
+	if args.ExistingValue != "" {
+		prompt = fmt.Sprintf("%s[%s] ", prompt, args.ExistingValue)
+	}
+	answer, aborted, err := self.readLine(prompt)
+	if err != nil || aborted || answer == "" {
+		return args.ExistingValue, aborted, err
+	}
+	return answer, false, nil
+}
+
+func (self PlainPrompt) printHeader(title, help string) {
+	fmt.Fprintf(self.output, "\n%s\n%s", title, help)
+}
+
+// readLine prints the given prompt and provides the next line of input.
+// The end of the input aborts the dialog.
+func (self PlainPrompt) readLine(prompt string) (line string, aborted bool, err error) {
+	fmt.Fprint(self.output, prompt)
+	line, err = self.input.ReadString('\n')
+	// input that doesn't come from a terminal isn't echoed, so end the prompt line here
+	fmt.Fprintln(self.output)
+	if errors.Is(err, io.EOF) {
+		if line == "" {
+			return "", true, nil
+		}
+		err = nil
+	}
+	return strings.TrimSpace(line), false, err
+}
+
+func joinInts(numbers []int) string {
+	texts := make([]string, len(numbers))
+	for n, number := range numbers {
+		texts[n] = strconv.Itoa(number)
+	}
+	return strings.Join(texts, ",")
+}
+
+// parseEntryNumbers provides the entry numbers in the given space or comma separated list.
+// Indicates whether all of them refer to one of the given number of entries.
+func parseEntryNumbers(text string, entryCount int) ([]int, bool) {
+	result := []int{}
+	for _, field := range strings.Fields(strings.ReplaceAll(text, ",", " ")) {
+		number, err := strconv.Atoi(field)
+		if err != nil || number < 0 || number >= entryCount {
+			return result, false
+		}
+		if !slices.Contains(result, number) {
+			result = append(result, number)
+		}
+	}
+	return result, true
+}
@@ -0,0 +1,132 @@
+package components_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/shoenig/test/must"
+)
+
+func TestPlainPrompt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("MultiSelect", func(t *testing.T) {
+		t.Parallel()
+		entries := []string{"one", "two", "three"}
+		t.Run("selects the entered numbers", func(t *testing.T) {
+			t.Parallel()
+			output := strings.Builder{}
+			prompt := components.NewPlainPrompt(strings.NewReader("2, 0\n"), &output)
+			selected, aborted, err := prompt.MultiSelect(entries, []int{1}, "Title", "help\n")
+			must.NoError(t, err)
+			must.False(t, aborted)
+			must.Eq(t, []int{2, 0}, selected)
+			must.StrContains(t, output.String(), "  1 [x] two\n")
+			must.StrContains(t, output.String(), "[1]: ")
+		})
+		t.Run("empty input keeps the preselected entries", func(t *testing.T) {
+			t.Parallel()
+			prompt := components.NewPlainPrompt(strings.NewReader("\n"), &strings.Builder{})
+			selected, aborted, err := prompt.MultiSelect(entries, []int{1}, "Title", "help\n")
+			must.NoError(t, err)
+			must.False(t, aborted)
+			must.Eq(t, []int{1}, selected)
+		})
+		t.Run("all and none", func(t *testing.T) {
+			t.Parallel()
+			prompt := components.NewPlainPrompt(strings.NewReader("all\nnone\n"), &strings.Builder{})
+			selected, _, err := prompt.MultiSelect(entries, []int{1}, "Title", "help\n")
+			must.NoError(t, err)
+			must.Eq(t, []int{0, 1, 2}, selected)
+			selected, _, err = prompt.MultiSelect(entries, []int{1}, "Title", "help\n")
+			must.NoError(t, err)
+			must.Eq(t, []int{}, selected)
+		})
+		t.Run("asks again after invalid input", func(t *testing.T) {
+			t.Parallel()
+			output := strings.Builder{}
+			prompt := components.NewPlainPrompt(strings.NewReader("1 3\n1\n"), &output)
+			selected, aborted, err := prompt.MultiSelect(entries, []int{}, "Title", "help\n")
+			must.NoError(t, err)
+			must.False(t, aborted)
+			must.Eq(t, []int{1}, selected)
+			must.StrContains(t, output.String(), "Please enter numbers between 0 and 2")
+		})
+	})
+
+	t.Run("RadioList", func(t *testing.T) {
+		t.Parallel()
+		entries := []string{"one", "two", "three"}
+		t.Run("selects the entered number", func(t *testing.T) {
+			t.Parallel()
+			output := strings.Builder{}
+			prompt := components.NewPlainPrompt(strings.NewReader("2\n"), &output)
+			selected, aborted, err := prompt.RadioList(entries, 1, "Title", "help\n")
+			must.NoError(t, err)
+			must.False(t, aborted)
+			must.EqOp(t, 2, selected)
+			want := "\nTitle\nhelp\n  0 one\n> 1 two\n  2 three\n\nEnter a number [1]: \n"
+			must.EqOp(t, want, output.String())
+		})
+		t.Run("empty input selects the entry at the cursor", func(t *testing.T) {
+			t.Parallel()
+			prompt := components.NewPlainPrompt(strings.NewReader("\n"), &strings.Builder{})
+			selected, aborted, err := prompt.RadioList(entries, 1, "Title", "help\n")
+			must.NoError(t, err)
+			must.False(t, aborted)
+			must.EqOp(t, 1, selected)
+		})
+		t.Run("asks again after invalid input", func(t *testing.T) {
+			t.Parallel()
+			output := strings.Builder{}
+			prompt := components.NewPlainPrompt(strings.NewReader("zero\n3\n0"), &output)
+			selected, aborted, err := prompt.RadioList(entries, 1, "Title", "help\n")
+			must.NoError(t, err)
+			must.False(t, aborted)
+			must.EqOp(t, 0, selected)
+			must.EqOp(t, 2, strings.Count(output.String(), "Please enter a number between 0 and 2."))
+		})
+		t.Run("end of input aborts", func(t *testing.T) {
+			t.Parallel()
+			prompt := components.NewPlainPrompt(strings.NewReader(""), &strings.Builder{})
+			_, aborted, err := prompt.RadioList(entries, 1, "Title", "help\n")
+			must.NoError(t, err)
+			must.True(t, aborted)
+		})
+	})
+
+	t.Run("TextField", func(t *testing.T) {
+		t.Parallel()
+		t.Run("provides the entered text", func(t *testing.T) {
+			t.Parallel()
+			output := strings.Builder{}
+			prompt := components.NewPlainPrompt(strings.NewReader("  new value \n"), &output)
+			have, aborted, err := prompt.TextField(components.TextFieldArgs{
+				ExistingValue: "old value",
+				Help:          "help\n",
+				Prompt:        "Value: ",
+				TestInput:     components.TestInput{},
+				Title:         "Title",
+			})
+			must.NoError(t, err)
+			must.False(t, aborted)
+			must.EqOp(t, "new value", have)
+			must.EqOp(t, "\nTitle\nhelp\nValue: [old value] \n", output.String())
+		})
+		t.Run("empty input keeps the existing value", func(t *testing.T) {
+			t.Parallel()
+			prompt := components.NewPlainPrompt(strings.NewReader("\n"), &strings.Builder{})
+			have, aborted, err := prompt.TextField(components.TextFieldArgs{
+				ExistingValue: "old value",
+				Help:          "help\n",
+				Prompt:        "Value: ",
+				TestInput:     components.TestInput{},
+				Title:         "Title",
+			})
+			must.NoError(t, err)
+			must.False(t, aborted)
+			must.EqOp(t, "old value", have)
+		})
+	})
+}
@@ -13,6 +13,10 @@ const WindowSize = 9
 
 // RadioList lets the user select a new main branch for this repo.
 func RadioList[S fmt.Stringer](entries []S, cursor int, title, help string, inputs TestInput) (selected S, aborted bool, err error) { //nolint:ireturn
+	if UsePlainPrompt(inputs) {
+		selectedIndex, aborted, err := stdinPrompt.RadioList(slice.Strings(entries), cursor, title, help)
+		return entries[selectedIndex], aborted, err
+	}
 	program := tea.NewProgram(radioListModel[S]{
 		BubbleList: NewBubbleList(entries, cursor),
 		help:       help,
@@ -65,21 +69,22 @@ func (self radioListModel[S]) View() string {
 	s.WriteString(self.Colors.Title.Styled(self.title))
 	s.WriteRune('\n')
 	s.WriteString(self.help)
-	window := slice.Window(slice.WindowArgs{
-		CursorPos:    self.Cursor,
-		ElementCount: len(self.Entries),
-		WindowSize:   WindowSize,
-	})
-	for i := window.StartRow; i < window.EndRow; i++ {
+	for _, row := range self.WindowRows() {
+		i := row.Index
 		branch := self.Entries[i]
-		s.WriteString(self.EntryNumberStr(i))
-		if i == self.Cursor {
+		switch {
+		case !row.Match:
+			s.WriteString(self.AncestorStr(i))
+		case i == self.Cursor:
+			s.WriteString(self.EntryNumberStr(i))
 			s.WriteString(self.Colors.Selection.Styled("> " + branch.String()))
-		} else {
+		default:
+			s.WriteString(self.EntryNumberStr(i))
 			s.WriteString("  " + branch.String())
 		}
 		s.WriteRune('\n')
 	}
+	s.WriteString(self.FilterStr())
 	s.WriteString("\n\n  ")
 	// up
 	s.WriteString(self.Colors.HelpKey.Styled("↑"))
@@ -101,6 +106,9 @@ func (self radioListModel[S]) View() string {
 	s.WriteString(self.Colors.Help.Styled("/"))
 	s.WriteString(self.Colors.HelpKey.Styled("d"))
 	s.WriteString(self.Colors.Help.Styled(" page down   "))
+	// filter
+	s.WriteString(self.Colors.HelpKey.Styled("/"))
+	s.WriteString(self.Colors.Help.Styled(" filter   "))
 	// numbers
 	s.WriteString(self.Colors.HelpKey.Styled("0"))
 	s.WriteString(self.Colors.Help.Styled("-"))
@@ -111,6 +111,8 @@ func recognizeTestInput(input string) tea.Msg { //nolint:ireturn
 		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}} //nolint:exhaustruct
 	case "q":
 		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}} //nolint:exhaustruct
+	case "r":
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}} //nolint:exhaustruct
 	}
 	panic("unknown test input: " + input)
 }
@@ -7,6 +7,10 @@ import (
 )
 
 func TextDisplay(title, text string, inputs TestInput) (bool, error) {
+	if UsePlainPrompt(inputs) {
+		stdinPrompt.TextDisplay(title, text)
+		return false, nil
+	}
 	model := textDisplayModel{
 		colors: createColors(),
 		status: StatusActive,
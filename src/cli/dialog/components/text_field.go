@@ -8,6 +8,9 @@ import (
 )
 
 func TextField(args TextFieldArgs) (string, bool, error) {
+	if UsePlainPrompt(args.TestInput) {
+		return stdinPrompt.TextField(args)
+	}
 	textInput := textinput.New()
 	textInput.SetValue(args.ExistingValue)
 	textInput.Prompt = args.Prompt
@@ -0,0 +1,32 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	conflictingParentTitleTemplate = `Conflicting parent branch for %s`
+	conflictingParentHelpTemplate  = `
+Your local configuration says that the parent of branch %q is %q,
+but the lineage shared by your teammates says it is %q.
+
+Please select the correct parent.
+Git Town will share your selection with your teammates.
+
+
+`
+)
+
+// ConflictingParent lets the user choose between the locally configured and the shared parent of the given branch.
+func ConflictingParent(branch, localParent, sharedParent gitdomain.LocalBranchName, inputs components.TestInput) (gitdomain.LocalBranchName, bool, error) {
+	entries := gitdomain.LocalBranchNames{localParent, sharedParent}
+	title := fmt.Sprintf(conflictingParentTitleTemplate, branch)
+	help := fmt.Sprintf(conflictingParentHelpTemplate, branch, localParent, sharedParent)
+	selection, aborted, err := components.RadioList(entries, 0, title, help, inputs)
+	fmt.Printf(messages.ParentDialogSelected, branch, components.FormattedSelection(selection.String(), aborted))
+	return selection, aborted, err
+}
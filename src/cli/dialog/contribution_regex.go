@@ -0,0 +1,37 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	contributionRegexTitle = `Regular expression for contribution branches`
+	ContributionRegexHelp  = `
+All branches whose names match this regular expression
+are considered contribution branches,
+unless they are listed explicitly as another branch type.
+Git Town syncs contribution branches and pushes your commits
+but never ships or removes them.
+Example: "release-.*" for release branches of your coworkers.
+
+If you are not sure, leave this empty.
+
+`
+)
+
+// ContributionRegex lets the user enter the regular expression for contribution branches.
+func ContributionRegex(oldValue configdomain.ContributionRegex, inputs components.TestInput) (configdomain.ContributionRegex, bool, error) {
+	value, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          ContributionRegexHelp,
+		Prompt:        "Contribution regex: ",
+		TestInput:     inputs,
+		Title:         contributionRegexTitle,
+	})
+	fmt.Printf(messages.ContributionRegex, components.FormattedSelection(value, aborted))
+	return configdomain.ContributionRegex(value), aborted, err
+}
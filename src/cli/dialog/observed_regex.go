@@ -0,0 +1,37 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	observedRegexTitle = `Regular expression for observed branches`
+	ObservedRegexHelp  = `
+All branches whose names match this regular expression
+are considered observed branches,
+unless they are listed explicitly as another branch type.
+Git Town pulls updates into observed branches
+but never pushes to or ships them.
+Example: "(renovate|dependabot)/.*" for bot branches.
+
+If you are not sure, leave this empty.
+
+`
+)
+
+// ObservedRegex lets the user enter the regular expression for observed branches.
+func ObservedRegex(oldValue configdomain.ObservedRegex, inputs components.TestInput) (configdomain.ObservedRegex, bool, error) {
+	value, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          ObservedRegexHelp,
+		Prompt:        "Observed regex: ",
+		TestInput:     inputs,
+		Title:         observedRegexTitle,
+	})
+	fmt.Printf(messages.ObservedRegex, components.FormattedSelection(value, aborted))
+	return configdomain.ObservedRegex(value), aborted, err
+}
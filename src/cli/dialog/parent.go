@@ -26,7 +26,11 @@ Most of the time this is the main development branch (%v).
 // Parent lets the user select the parent branch for the given branch.
 func Parent(args ParentArgs) (gitdomain.LocalBranchName, bool, error) {
 	entries := ParentEntries(args)
-	cursor := stringers.IndexOrStart(entries, args.MainBranch)
+	preselected := args.MainBranch
+	if !args.Suggestion.IsEmpty() {
+		preselected = args.Suggestion
+	}
+	cursor := stringers.IndexOrStart(entries, preselected)
 	title := fmt.Sprintf(parentBranchTitleTemplate, args.Branch)
 	help := fmt.Sprintf(parentBranchHelpTemplate, args.Branch, args.MainBranch)
 	selection, aborted, err := components.RadioList(entries, cursor, title, help, args.DialogTestInput)
@@ -40,6 +44,7 @@ type ParentArgs struct {
 	Lineage         configdomain.Lineage
 	LocalBranches   gitdomain.LocalBranchNames
 	MainBranch      gitdomain.LocalBranchName
+	Suggestion      gitdomain.LocalBranchName // the most likely parent, empty if unknown
 }
 
 func ParentEntries(args ParentArgs) gitdomain.LocalBranchNames {
@@ -0,0 +1,35 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	parkedRegexTitle = `Regular expression for parked branches`
+	ParkedRegexHelp  = `
+All branches whose names match this regular expression
+are considered parked branches,
+unless they are listed explicitly as another branch type.
+Git Town syncs parked branches only when they are checked out.
+
+If you are not sure, leave this empty.
+
+`
+)
+
+// ParkedRegex lets the user enter the regular expression for parked branches.
+func ParkedRegex(oldValue configdomain.ParkedRegex, inputs components.TestInput) (configdomain.ParkedRegex, bool, error) {
+	value, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          ParkedRegexHelp,
+		Prompt:        "Parked regex: ",
+		TestInput:     inputs,
+		Title:         parkedRegexTitle,
+	})
+	fmt.Printf(messages.ParkedRegex, components.FormattedSelection(value, aborted))
+	return configdomain.ParkedRegex(value), aborted, err
+}
@@ -33,6 +33,9 @@ func PerennialBranches(localBranches gitdomain.LocalBranchNames, oldPerennialBra
 	if len(perennialCandidates) == 0 {
 		return gitdomain.LocalBranchNames{}, false, nil
 	}
+	if components.UsePlainPrompt(inputs) {
+		return plainPerennialBranches(perennialCandidates, oldPerennialBranches)
+	}
 	program := tea.NewProgram(PerennialBranchesModel{
 		BubbleList:    components.NewBubbleList(perennialCandidates, 0),
 		Selections:    slice.FindMany(perennialCandidates, oldPerennialBranches),
@@ -45,11 +48,7 @@ func PerennialBranches(localBranches gitdomain.LocalBranchNames, oldPerennialBra
 	}
 	result := dialogResult.(PerennialBranchesModel) //nolint:forcetypeassert
 	selectedBranches := result.CheckedEntries()
-	selectionText := strings.Join(selectedBranches.Strings(), ", ")
-	if selectionText == "" {
-		selectionText = "(none)"
-	}
-	fmt.Printf(messages.PerennialBranches, components.FormattedSelection(selectionText, result.Aborted()))
+	printPerennialBranchesSelection(selectedBranches, result.Aborted())
 	return selectedBranches, result.Aborted(), nil
 }
 
@@ -136,13 +135,14 @@ func (self PerennialBranchesModel) View() string {
 	s.WriteString(self.Colors.Title.Styled(perennialBranchesTitle))
 	s.WriteRune('\n')
 	s.WriteString(PerennialBranchesHelp)
-	window := slice.Window(slice.WindowArgs{
-		CursorPos:    self.Cursor,
-		ElementCount: len(self.Entries),
-		WindowSize:   components.WindowSize,
-	})
-	for i := window.StartRow; i < window.EndRow; i++ {
+	for _, row := range self.WindowRows() {
+		i := row.Index
 		branch := self.Entries[i]
+		if !row.Match {
+			s.WriteString(self.AncestorStr(i))
+			s.WriteRune('\n')
+			continue
+		}
 		selected := self.Cursor == i
 		checked := self.IsRowChecked(i)
 		s.WriteString(self.EntryNumberStr(i))
@@ -158,6 +158,7 @@ func (self PerennialBranchesModel) View() string {
 		}
 		s.WriteRune('\n')
 	}
+	s.WriteString(self.FilterStr())
 	s.WriteString("\n\n  ")
 	// up
 	s.WriteString(self.Colors.HelpKey.Styled("↑"))
@@ -184,6 +185,9 @@ func (self PerennialBranchesModel) View() string {
 	s.WriteString(self.Colors.Help.Styled("/"))
 	s.WriteString(self.Colors.HelpKey.Styled("o"))
 	s.WriteString(self.Colors.Help.Styled(" toggle   "))
+	// filter
+	s.WriteString(self.Colors.HelpKey.Styled("/"))
+	s.WriteString(self.Colors.Help.Styled(" filter   "))
 	// numbers
 	s.WriteString(self.Colors.HelpKey.Styled("0"))
 	s.WriteString(self.Colors.Help.Styled("-"))
@@ -201,3 +205,25 @@ func (self PerennialBranchesModel) View() string {
 	s.WriteString(self.Colors.Help.Styled(" abort"))
 	return s.String()
 }
+
+// plainPerennialBranches asks for the perennial branches using the line-based prompt.
+func plainPerennialBranches(perennialCandidates, oldPerennialBranches gitdomain.LocalBranchNames) (gitdomain.LocalBranchNames, bool, error) {
+	selections, aborted, err := components.StdinPrompt().MultiSelect(perennialCandidates.Strings(), slice.FindMany(perennialCandidates, oldPerennialBranches), perennialBranchesTitle, PerennialBranchesHelp)
+	if err != nil {
+		return gitdomain.LocalBranchNames{}, false, err
+	}
+	selectedBranches := make(gitdomain.LocalBranchNames, len(selections))
+	for s, selection := range selections {
+		selectedBranches[s] = perennialCandidates[selection]
+	}
+	printPerennialBranchesSelection(selectedBranches, aborted)
+	return selectedBranches, aborted, nil
+}
+
+func printPerennialBranchesSelection(selectedBranches gitdomain.LocalBranchNames, aborted bool) {
+	selectionText := strings.Join(selectedBranches.Strings(), ", ")
+	if selectionText == "" {
+		selectionText = "(none)"
+	}
+	fmt.Printf(messages.PerennialBranches, components.FormattedSelection(selectionText, aborted))
+}
@@ -0,0 +1,83 @@
+package dialog
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const selectBranchesHelp = `
+Please select the branches to %s.
+
+`
+
+// SelectBranches lets the user select any number of the given branches.
+// The branches are displayed according to their lineage.
+func SelectBranches(args SelectBranchesArgs) (gitdomain.LocalBranchNames, bool, error) {
+	entries := SelectBranchesEntries(args.Branches, args.Lineage)
+	selections := []int{}
+	for e, entry := range entries {
+		if slices.Contains(args.Preselected, entry.Branch) {
+			selections = append(selections, e)
+		}
+	}
+	title := "Select branches to " + args.Action
+	help := fmt.Sprintf(selectBranchesHelp, args.Action)
+	selected, aborted, err := components.CheckList(entries, selections, title, help, args.Inputs)
+	if err != nil || aborted {
+		return gitdomain.LocalBranchNames{}, aborted, err
+	}
+	result := make(gitdomain.LocalBranchNames, len(selected))
+	for s, selection := range selected {
+		result[s] = entries[selection].Branch
+	}
+	selectionText := strings.Join(result.Strings(), ", ")
+	if selectionText == "" {
+		selectionText = "(none)"
+	}
+	fmt.Printf(messages.SelectedBranches, components.FormattedSelection(selectionText, aborted))
+	return result, aborted, nil
+}
+
+type SelectBranchesArgs struct {
+	Action      string                     // what the command does with the selected branches, for example "park"
+	Branches    gitdomain.LocalBranchNames // the branches that the user can select
+	Inputs      components.TestInput
+	Lineage     configdomain.Lineage
+	Preselected gitdomain.LocalBranchNames // the branches to check initially
+}
+
+// SelectBranchesEntries provides the entries for the "select branches" dialog:
+// the given branches ordered and indented according to the given lineage.
+// Branches that aren't in the given list don't take up indentation.
+func SelectBranchesEntries(branches gitdomain.LocalBranchNames, lineage configdomain.Lineage) []SwitchBranchEntry {
+	layout := []SwitchBranchEntry{}
+	for _, root := range lineage.Roots() {
+		layoutBranches(&layout, root, "", lineage)
+	}
+	result := make([]SwitchBranchEntry, 0, len(branches))
+	for _, entry := range layout {
+		if !slices.Contains(branches, entry.Branch) {
+			continue
+		}
+		depth := 0
+		for _, ancestor := range lineage.Ancestors(entry.Branch) {
+			if slices.Contains(branches, ancestor) {
+				depth++
+			}
+		}
+		entry.Indentation = strings.Repeat("  ", depth)
+		result = append(result, entry)
+	}
+	for _, branch := range branches {
+		if !slices.ContainsFunc(result, func(entry SwitchBranchEntry) bool { return entry.Branch == branch }) {
+			result = append(result, SwitchBranchEntry{Branch: branch, Indentation: ""}) //nolint:exhaustruct
+		}
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+package dialog_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestSelectBranches(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SelectBranchesEntries", func(t *testing.T) {
+		t.Parallel()
+		t.Run("indents according to the lineage of the selectable branches", func(t *testing.T) {
+			t.Parallel()
+			lineage := configdomain.Lineage{
+				"alpha":  "main",
+				"alpha1": "alpha",
+				"alpha2": "alpha1",
+				"beta":   "main",
+			}
+			branches := gitdomain.NewLocalBranchNames("alpha", "alpha2", "beta", "orphan")
+			have := dialog.SelectBranchesEntries(branches, lineage)
+			want := []dialog.SwitchBranchEntry{
+				{Branch: "alpha", Indentation: ""},    //nolint:exhaustruct
+				{Branch: "alpha2", Indentation: "  "}, //nolint:exhaustruct
+				{Branch: "beta", Indentation: ""},     //nolint:exhaustruct
+				{Branch: "orphan", Indentation: ""},   //nolint:exhaustruct
+			}
+			must.Eq(t, want, have)
+		})
+	})
+}
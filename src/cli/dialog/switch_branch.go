@@ -8,35 +8,79 @@ import (
 	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
-	"github.com/git-town/git-town/v12/src/gohacks/slice"
 	"golang.org/x/exp/maps"
 )
 
-func SwitchBranch(localBranches gitdomain.LocalBranchNames, initialBranch gitdomain.LocalBranchName, lineage configdomain.Lineage) (gitdomain.LocalBranchName, bool, error) {
-	entries := SwitchBranchEntries(localBranches, lineage)
-	cursor := SwitchBranchCursorPos(entries, initialBranch)
-	dialogProcess := tea.NewProgram(SwitchModel{
-		BubbleList:       components.NewBubbleList(entries, cursor),
-		InitialBranchPos: cursor,
-	})
+// SwitchBranch lets the user select a branch to switch to.
+func SwitchBranch(args SwitchBranchArgs) (SwitchBranchEntry, bool, error) {
+	allEntries := SwitchBranchEntries(args.Branches, args.Config, args.Worktrees)
+	model := SwitchModel{ //nolint:exhaustruct
+		AllEntries:    allEntries,
+		InitialBranch: args.InitialBranch,
+		ShowRemote:    false,
+		TypeFilter:    nil,
+	}
+	entries := model.VisibleEntries()
+	cursor := SwitchBranchCursorPos(entries, args.InitialBranch)
+	if components.UsePlainPrompt(args.DialogTestInput) {
+		entryTexts := make([]string, len(entries))
+		for e, entry := range entries {
+			entryTexts[e] = strings.TrimRight(entry.String()+"  "+entry.Details(), " ")
+		}
+		selected, aborted, err := components.StdinPrompt().RadioList(entryTexts, cursor, "Switch to branch", "\n")
+		return entries[selected], aborted, err
+	}
+	model.BubbleList = components.NewBubbleList(entries, cursor)
+	model.InitialBranchPos = cursor
+	dialogProcess := tea.NewProgram(model)
+	components.SendInputs(args.DialogTestInput, dialogProcess)
 	dialogResult, err := dialogProcess.Run()
 	if err != nil {
-		return "", false, err
+		return SwitchBranchEntry{}, false, err //nolint:exhaustruct
 	}
 	result := dialogResult.(SwitchModel) //nolint:forcetypeassert
-	selectedEntry := result.BubbleList.SelectedEntry()
-	return selectedEntry.Branch, result.Aborted(), nil
+	return result.BubbleList.SelectedEntry(), result.Aborted(), nil
+}
+
+type SwitchBranchArgs struct {
+	Branches        gitdomain.BranchInfos
+	Config          *configdomain.FullConfig
+	DialogTestInput components.TestInput
+	InitialBranch   gitdomain.LocalBranchName
+	Worktrees       gitdomain.Worktrees
 }
 
 type SwitchModel struct {
 	components.BubbleList[SwitchBranchEntry]
-	InitialBranchPos int // position of the currently checked out branch in the list
+	AllEntries       []SwitchBranchEntry       // all entries, including the ones that the toggles currently hide
+	InitialBranch    gitdomain.LocalBranchName // the currently checked out branch
+	InitialBranchPos int                       // position of the currently checked out branch in the list
+	ShowRemote       bool                      // whether to display branches that exist only at the remote
+	TypeFilter       *configdomain.BranchType  // the only branch type to display, nil displays all branch types
 }
 
 func (self SwitchModel) Init() tea.Cmd {
 	return nil
 }
 
+// NextTypeFilter provides the branch type to filter by after the current one,
+// cycling through the types of the displayable branches and ending with no filter.
+func (self SwitchModel) NextTypeFilter() *configdomain.BranchType {
+	types := []configdomain.BranchType{}
+	for _, entry := range self.AllEntries {
+		if (self.ShowRemote || !entry.IsRemoteOnly()) && !slices.Contains(types, entry.BranchType) {
+			types = append(types, entry.BranchType)
+		}
+	}
+	slices.Sort(types)
+	for _, branchType := range types {
+		if self.TypeFilter == nil || branchType > *self.TypeFilter {
+			return &branchType
+		}
+	}
+	return nil
+}
+
 func (self SwitchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolint:ireturn
 	keyMsg, isKeyMsg := msg.(tea.KeyMsg)
 	if !isKeyMsg {
@@ -49,26 +93,62 @@ func (self SwitchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolint:iret
 		self.Status = components.StatusDone
 		return self, tea.Quit
 	}
-	if keyMsg.String() == "o" {
+	switch keyMsg.String() {
+	case "o":
 		self.Status = components.StatusDone
 		return self, tea.Quit
+	case "r":
+		self.ShowRemote = !self.ShowRemote
+		self.updateEntries()
+	case "t":
+		self.TypeFilter = self.NextTypeFilter()
+		self.updateEntries()
 	}
 	return self, nil
 }
 
+// VisibleEntries provides the entries that the current toggles display.
+func (self SwitchModel) VisibleEntries() []SwitchBranchEntry {
+	result := make([]SwitchBranchEntry, 0, len(self.AllEntries))
+	for _, entry := range self.AllEntries {
+		if entry.IsRemoteOnly() && !self.ShowRemote {
+			continue
+		}
+		if self.TypeFilter != nil && entry.BranchType != *self.TypeFilter {
+			continue
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+// updateEntries displays the entries that the current toggles select, keeping the cursor on the selected branch if possible.
+func (self *SwitchModel) updateEntries() {
+	entries := self.VisibleEntries()
+	if len(entries) == 0 {
+		// the type filter doesn't match any displayable branch
+		self.TypeFilter = nil
+		entries = self.VisibleEntries()
+	}
+	selectedBranch := self.SelectedEntry().Branch
+	self.Entries = entries
+	self.Cursor = SwitchBranchCursorPos(entries, selectedBranch)
+	// the filter typed by the user still applies to the new entries
+	self.MoveCursorToMatch()
+	self.InitialBranchPos = slices.IndexFunc(entries, func(entry SwitchBranchEntry) bool { return entry.Branch == self.InitialBranch })
+}
+
 func (self SwitchModel) View() string {
 	if self.Status != components.StatusActive {
 		return ""
 	}
 	s := strings.Builder{}
-	window := slice.Window(slice.WindowArgs{
-		CursorPos:    self.Cursor,
-		ElementCount: len(self.Entries),
-		WindowSize:   components.WindowSize,
-	})
-	for i := window.StartRow; i < window.EndRow; i++ {
+	for _, row := range self.WindowRows() {
+		i := row.Index
 		branch := self.Entries[i]
 		switch {
+		case !row.Match:
+			s.WriteString(self.Dim.Styled("  " + branch.String()))
 		case i == self.Cursor:
 			s.WriteString(self.Colors.Selection.Styled("> " + branch.String()))
 		case i == self.InitialBranchPos:
@@ -76,8 +156,13 @@ func (self SwitchModel) View() string {
 		default:
 			s.WriteString("  " + branch.String())
 		}
+		if details := branch.Details(); row.Match && details != "" {
+			s.WriteString(self.Dim.Styled("  " + details))
+		}
 		s.WriteRune('\n')
 	}
+	s.WriteString(self.FilterStr())
+	s.WriteString(self.togglesStr())
 	s.WriteString("\n\n  ")
 	// up
 	s.WriteString(self.Colors.HelpKey.Styled("↑"))
@@ -99,6 +184,15 @@ func (self SwitchModel) View() string {
 	s.WriteString(self.Colors.Help.Styled("/"))
 	s.WriteString(self.Colors.HelpKey.Styled("d"))
 	s.WriteString(self.Colors.Help.Styled(" 10 down   "))
+	// filter
+	s.WriteString(self.Colors.HelpKey.Styled("/"))
+	s.WriteString(self.Colors.Help.Styled(" filter   "))
+	// remote branches
+	s.WriteString(self.Colors.HelpKey.Styled("r"))
+	s.WriteString(self.Colors.Help.Styled(" remote branches   "))
+	// branch types
+	s.WriteString(self.Colors.HelpKey.Styled("t"))
+	s.WriteString(self.Colors.Help.Styled(" branch type   "))
 	// accept
 	s.WriteString(self.Colors.HelpKey.Styled("enter"))
 	s.WriteString(self.Colors.Help.Styled("/"))
@@ -114,6 +208,22 @@ func (self SwitchModel) View() string {
 	return s.String()
 }
 
+// togglesStr describes which entries the toggles currently display,
+// or provides an empty string if they display all local branches.
+func (self SwitchModel) togglesStr() string {
+	toggles := []string{}
+	if self.ShowRemote {
+		toggles = append(toggles, "including remote branches")
+	}
+	if self.TypeFilter != nil {
+		toggles = append(toggles, "only "+self.TypeFilter.String()+"es")
+	}
+	if len(toggles) == 0 {
+		return ""
+	}
+	return "\n" + self.Dim.Styled("("+strings.Join(toggles, ", ")+")")
+}
+
 // SwitchBranchCursorPos provides the initial cursor position for the "switch branch" components.
 func SwitchBranchCursorPos(entries []SwitchBranchEntry, initialBranch gitdomain.LocalBranchName) int {
 	for e, entry := range entries {
@@ -124,16 +234,18 @@ func SwitchBranchCursorPos(entries []SwitchBranchEntry, initialBranch gitdomain.
 	return 0
 }
 
-// SwitchBranchEntries provides the entries for the "switch branch" components.
-func SwitchBranchEntries(localBranches gitdomain.LocalBranchNames, lineage configdomain.Lineage) []SwitchBranchEntry {
-	entries := make([]SwitchBranchEntry, 0, len(lineage))
-	roots := lineage.Roots()
+// SwitchBranchEntries provides the entries for the "switch branch" components:
+// the local branches laid out according to their lineage, followed by the branches that exist only at the origin remote.
+func SwitchBranchEntries(branches gitdomain.BranchInfos, config *configdomain.FullConfig, worktrees gitdomain.Worktrees) []SwitchBranchEntry {
+	localBranches := branches.Names()
+	entries := make([]SwitchBranchEntry, 0, len(branches))
+	roots := config.Lineage.Roots()
 	// add all entries from the lineage
 	for _, root := range roots {
-		layoutBranches(&entries, root, "", lineage)
+		layoutBranches(&entries, root, "", config.Lineage)
 	}
 	// add missing local branches
-	branchesInLineage := maps.Keys(lineage)
+	branchesInLineage := maps.Keys(config.Lineage)
 	for _, localBranch := range localBranches {
 		if slices.Contains(roots, localBranch) {
 			continue
@@ -141,7 +253,35 @@ func SwitchBranchEntries(localBranches gitdomain.LocalBranchNames, lineage confi
 		if slices.Contains(branchesInLineage, localBranch) {
 			continue
 		}
-		entries = append(entries, SwitchBranchEntry{Branch: localBranch, Indentation: ""})
+		entries = append(entries, SwitchBranchEntry{Branch: localBranch, Indentation: ""}) //nolint:exhaustruct
+	}
+	// add the type, sync status, and worktree of the branches
+	for e := range entries {
+		entries[e].BranchType = config.BranchType(entries[e].Branch)
+		if branchInfo := branches.FindByLocalName(entries[e].Branch); branchInfo != nil {
+			entries[e].SyncStatus = branchInfo.SyncStatus
+			if branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree {
+				entries[e].Worktree = worktrees[entries[e].Branch]
+			}
+		}
+	}
+	// add remote-only branches
+	for _, branchInfo := range branches {
+		if !branchInfo.HasOnlyRemoteBranch() || branchInfo.RemoteName.Remote() != gitdomain.OriginRemote {
+			continue
+		}
+		localName := branchInfo.RemoteName.LocalBranchName()
+		if slices.Contains(localBranches, localName) {
+			continue
+		}
+		entries = append(entries, SwitchBranchEntry{
+			Branch:       localName,
+			BranchType:   config.BranchType(localName),
+			Indentation:  "",
+			RemoteBranch: branchInfo.RemoteName,
+			SyncStatus:   gitdomain.SyncStatusRemoteOnly,
+			Worktree:     "",
+		})
 	}
 	return entries
 }
@@ -149,15 +289,47 @@ func SwitchBranchEntries(localBranches gitdomain.LocalBranchNames, lineage confi
 // layoutBranches adds entries for the given branch and its children to the given entry list.
 // The entries are indented according to their position in the given lineage.
 func layoutBranches(result *[]SwitchBranchEntry, branch gitdomain.LocalBranchName, indentation string, lineage configdomain.Lineage) {
-	*result = append(*result, SwitchBranchEntry{Branch: branch, Indentation: indentation})
+	*result = append(*result, SwitchBranchEntry{Branch: branch, Indentation: indentation}) //nolint:exhaustruct
 	for _, child := range lineage.Children(branch) {
 		layoutBranches(result, child, indentation+"  ", lineage)
 	}
 }
 
 type SwitchBranchEntry struct {
-	Branch      gitdomain.LocalBranchName
-	Indentation string
+	Branch       gitdomain.LocalBranchName
+	BranchType   configdomain.BranchType
+	Indentation  string
+	RemoteBranch gitdomain.RemoteBranchName // the branch to track when selecting this entry, only set for branches that exist only at the remote
+	SyncStatus   gitdomain.SyncStatus
+	Worktree     string // directory of the other worktree in which this branch is checked out
+}
+
+// Details provides a human-readable description of the type, sync status, and worktree of this branch.
+func (sbe SwitchBranchEntry) Details() string {
+	details := []string{}
+	if sbe.BranchType != configdomain.BranchTypeFeatureBranch {
+		details = append(details, strings.TrimSuffix(sbe.BranchType.String(), " branch"))
+	}
+	switch {
+	case sbe.Worktree != "":
+		details = append(details, "worktree "+sbe.Worktree)
+	case sbe.SyncStatus != "" && sbe.SyncStatus != gitdomain.SyncStatusUpToDate:
+		details = append(details, sbe.SyncStatus.String())
+	}
+	if len(details) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(details, ", ") + ")"
+}
+
+// IsRemoteOnly indicates whether this entry represents a branch that exists only at the remote.
+func (sbe SwitchBranchEntry) IsRemoteOnly() bool {
+	return !sbe.RemoteBranch.IsEmpty()
+}
+
+// NestingLevel provides how deep this entry is nested in the lineage.
+func (sbe SwitchBranchEntry) NestingLevel() int {
+	return len(sbe.Indentation) / 2
 }
 
 func (sbe SwitchBranchEntry) String() string {
@@ -3,6 +3,7 @@ package dialog_test
 import (
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/git-town/git-town/v12/src/cli/dialog"
 	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
@@ -49,16 +50,18 @@ func TestSwitchBranch(t *testing.T) {
 			branchA := gitdomain.NewLocalBranchName("alpha")
 			branchB := gitdomain.NewLocalBranchName("beta")
 			main := gitdomain.NewLocalBranchName("main")
-			lineage := configdomain.Lineage{
+			config := configdomain.DefaultConfig()
+			config.MainBranch = main
+			config.Lineage = configdomain.Lineage{
 				branchA: main,
 				branchB: main,
 			}
-			localBranches := gitdomain.LocalBranchNames{branchA, branchB, main}
-			have := dialog.SwitchBranchEntries(localBranches, lineage)
+			branches := localBranchInfos(branchA, branchB, main)
+			have := dialog.SwitchBranchEntries(branches, &config, gitdomain.Worktrees{})
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: ""},
-				{Branch: "alpha", Indentation: "  "},
-				{Branch: "beta", Indentation: "  "},
+				{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""},
+				{Branch: "alpha", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""},
+				{Branch: "beta", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""},
 			}
 			must.Eq(t, want, have)
 		})
@@ -68,17 +71,20 @@ func TestSwitchBranch(t *testing.T) {
 			branchB := gitdomain.NewLocalBranchName("beta")
 			perennial1 := gitdomain.NewLocalBranchName("perennial-1")
 			main := gitdomain.NewLocalBranchName("main")
-			lineage := configdomain.Lineage{
+			config := configdomain.DefaultConfig()
+			config.MainBranch = main
+			config.PerennialBranches = gitdomain.LocalBranchNames{perennial1}
+			config.Lineage = configdomain.Lineage{
 				branchA: main,
 				branchB: main,
 			}
-			localBranches := gitdomain.LocalBranchNames{branchA, branchB, main, perennial1}
-			have := dialog.SwitchBranchEntries(localBranches, lineage)
+			branches := localBranchInfos(branchA, branchB, main, perennial1)
+			have := dialog.SwitchBranchEntries(branches, &config, gitdomain.Worktrees{})
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: ""},
-				{Branch: "alpha", Indentation: "  "},
-				{Branch: "beta", Indentation: "  "},
-				{Branch: "perennial-1", Indentation: ""},
+				{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""},
+				{Branch: "alpha", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""},
+				{Branch: "beta", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""},
+				{Branch: "perennial-1", BranchType: configdomain.BranchTypePerennialBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""},
 			}
 			must.Eq(t, want, have)
 		})
@@ -87,28 +93,140 @@ func TestSwitchBranch(t *testing.T) {
 			child := gitdomain.NewLocalBranchName("child")
 			grandchild := gitdomain.NewLocalBranchName("grandchild")
 			main := gitdomain.NewLocalBranchName("main")
-			lineage := configdomain.Lineage{
+			config := configdomain.DefaultConfig()
+			config.MainBranch = main
+			config.Lineage = configdomain.Lineage{
 				child:      main,
 				grandchild: child,
 			}
-			localBranches := gitdomain.LocalBranchNames{grandchild, main}
-			have := dialog.SwitchBranchEntries(localBranches, lineage)
+			branches := localBranchInfos(grandchild, main)
+			have := dialog.SwitchBranchEntries(branches, &config, gitdomain.Worktrees{})
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: ""},
-				{Branch: "child", Indentation: "  "},
-				{Branch: "grandchild", Indentation: "    "},
+				{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""},
+				{Branch: "child", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: "", Worktree: ""},
+				{Branch: "grandchild", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "    ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("remote-only branches and branches in other worktrees", func(t *testing.T) {
+			t.Parallel()
+			main := gitdomain.NewLocalBranchName("main")
+			config := configdomain.DefaultConfig()
+			config.MainBranch = main
+			branches := gitdomain.BranchInfos{
+				{
+					LocalName:  main,
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					RemoteName: gitdomain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+				},
+				{
+					LocalName:  gitdomain.NewLocalBranchName("other"),
+					LocalSHA:   gitdomain.NewSHA("222222"),
+					RemoteName: gitdomain.EmptyRemoteBranchName(),
+					RemoteSHA:  gitdomain.EmptySHA(),
+					SyncStatus: gitdomain.SyncStatusOtherWorktree,
+				},
+				{
+					LocalName:  gitdomain.EmptyLocalBranchName(),
+					LocalSHA:   gitdomain.EmptySHA(),
+					RemoteName: gitdomain.NewRemoteBranchName("origin/coworker"),
+					RemoteSHA:  gitdomain.NewSHA("333333"),
+					SyncStatus: gitdomain.SyncStatusRemoteOnly,
+				},
+				{
+					LocalName:  gitdomain.EmptyLocalBranchName(),
+					LocalSHA:   gitdomain.EmptySHA(),
+					RemoteName: gitdomain.NewRemoteBranchName("upstream/main"),
+					RemoteSHA:  gitdomain.NewSHA("444444"),
+					SyncStatus: gitdomain.SyncStatusRemoteOnly,
+				},
+			}
+			worktrees := gitdomain.Worktrees{
+				main:                                  "/repo",
+				gitdomain.NewLocalBranchName("other"): "/repo-other",
+			}
+			have := dialog.SwitchBranchEntries(branches, &config, worktrees)
+			want := []dialog.SwitchBranchEntry{
+				{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+				{Branch: "other", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusOtherWorktree, Worktree: "/repo-other"},
+				{Branch: "coworker", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "", RemoteBranch: "origin/coworker", SyncStatus: gitdomain.SyncStatusRemoteOnly, Worktree: ""},
 			}
 			must.Eq(t, want, have)
 		})
 	})
 
+	t.Run("SwitchBranchEntry", func(t *testing.T) {
+		t.Parallel()
+		t.Run("Details", func(t *testing.T) {
+			t.Parallel()
+			tests := map[dialog.SwitchBranchEntry]string{
+				{Branch: "feature", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""}:               "",
+				{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusNotInSync, Worktree: ""}:                    "(main, not in sync)",
+				{Branch: "parked", BranchType: configdomain.BranchTypeParkedBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusLocalOnly, Worktree: ""}:                "(parked, local only)",
+				{Branch: "other", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusOtherWorktree, Worktree: "/repo-other"}: "(worktree /repo-other)",
+			}
+			for give, want := range tests {
+				must.EqOp(t, want, give.Details())
+			}
+		})
+	})
+
+	t.Run("SwitchModel", func(t *testing.T) {
+		t.Parallel()
+		allEntries := []dialog.SwitchBranchEntry{
+			{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+			{Branch: "parked", BranchType: configdomain.BranchTypeParkedBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+			{Branch: "coworker", BranchType: configdomain.BranchTypeContributionBranch, Indentation: "", RemoteBranch: "origin/coworker", SyncStatus: gitdomain.SyncStatusRemoteOnly, Worktree: ""},
+		}
+		t.Run("NextTypeFilter", func(t *testing.T) {
+			t.Parallel()
+			model := dialog.SwitchModel{AllEntries: allEntries} //nolint:exhaustruct
+			model.TypeFilter = model.NextTypeFilter()
+			must.EqOp(t, configdomain.BranchTypeMainBranch, *model.TypeFilter)
+			model.TypeFilter = model.NextTypeFilter()
+			must.EqOp(t, configdomain.BranchTypeParkedBranch, *model.TypeFilter)
+			model.TypeFilter = model.NextTypeFilter()
+			must.Nil(t, model.TypeFilter)
+			model.ShowRemote = true
+			parked := configdomain.BranchTypeParkedBranch
+			model.TypeFilter = &parked
+			model.TypeFilter = model.NextTypeFilter()
+			must.EqOp(t, configdomain.BranchTypeContributionBranch, *model.TypeFilter)
+		})
+		t.Run("VisibleEntries", func(t *testing.T) {
+			t.Parallel()
+			model := dialog.SwitchModel{AllEntries: allEntries} //nolint:exhaustruct
+			must.Eq(t, allEntries[:2], model.VisibleEntries())
+			model.ShowRemote = true
+			must.Eq(t, allEntries, model.VisibleEntries())
+			parked := configdomain.BranchTypeParkedBranch
+			model.TypeFilter = &parked
+			must.Eq(t, allEntries[1:2], model.VisibleEntries())
+		})
+		t.Run("Update keeps the cursor on a filter match when toggling remote branches", func(t *testing.T) {
+			t.Parallel()
+			model := dialog.SwitchModel{ //nolint:exhaustruct
+				AllEntries: allEntries,
+				BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
+					Cursor:  0,
+					Entries: allEntries[:2],
+					Filter:  "cow",
+				},
+			}
+			newModel, _ := model.Update(tea.KeyMsg{Runes: []rune{'r'}, Type: tea.KeyRunes}) //nolint:exhaustruct
+			must.EqOp(t, "coworker", newModel.(dialog.SwitchModel).SelectedEntry().Branch.String())
+		})
+	})
+
 	t.Run("View", func(t *testing.T) {
 		t.Run("only the main branch exists", func(t *testing.T) {
 			t.Parallel()
 			model := dialog.SwitchModel{
 				BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
 					Cursor:       0,
-					Entries:      []dialog.SwitchBranchEntry{{Branch: "main", Indentation: ""}},
+					Entries:      []dialog.SwitchBranchEntry{{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""}},
 					MaxDigits:    1,
 					NumberFormat: "%d",
 				},
@@ -116,10 +234,10 @@ func TestSwitchBranch(t *testing.T) {
 			}
 			have := model.View()
 			want := `
-> main
+> main  (main)
 
 
-  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   enter/o accept   q/esc/ctrl-c abort`[1:]
+  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   / filter   r remote branches   t branch type   enter/o accept   q/esc/ctrl-c abort`[1:]
 			must.EqOp(t, want, have)
 		})
 
@@ -129,9 +247,9 @@ func TestSwitchBranch(t *testing.T) {
 				BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
 					Cursor: 0,
 					Entries: []dialog.SwitchBranchEntry{
-						{Branch: "main", Indentation: ""},
-						{Branch: "one", Indentation: ""},
-						{Branch: "two", Indentation: ""},
+						{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "one", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "two", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
 					},
 					MaxDigits:    1,
 					NumberFormat: "%d",
@@ -140,12 +258,12 @@ func TestSwitchBranch(t *testing.T) {
 			}
 			have := model.View()
 			want := `
-> main
+> main  (main)
   one
   two
 
 
-  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   enter/o accept   q/esc/ctrl-c abort`[1:]
+  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   / filter   r remote branches   t branch type   enter/o accept   q/esc/ctrl-c abort`[1:]
 			must.EqOp(t, want, have)
 		})
 
@@ -155,13 +273,13 @@ func TestSwitchBranch(t *testing.T) {
 				BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
 					Cursor: 0,
 					Entries: []dialog.SwitchBranchEntry{
-						{Branch: "main", Indentation: ""},
-						{Branch: "alpha", Indentation: "  "},
-						{Branch: "alpha1", Indentation: "    "},
-						{Branch: "alpha2", Indentation: "    "},
-						{Branch: "beta", Indentation: "  "},
-						{Branch: "beta1", Indentation: "    "},
-						{Branch: "other", Indentation: ""},
+						{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "alpha", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "alpha1", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "    ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "alpha2", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "    ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "beta", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "beta1", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "    ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "other", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
 					},
 					MaxDigits:    1,
 					NumberFormat: "%d",
@@ -170,7 +288,7 @@ func TestSwitchBranch(t *testing.T) {
 			}
 			have := model.View()
 			want := `
-> main
+> main  (main)
     alpha
       alpha1
       alpha2
@@ -179,8 +297,85 @@ func TestSwitchBranch(t *testing.T) {
   other
 
 
-  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   enter/o accept   q/esc/ctrl-c abort`[1:]
+  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   / filter   r remote branches   t branch type   enter/o accept   q/esc/ctrl-c abort`[1:]
+			must.EqOp(t, want, have)
+		})
+
+		t.Run("remote branches and worktrees", func(t *testing.T) {
+			t.Parallel()
+			model := dialog.SwitchModel{ //nolint:exhaustruct
+				BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
+					Cursor: 0,
+					Entries: []dialog.SwitchBranchEntry{
+						{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusNotInSync, Worktree: ""},
+						{Branch: "other", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusOtherWorktree, Worktree: "/repo-other"},
+						{Branch: "coworker", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "", RemoteBranch: "origin/coworker", SyncStatus: gitdomain.SyncStatusRemoteOnly, Worktree: ""},
+					},
+					MaxDigits:    1,
+					NumberFormat: "%d",
+				},
+				InitialBranchPos: 0,
+				ShowRemote:       true,
+			}
+			have := model.View()
+			want := `
+> main  (main, not in sync)
+    other  (worktree /repo-other)
+  coworker  (remote only)
+
+(including remote branches)
+
+  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   / filter   r remote branches   t branch type   enter/o accept   q/esc/ctrl-c abort`[1:]
+			must.EqOp(t, want, have)
+		})
+
+		t.Run("filtered", func(t *testing.T) {
+			t.Parallel()
+			model := dialog.SwitchModel{
+				BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
+					Cursor: 2,
+					Entries: []dialog.SwitchBranchEntry{
+						{Branch: "main", BranchType: configdomain.BranchTypeMainBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "alpha", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "alpha1", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "    ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "alpha2", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "    ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "beta", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "  ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "beta1", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "    ", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+						{Branch: "other", BranchType: configdomain.BranchTypeFeatureBranch, Indentation: "", RemoteBranch: "", SyncStatus: gitdomain.SyncStatusUpToDate, Worktree: ""},
+					},
+					Filter:       "a1",
+					MaxDigits:    1,
+					NumberFormat: "%d",
+				},
+				InitialBranchPos: 0,
+			}
+			have := model.View()
+			want := `
+  main
+    alpha
+>     alpha1
+    beta
+      beta1
+
+/a1
+
+  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   / filter   r remote branches   t branch type   enter/o accept   q/esc/ctrl-c abort`[1:]
 			must.EqOp(t, want, have)
 		})
 	})
 }
+
+// localBranchInfos provides BranchInfos for local-only branches with the given names.
+func localBranchInfos(names ...gitdomain.LocalBranchName) gitdomain.BranchInfos {
+	result := make(gitdomain.BranchInfos, len(names))
+	for n, name := range names {
+		result[n] = gitdomain.BranchInfo{
+			LocalName:  name,
+			LocalSHA:   gitdomain.NewSHA("111111"),
+			RemoteName: gitdomain.EmptyRemoteBranchName(),
+			RemoteSHA:  gitdomain.EmptySHA(),
+			SyncStatus: gitdomain.SyncStatusLocalOnly,
+		}
+	}
+	return result
+}
@@ -0,0 +1,6 @@
+package flags
+
+// InferParents provides mistake-safe access to the "--infer-parents" Cobra command-line flag.
+func InferParents() (AddFunc, ReadBoolFlagFunc) {
+	return Bool("infer-parents", "", "Apply the inferred parent of branches without lineage instead of asking", FlagTypeNonPersistent)
+}
@@ -0,0 +1,19 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/shoenig/test/must"
+	"github.com/spf13/cobra"
+)
+
+func TestInferParents(t *testing.T) {
+	t.Parallel()
+	cmd := cobra.Command{}
+	addFlag, readFlag := flags.InferParents()
+	addFlag(&cmd)
+	err := cmd.ParseFlags([]string{"--infer-parents"})
+	must.NoError(t, err)
+	must.EqOp(t, true, readFlag(&cmd))
+}
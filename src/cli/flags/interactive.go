@@ -0,0 +1,6 @@
+package flags
+
+// Interactive provides mistake-safe access to the "--interactive" Cobra command-line flag.
+func Interactive() (AddFunc, ReadBoolFlagFunc) {
+	return Bool("interactive", "i", "Select the branches in a dialog", FlagTypeNonPersistent)
+}
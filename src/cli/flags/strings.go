@@ -0,0 +1,25 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// Strings provides mistake-safe access to Cobra command-line flags that can be provided multiple times.
+func Strings(name, short, desc string) (AddFunc, ReadStringsFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringArrayP(name, short, []string{}, desc)
+	}
+	readFlag := func(cmd *cobra.Command) []string {
+		value, err := cmd.Flags().GetStringArray(name)
+		if err != nil {
+			panic(fmt.Sprintf("command %q does not have a string array %q flag", cmd.Name(), name))
+		}
+		return value
+	}
+	return addFlag, readFlag
+}
+
+// ReadStringsFlagFunc defines the type signature for helper functions that provide the values of a repeatable string CLI flag associated with a Cobra command.
+type ReadStringsFlagFunc func(*cobra.Command) []string
@@ -0,0 +1,32 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/shoenig/test/must"
+	"github.com/spf13/cobra"
+)
+
+func TestStrings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("multiple values", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Strings("myflag", "m", "desc")
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--myflag", "one=1", "-m", "two=2,3"})
+		must.NoError(t, err)
+		must.Eq(t, []string{"one=1", "two=2,3"}, readFlag(&cmd))
+	})
+
+	t.Run("not provided", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Strings("myflag", "m", "desc")
+		addFlag(&cmd)
+		must.NoError(t, cmd.ParseFlags([]string{}))
+		must.SliceEmpty(t, readFlag(&cmd))
+	})
+}
@@ -7,11 +7,11 @@ import (
 )
 
 // BranchLineage provides printable formatting of the given branch lineage.
-func BranchLineage(lineage configdomain.Lineage) string {
+func BranchLineage(lineage configdomain.Lineage, branchSyncStrategies configdomain.BranchSyncStrategies) string {
 	roots := lineage.Roots()
 	trees := make([]string, len(roots))
 	for r, root := range roots {
-		trees[r] = BranchTree(root, lineage)
+		trees[r] = BranchTree(root, lineage, branchSyncStrategies)
 	}
 	return strings.Join(trees, "\n\n")
 }
@@ -1,16 +1,21 @@
 package format
 
 import (
+	"fmt"
+
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 )
 
 // BranchTree provids a printable version of the given branch tree.
-func BranchTree(branch gitdomain.LocalBranchName, lineage configdomain.Lineage) string {
+func BranchTree(branch gitdomain.LocalBranchName, lineage configdomain.Lineage, branchSyncStrategies configdomain.BranchSyncStrategies) string {
 	result := branch.String()
+	if syncStrategy, hasSyncStrategy := branchSyncStrategies[branch]; hasSyncStrategy {
+		result += fmt.Sprintf(" (sync-strategy: %s)", syncStrategy)
+	}
 	childBranches := lineage.Children(branch)
 	for _, childBranch := range childBranches {
-		result += "\n" + Indent(BranchTree(childBranch, lineage))
+		result += "\n" + Indent(BranchTree(childBranch, lineage, branchSyncStrategies))
 	}
 	return result
 }
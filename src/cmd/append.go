@@ -29,6 +29,7 @@ See "sync" for information regarding upstream remotes.`
 
 func appendCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	cmd := cobra.Command{
 		Use:     "append <branch>",
@@ -37,15 +38,16 @@ func appendCmd() *cobra.Command {
 		Short:   appendDesc,
 		Long:    cmdhelpers.Long(appendDesc, appendHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeAppend(args[0], readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeAppend(args[0], readDryRunFlag(cmd), readInferParentsFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addInferParentsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeAppend(arg string, dryRun, verbose bool) error {
+func executeAppend(arg string, dryRun, inferParents, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -57,7 +59,7 @@ func executeAppend(arg string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineAppendConfig(gitdomain.NewLocalBranchName(arg), repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineAppendConfig(gitdomain.NewLocalBranchName(arg), repo, dryRun, inferParents, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -98,11 +100,12 @@ type appendConfig struct {
 	newBranchParentCandidates gitdomain.LocalBranchNames
 	parentBranch              gitdomain.LocalBranchName
 	previousBranch            gitdomain.LocalBranchName
+	prototype                 bool // whether to create the new branch as a prototype branch
 	remotes                   gitdomain.Remotes
 	targetBranch              gitdomain.LocalBranchName
 }
 
-func determineAppendConfig(targetBranch gitdomain.LocalBranchName, repo *execute.OpenRepoResult, dryRun, verbose bool) (*appendConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineAppendConfig(targetBranch gitdomain.LocalBranchName, repo *execute.OpenRepoResult, dryRun, inferParents, verbose bool) (*appendConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	fc := execute.FailureCollector{}
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
@@ -120,6 +123,15 @@ func determineAppendConfig(targetBranch gitdomain.LocalBranchName, repo *execute
 	}
 	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
 	remotes := fc.Remotes(repo.Runner.Backend.Remotes())
+	exit, err = execute.LoadSharedLineage(execute.LoadSharedLineageArgs{
+		DialogTestInputs: &dialogTestInputs,
+		LocalBranches:    branchesSnapshot.Branches.LocalBranches().Names(),
+		Remotes:          remotes,
+		Runner:           repo.Runner,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
 	if branchesSnapshot.Branches.HasLocalBranch(targetBranch) {
 		fc.Fail(messages.BranchAlreadyExistsLocally, targetBranch)
 	}
@@ -131,6 +143,7 @@ func determineAppendConfig(targetBranch gitdomain.LocalBranchName, repo *execute
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     inferParents,
 		Runner:           repo.Runner,
 	})
 	if err != nil {
@@ -151,6 +164,7 @@ func determineAppendConfig(targetBranch gitdomain.LocalBranchName, repo *execute
 		newBranchParentCandidates: initialAndAncestors,
 		parentBranch:              branchesSnapshot.Active,
 		previousBranch:            previousBranch,
+		prototype:                 false,
 		remotes:                   remotes,
 		targetBranch:              targetBranch,
 	}, branchesSnapshot, stashSize, false, fc.Err
@@ -160,12 +174,13 @@ func appendProgram(config *appendConfig) program.Program {
 	prog := program.Program{}
 	for _, branch := range config.branchesToSync {
 		sync.BranchProgram(branch, sync.BranchProgramArgs{
-			Config:        config.FullConfig,
-			BranchInfos:   config.allBranches,
-			InitialBranch: config.initialBranch,
-			Program:       &prog,
-			Remotes:       config.remotes,
-			PushBranch:    true,
+			Config:               config.FullConfig,
+			BranchInfos:          config.allBranches,
+			InitialBranch:        config.initialBranch,
+			Program:              &prog,
+			Remotes:              config.remotes,
+			SquashMergedBranches: gitdomain.LocalBranchNames{},
+			PushBranch:           true,
 		})
 	}
 	prog.Add(&opcodes.CreateBranchExistingParent{
@@ -177,7 +192,10 @@ func appendProgram(config *appendConfig) program.Program {
 		Ancestors: config.newBranchParentCandidates,
 	})
 	prog.Add(&opcodes.Checkout{Branch: config.targetBranch})
-	if config.remotes.HasOrigin() && config.ShouldPushNewBranches() && config.IsOnline() {
+	switch {
+	case config.prototype:
+		prog.Add(&opcodes.AddToPrototypeBranches{Branch: config.targetBranch})
+	case config.remotes.HasOrigin() && config.ShouldPushNewBranches() && config.IsOnline():
 		prog.Add(&opcodes.CreateTrackingBranch{Branch: config.targetBranch})
 	}
 	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/settings"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const getConfigDesc = "Displays the value of a Git Town setting"
+
+const getConfigHelp = `
+Without a target flag, displays the effective value of the given setting.
+With --global, --local, or --file, displays the value stored in that location.`
+
+func getConfigCommand() *cobra.Command {
+	addJSONFlag, readJSONFlag := flags.Bool("json", "", "Display the value and where it comes from as JSON", flags.FlagTypeNonPersistent)
+	addTargetFlags, readTargetFlags := targetFlags()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:       "get <setting>",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: settings.Names(),
+		Short:     getConfigDesc,
+		Long:      cmdhelpers.Long(getConfigDesc, getConfigHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeGetConfig(args[0], readTargetFlags(cmd), readJSONFlag(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addJSONFlag(&cmd)
+	addTargetFlags(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeGetConfig(name string, target settings.Source, asJSON, verbose bool) error {
+	setting, err := settings.Lookup(name)
+	if err != nil {
+		return err
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	entry := settings.Entry{
+		Origins: nil,
+		Source:  settings.SourceOf(setting, repo.Runner.Config.Origins),
+		Value:   setting.Value(&repo.Runner.Config.FullConfig),
+	}
+	if target != "" {
+		value, has := settings.ValueIn(setting, target, repo.Runner.Config.Origins)
+		if !has {
+			return fmt.Errorf(messages.SettingNotSet, setting.Name(), target)
+		}
+		entry = settings.Entry{Origins: nil, Source: target, Value: value}
+	}
+	if !asJSON {
+		fmt.Println(entry.Value)
+		return nil
+	}
+	bytes, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bytes))
+	return nil
+}
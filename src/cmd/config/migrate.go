@@ -0,0 +1,93 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/config/settings"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	configInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/config"
+)
+
+// executeConfigMigrate moves all settings that the configuration file supports
+// from the local Git configuration into the configuration file.
+func executeConfigMigrate(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := configfile.LoadData()
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		data = &configfile.Data{} //nolint:exhaustruct
+	}
+	migrated := []settings.Setting{}
+	for _, setting := range settings.All() {
+		localValue, has := repo.ConfigSnapshot.Local[setting.Key]
+		if !has || !configfile.Supports(setting.Key) {
+			continue
+		}
+		newValue := localValue
+		if fileValue, hasFileValue := data.Value(setting.Key); hasFileValue && setting.List {
+			newValue = mergeLists(fileValue, localValue)
+		}
+		if err = data.SetValue(setting.Key, newValue); err != nil {
+			return err
+		}
+		migrated = append(migrated, setting)
+	}
+	// Git needs the aliases in the Git configuration to work, so they remain there
+	gitAliases := repo.Runner.Config.GitAliases()
+	for _, aliasableCommand := range configdomain.AllAliasableCommands() {
+		alias, has := gitAliases[aliasableCommand]
+		if !has {
+			continue
+		}
+		if err = data.SetValue(gitconfig.KeyForAliasableCommand(aliasableCommand), alias); err != nil {
+			return err
+		}
+	}
+	if err = configfile.SaveData(data); err != nil {
+		return err
+	}
+	for _, setting := range migrated {
+		if err = repo.Runner.Frontend.RemoveGitConfig(setting.Key, false); err != nil {
+			return err
+		}
+	}
+	fmt.Printf(messages.ConfigFileSettingsMigrated, len(migrated), configfile.FileName)
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		BeginConfigSnapshot: repo.ConfigSnapshot,
+		Command:             "config setup",
+		EndConfigSnapshot:   undoconfig.EmptyConfigSnapshot(),
+		RootDir:             repo.RootDir,
+		Runner:              repo.Runner,
+		Verbose:             verbose,
+	})
+}
+
+// mergeLists combines the given space-separated lists, without duplicates.
+func mergeLists(first, second string) string {
+	result := strings.Fields(first)
+	for _, element := range strings.Fields(second) {
+		if !slices.Contains(result, element) {
+			result = append(result, element)
+		}
+	}
+	return strings.Join(result, " ")
+}
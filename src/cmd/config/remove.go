@@ -40,14 +40,15 @@ func executeRemoveConfig(verbose bool) error {
 	if err != nil {
 		return err
 	}
-	err = repo.Runner.Config.GitConfig.RemoveLocalGitConfiguration(repo.Runner.Config.FullConfig.Lineage)
+	err = repo.Runner.Config.GitConfig.RemoveLocalGitConfiguration(repo.Runner.Config.FullConfig.Lineage, repo.Runner.Config.FullConfig.BranchSyncStrategies)
 	if err != nil {
 		return err
 	}
-	aliasNames := maps.Keys(repo.Runner.Config.FullConfig.Aliases)
+	gitAliases := repo.Runner.Config.GitAliases()
+	aliasNames := maps.Keys(gitAliases)
 	slices.Sort(aliasNames)
 	for _, aliasName := range aliasNames {
-		if strings.HasPrefix(repo.Runner.Config.FullConfig.Aliases[aliasName], "town ") {
+		if strings.HasPrefix(gitAliases[aliasName], "town ") {
 			err = repo.Runner.Frontend.RemoveGitAlias(aliasName)
 			if err != nil {
 				return err
@@ -1,20 +1,31 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/git-town/git-town/v12/src/cli/flags"
 	"github.com/git-town/git-town/v12/src/cli/format"
 	"github.com/git-town/git-town/v12/src/cli/print"
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/config/envconfig"
+	"github.com/git-town/git-town/v12/src/config/settings"
 	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 )
 
 const configDesc = "Displays your Git Town configuration"
 
 func RootCmd() *cobra.Command {
+	addJSONFlag, readJSONFlag := flags.Bool("json", "", "Display the configuration and where each value comes from as JSON", flags.FlagTypeNonPersistent)
+	addShowOriginFlag, readShowOriginFlag := flags.Bool("show-origin", "", "Display all values of each setting and where they come from", flags.FlagTypeNonPersistent)
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	configCmd := cobra.Command{
 		Use:     "config",
@@ -23,16 +34,22 @@ func RootCmd() *cobra.Command {
 		Short:   configDesc,
 		Long:    cmdhelpers.Long(configDesc),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeConfig(readVerboseFlag(cmd))
+			return executeConfig(readJSONFlag(cmd), readShowOriginFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addJSONFlag(&configCmd)
+	addShowOriginFlag(&configCmd)
 	addVerboseFlag(&configCmd)
+	configCmd.AddCommand(getConfigCommand())
 	configCmd.AddCommand(removeConfigCommand())
+	configCmd.AddCommand(setConfigCommand())
 	configCmd.AddCommand(SetupCommand())
+	configCmd.AddCommand(unsetConfigCommand())
+	configCmd.AddCommand(validateConfigCommand())
 	return &configCmd
 }
 
-func executeConfig(verbose bool) error {
+func executeConfig(asJSON, showOrigin, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  true,
@@ -44,10 +61,48 @@ func executeConfig(verbose bool) error {
 	if err != nil {
 		return err
 	}
+	if asJSON {
+		return printConfigJSON(repo, showOrigin)
+	}
+	if showOrigin {
+		return printConfigOrigins(repo)
+	}
+	if err = printConfigSources(repo.Runner.Config); err != nil {
+		return err
+	}
 	printConfig(&repo.Runner.Config.FullConfig)
 	return nil
 }
 
+// printConfigSources prints the places that Git Town reads settings from, ordered by their precedence.
+func printConfigSources(config *config.Config) error {
+	envSnapshot, _, err := envconfig.Load()
+	if err != nil {
+		return err
+	}
+	envVars := make([]string, 0, len(envSnapshot))
+	for key := range envSnapshot {
+		envVars = append(envVars, envconfig.VarName(key))
+	}
+	slices.Sort(envVars)
+	fmt.Println()
+	print.Header("Configuration sources (highest precedence first)")
+	// Git reads aliases itself, so environment variables cannot override them
+	fmt.Println("  1. environment variables (except aliases): " + format.StringsSetting(strings.Join(envVars, ", ")))
+	fmt.Println("  2. local Git configuration")
+	fmt.Println("  3. global Git configuration")
+	fmt.Println("  4. user configuration file: " + configFileStatus(configfile.UserFilePath(), config.UserConfigFile != nil))
+	fmt.Println("  5. configuration file: " + configFileStatus(configfile.FileName, config.ConfigFile != nil))
+	return nil
+}
+
+func configFileStatus(path string, exists bool) string {
+	if exists {
+		return path
+	}
+	return path + " (not found)"
+}
+
 func printConfig(config *configdomain.FullConfig) {
 	fmt.Println()
 	print.Header("Branches")
@@ -55,18 +110,27 @@ func printConfig(config *configdomain.FullConfig) {
 	print.Entry("perennial branches", format.StringsSetting((config.PerennialBranches.Join(", "))))
 	print.Entry("perennial regex", format.StringSetting(config.PerennialRegex.String()))
 	print.Entry("parked branches", format.StringsSetting((config.ParkedBranches.Join(", "))))
+	print.Entry("parked regex", format.StringSetting(config.ParkedRegex.String()))
+	print.Entry("prototype branches", format.StringsSetting((config.PrototypeBranches.Join(", "))))
 	print.Entry("contribution branches", format.StringsSetting((config.ContributionBranches.Join(", "))))
+	print.Entry("contribution regex", format.StringSetting(config.ContributionRegex.String()))
 	print.Entry("observed branches", format.StringsSetting((config.ObservedBranches.Join(", "))))
+	print.Entry("observed regex", format.StringSetting(config.ObservedRegex.String()))
+	print.Entry("default branch type", config.DefaultBranchType.String())
+	print.Entry("default remote branch type", config.DefaultRemoteBranchType.String())
 	fmt.Println()
 	print.Header("Configuration")
 	print.Entry("offline", format.Bool(config.Offline.Bool()))
 	print.Entry("run pre-push hook", format.Bool(bool(config.PushHook)))
 	print.Entry("push new branches", format.Bool(config.ShouldPushNewBranches()))
 	print.Entry("ship deletes the tracking branch", format.Bool(config.ShipDeleteTrackingBranch.Bool()))
+	print.Entry("ship message template", format.StringSetting(strings.ReplaceAll(config.ShipMessageTemplate.String(), "\n", `\n`)))
+	print.Entry("ticket regex", format.StringSetting(config.TicketRegex.String()))
 	print.Entry("sync-feature strategy", config.SyncFeatureStrategy.String())
 	print.Entry("sync-perennial strategy", config.SyncPerennialStrategy.String())
 	print.Entry("sync with upstream", format.Bool(config.SyncUpstream.Bool()))
 	print.Entry("sync before shipping", format.Bool(config.SyncBeforeShip.Bool()))
+	print.Entry("share lineage", format.Bool(config.ShareLineage.Bool()))
 	fmt.Println()
 	print.Header("Hosting")
 	print.Entry("hosting platform override", format.StringSetting(config.HostingPlatform.String()))
@@ -75,6 +139,61 @@ func printConfig(config *configdomain.FullConfig) {
 	print.Entry("Gitea token", format.StringSetting(string(config.GiteaToken)))
 	fmt.Println()
 	if !config.MainBranch.IsEmpty() {
-		print.LabelAndValue("Branch Lineage", format.BranchLineage(config.Lineage))
+		print.LabelAndValue("Branch Lineage", format.BranchLineage(config.Lineage, config.BranchSyncStrategies))
+	}
+}
+
+func printConfigJSON(repo *execute.OpenRepoResult, showOrigin bool) error {
+	dump := settings.NewDump(&repo.Runner.Config.FullConfig, repo.Runner.Config.Origins)
+	if showOrigin {
+		dump.AddOrigins(repo.Runner.Config.Origins, repo.Runner.Config.GitConfig.DeprecatedSettings())
+	}
+	bytes, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bytes))
+	return nil
+}
+
+// printConfigOrigins prints all values of each setting, where they come from, and which of them are in effect.
+func printConfigOrigins(repo *execute.OpenRepoResult) error {
+	fmt.Println()
+	print.Header("Settings")
+	for _, setting := range settings.All() {
+		print.Entry(setting.Name(), format.StringSetting(setting.Value(&repo.Runner.Config.FullConfig)))
+		printOrigins(settings.Origins(setting, repo.Runner.Config.Origins))
+	}
+	branchOrigins := settings.BranchOrigins(repo.Runner.Config.Origins)
+	if len(branchOrigins) > 0 {
+		fmt.Println()
+		print.Header("Branch settings")
+		keys := maps.Keys(branchOrigins)
+		slices.Sort(keys)
+		for _, key := range keys {
+			print.Entry(key, format.StringSetting(branchOrigins[key][0].Value))
+			printOrigins(branchOrigins[key])
+		}
+	}
+	deprecatedSettings := repo.Runner.Config.GitConfig.DeprecatedSettings()
+	if len(deprecatedSettings) > 0 {
+		fmt.Println()
+		print.Header("Deprecated settings")
+		for _, deprecatedSetting := range deprecatedSettings {
+			print.Entry(deprecatedSetting.Key.String(), fmt.Sprintf(messages.ConfigDeprecatedSetting, deprecatedSetting.Origin, deprecatedSetting.NewKey))
+		}
+	}
+	fmt.Println()
+	return nil
+}
+
+// printOrigins prints the given values of a setting and whether they are in effect.
+func printOrigins(origins []settings.Origin) {
+	for _, origin := range origins {
+		line := fmt.Sprintf("    %s: %s", origin.Source, format.StringSetting(origin.Value))
+		if !origin.Active {
+			line += " (overridden)"
+		}
+		fmt.Println(line)
 	}
 }
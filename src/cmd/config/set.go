@@ -0,0 +1,98 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/config/settings"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	configInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/config"
+	"github.com/spf13/cobra"
+)
+
+const setConfigDesc = "Changes the value of a Git Town setting"
+
+const setConfigHelp = `
+Stores the given value in the local Git configuration,
+or in the location selected by --global or --file.
+Git Town checks that the value is valid for the given setting.`
+
+func setConfigCommand() *cobra.Command {
+	addTargetFlags, readTargetFlags := targetFlags()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:       "set <setting> <value>",
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: settings.Names(),
+		Short:     setConfigDesc,
+		Long:      cmdhelpers.Long(setConfigDesc, setConfigHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeSetConfig(args[0], args[1], readTargetFlags(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addTargetFlags(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeSetConfig(name, value string, target settings.Source, verbose bool) error {
+	setting, err := settings.Lookup(name)
+	if err != nil {
+		return err
+	}
+	if err = setting.Validate(value); err != nil {
+		return err
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	switch target {
+	case settings.SourceFile:
+		err = setConfigFileValue(setting, value)
+	case settings.SourceGlobal:
+		err = repo.Runner.Frontend.SetGitConfig(setting.Key, value, true)
+	case settings.SourceLocal, settings.SourceDefault, "":
+		err = repo.Runner.Frontend.SetGitConfig(setting.Key, value, false)
+	}
+	if err != nil {
+		return err
+	}
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		BeginConfigSnapshot: repo.ConfigSnapshot,
+		Command:             "config set",
+		EndConfigSnapshot:   undoconfig.EmptyConfigSnapshot(),
+		RootDir:             repo.RootDir,
+		Runner:              repo.Runner,
+		Verbose:             verbose,
+	})
+}
+
+func setConfigFileValue(setting settings.Setting, value string) error {
+	data, err := configfile.LoadData()
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		data = &configfile.Data{} //nolint:exhaustruct
+	}
+	if err = data.SetValue(setting.Key, value); err != nil {
+		return err
+	}
+	if err = configfile.SaveData(data); err != nil {
+		return err
+	}
+	fmt.Printf(messages.ConfigFileSettingSet, setting.Name(), value, configfile.FileName)
+	return nil
+}
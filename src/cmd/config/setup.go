@@ -10,6 +10,8 @@ import (
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/config/settings"
 	"github.com/git-town/git-town/v12/src/execute"
 	"github.com/git-town/git-town/v12/src/git"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
@@ -21,18 +23,52 @@ import (
 
 const setupConfigDesc = "Prompts to setup your Git Town configuration"
 
+const setupConfigHelp = `
+With --migrate, moves all settings of this repository
+that the configuration file supports
+from the local Git configuration into the configuration file
+without prompting.
+
+To set up Git Town without prompting, for example in provisioning scripts,
+provide answers through --set <setting>=<value>, which you can repeat,
+or through --answers <file>, which contains them in TOML or JSON format.
+Git Town uses the values it detects for all other questions.
+With --yes, Git Town accepts the detected values for all questions.
+
+Besides the settings that the setup assistant asks for,
+answers can contain "aliases" (the commands to alias, "all", or "none")
+and "config-storage" ("file" or "git").`
+
 func SetupCommand() *cobra.Command {
+	addAnswersFlag, readAnswersFlag := flags.String("answers", "", "", "Read the answers from the given TOML or JSON file instead of prompting")
+	addMigrateFlag, readMigrateFlag := flags.Bool("migrate", "", "Move the local Git configuration into the configuration file", flags.FlagTypeNonPersistent)
+	addSetFlag, readSetFlag := flags.Strings("set", "", "Answer the question for the given setting, in the format <setting>=<value>")
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addYesFlag, readYesFlag := flags.Bool("yes", "y", "Accept the detected values for all questions without prompting", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:   "setup",
 		Args:  cobra.NoArgs,
 		Short: setupConfigDesc,
-		Long:  cmdhelpers.Long(setupConfigDesc),
+		Long:  cmdhelpers.Long(setupConfigDesc, setupConfigHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeConfigSetup(readVerboseFlag(cmd))
+			if readMigrateFlag(cmd) {
+				return executeConfigMigrate(readVerboseFlag(cmd))
+			}
+			answersFile := readAnswersFlag(cmd)
+			assignments := readSetFlag(cmd)
+			answers, err := loadAnswers(answersFile, assignments)
+			if err != nil {
+				return err
+			}
+			interactive := !readYesFlag(cmd) && answersFile == "" && len(assignments) == 0
+			return executeConfigSetup(answers, interactive, readVerboseFlag(cmd))
 		},
 	}
+	addAnswersFlag(&cmd)
+	addMigrateFlag(&cmd)
+	addSetFlag(&cmd)
 	addVerboseFlag(&cmd)
+	addYesFlag(&cmd)
 	return &cmd
 }
 
@@ -44,7 +80,7 @@ func defaultUserInput() userInput {
 	}
 }
 
-func executeConfigSetup(verbose bool) error {
+func executeConfigSetup(answers settings.Answers, interactive, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  true,
@@ -60,11 +96,18 @@ func executeConfigSetup(verbose bool) error {
 	if err != nil || exit {
 		return err
 	}
-	aborted, err := enterData(repo.Runner, &config)
-	if err != nil || aborted {
-		return err
+	if interactive {
+		aborted, err := enterData(repo.Runner, &config)
+		if err != nil || aborted {
+			return err
+		}
+	} else {
+		err = applyAnswers(repo.Runner, &config, answers)
+		if err != nil {
+			return err
+		}
 	}
-	err = saveAll(repo.Runner, config.userInput)
+	err = saveAll(repo.Runner, &config.oldConfig, config.userInput)
 	if err != nil {
 		return err
 	}
@@ -82,6 +125,7 @@ type setupConfig struct {
 	dialogInputs  components.TestInputs
 	hasConfigFile bool
 	localBranches gitdomain.BranchInfos
+	oldConfig     configdomain.FullConfig // the configuration that the user input replaces, only settings that differ from it get saved
 	userInput     userInput
 }
 
@@ -122,6 +166,18 @@ func enterData(runner *git.ProdRunner, config *setupConfig) (aborted bool, err e
 	if err != nil || aborted {
 		return aborted, err
 	}
+	config.userInput.ContributionRegex, aborted, err = dialog.ContributionRegex(runner.Config.FullConfig.ContributionRegex, config.dialogInputs.Next())
+	if err != nil || aborted {
+		return aborted, err
+	}
+	config.userInput.ObservedRegex, aborted, err = dialog.ObservedRegex(runner.Config.FullConfig.ObservedRegex, config.dialogInputs.Next())
+	if err != nil || aborted {
+		return aborted, err
+	}
+	config.userInput.ParkedRegex, aborted, err = dialog.ParkedRegex(runner.Config.FullConfig.ParkedRegex, config.dialogInputs.Next())
+	if err != nil || aborted {
+		return aborted, err
+	}
 	config.userInput.HostingPlatform, aborted, err = dialog.HostingPlatform(runner.Config.FullConfig.HostingPlatform, config.dialogInputs.Next())
 	if err != nil || aborted {
 		return aborted, err
@@ -201,24 +257,25 @@ func loadSetupConfig(repo *execute.OpenRepoResult, verbose bool) (setupConfig, b
 		dialogInputs:  dialogTestInputs,
 		hasConfigFile: repo.Runner.Config.ConfigFile != nil,
 		localBranches: branchesSnapshot.Branches,
+		oldConfig:     repo.Runner.Config.FullConfig,
 		userInput:     defaultUserInput(),
 	}, exit, err
 }
 
-func saveAll(runner *git.ProdRunner, userInput userInput) error {
+func saveAll(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, userInput userInput) error {
 	err := saveAliases(runner, userInput.Aliases)
 	if err != nil {
 		return err
 	}
-	err = saveGiteaToken(runner, userInput.GiteaToken)
+	err = saveGiteaToken(runner, oldConfig, userInput.GiteaToken)
 	if err != nil {
 		return err
 	}
-	err = saveGitHubToken(runner, userInput.GitHubToken)
+	err = saveGitHubToken(runner, oldConfig, userInput.GitHubToken)
 	if err != nil {
 		return err
 	}
-	err = saveGitLabToken(runner, userInput.GitLabToken)
+	err = saveGitLabToken(runner, oldConfig, userInput.GitLabToken)
 	if err != nil {
 		return err
 	}
@@ -226,57 +283,69 @@ func saveAll(runner *git.ProdRunner, userInput userInput) error {
 	case dialog.ConfigStorageOptionFile:
 		return saveToFile(userInput, runner)
 	case dialog.ConfigStorageOptionGit:
-		return saveToGit(runner, userInput)
+		return saveToGit(runner, oldConfig, userInput)
 	}
 	panic("unknown configStorage: " + userInput.configStorage)
 }
 
-func saveToGit(runner *git.ProdRunner, userInput userInput) error {
-	err := saveHostingPlatform(runner, userInput.HostingPlatform)
+func saveToGit(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, userInput userInput) error {
+	err := saveHostingPlatform(runner, oldConfig, userInput.HostingPlatform)
+	if err != nil {
+		return err
+	}
+	err = saveOriginHostname(runner, oldConfig, userInput.HostingOriginHostname)
+	if err != nil {
+		return err
+	}
+	err = saveMainBranch(runner, oldConfig, userInput.MainBranch)
 	if err != nil {
 		return err
 	}
-	err = saveOriginHostname(runner, userInput.HostingOriginHostname)
+	err = savePerennialBranches(runner, oldConfig, userInput.PerennialBranches)
 	if err != nil {
 		return err
 	}
-	err = saveMainBranch(runner, userInput.MainBranch)
+	err = savePerennialRegex(runner, oldConfig, userInput.PerennialRegex)
 	if err != nil {
 		return err
 	}
-	err = savePerennialBranches(runner, userInput.PerennialBranches)
+	err = saveContributionRegex(runner, oldConfig, userInput.ContributionRegex)
 	if err != nil {
 		return err
 	}
-	err = savePerennialRegex(runner, userInput.PerennialRegex)
+	err = saveObservedRegex(runner, oldConfig, userInput.ObservedRegex)
 	if err != nil {
 		return err
 	}
-	err = savePushHook(runner, userInput.PushHook)
+	err = saveParkedRegex(runner, oldConfig, userInput.ParkedRegex)
 	if err != nil {
 		return err
 	}
-	err = savePushNewBranches(runner, userInput.PushNewBranches)
+	err = savePushHook(runner, oldConfig, userInput.PushHook)
 	if err != nil {
 		return err
 	}
-	err = saveShipDeleteTrackingBranch(runner, userInput.ShipDeleteTrackingBranch)
+	err = savePushNewBranches(runner, oldConfig, userInput.PushNewBranches)
 	if err != nil {
 		return err
 	}
-	err = saveSyncFeatureStrategy(runner, userInput.SyncFeatureStrategy)
+	err = saveShipDeleteTrackingBranch(runner, oldConfig, userInput.ShipDeleteTrackingBranch)
 	if err != nil {
 		return err
 	}
-	err = saveSyncPerennialStrategy(runner, userInput.SyncPerennialStrategy)
+	err = saveSyncFeatureStrategy(runner, oldConfig, userInput.SyncFeatureStrategy)
 	if err != nil {
 		return err
 	}
-	err = saveSyncUpstream(runner, userInput.SyncUpstream)
+	err = saveSyncPerennialStrategy(runner, oldConfig, userInput.SyncPerennialStrategy)
 	if err != nil {
 		return err
 	}
-	err = saveSyncBeforeShip(runner, userInput.SyncBeforeShip)
+	err = saveSyncUpstream(runner, oldConfig, userInput.SyncUpstream)
+	if err != nil {
+		return err
+	}
+	err = saveSyncBeforeShip(runner, oldConfig, userInput.SyncBeforeShip)
 	if err != nil {
 		return err
 	}
@@ -284,8 +353,9 @@ func saveToGit(runner *git.ProdRunner, userInput userInput) error {
 }
 
 func saveAliases(runner *git.ProdRunner, newAliases configdomain.Aliases) (err error) {
+	gitAliases := runner.Config.GitAliases()
 	for _, aliasableCommand := range configdomain.AllAliasableCommands() {
-		oldAlias, hasOld := runner.Config.FullConfig.Aliases[aliasableCommand]
+		oldAlias, hasOld := gitAliases[aliasableCommand]
 		newAlias, hasNew := newAliases[aliasableCommand]
 		switch {
 		case hasOld && !hasNew:
@@ -300,29 +370,36 @@ func saveAliases(runner *git.ProdRunner, newAliases configdomain.Aliases) (err e
 	return nil
 }
 
-func saveGiteaToken(runner *git.ProdRunner, newToken configdomain.GiteaToken) error {
-	if newToken == runner.Config.FullConfig.GiteaToken {
+func saveContributionRegex(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.ContributionRegex) error {
+	if newValue == oldConfig.ContributionRegex {
+		return nil
+	}
+	return runner.Config.SetContributionRegexLocally(newValue)
+}
+
+func saveGiteaToken(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newToken configdomain.GiteaToken) error {
+	if newToken == oldConfig.GiteaToken {
 		return nil
 	}
 	return runner.Frontend.SetGiteaToken(newToken)
 }
 
-func saveGitHubToken(runner *git.ProdRunner, newToken configdomain.GitHubToken) error {
-	if newToken == runner.Config.FullConfig.GitHubToken {
+func saveGitHubToken(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newToken configdomain.GitHubToken) error {
+	if newToken == oldConfig.GitHubToken {
 		return nil
 	}
 	return runner.Frontend.SetGitHubToken(newToken)
 }
 
-func saveGitLabToken(runner *git.ProdRunner, newToken configdomain.GitLabToken) error {
-	if newToken == runner.Config.FullConfig.GitLabToken {
+func saveGitLabToken(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newToken configdomain.GitLabToken) error {
+	if newToken == oldConfig.GitLabToken {
 		return nil
 	}
 	return runner.Frontend.SetGitLabToken(newToken)
 }
 
-func saveHostingPlatform(runner *git.ProdRunner, newValue configdomain.HostingPlatform) (err error) {
-	oldValue := runner.Config.FullConfig.HostingPlatform
+func saveHostingPlatform(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.HostingPlatform) (err error) {
+	oldValue := oldConfig.HostingPlatform
 	switch {
 	case oldValue == "" && newValue == configdomain.HostingPlatformNone:
 		// no changes --> do nothing
@@ -334,82 +411,96 @@ func saveHostingPlatform(runner *git.ProdRunner, newValue configdomain.HostingPl
 	return nil
 }
 
-func saveMainBranch(runner *git.ProdRunner, newValue gitdomain.LocalBranchName) error {
-	if newValue == runner.Config.FullConfig.MainBranch {
+func saveMainBranch(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue gitdomain.LocalBranchName) error {
+	if newValue == oldConfig.MainBranch {
 		return nil
 	}
 	return runner.Config.SetMainBranch(newValue)
 }
 
-func saveOriginHostname(runner *git.ProdRunner, newValue configdomain.HostingOriginHostname) error {
-	if newValue == runner.Config.FullConfig.HostingOriginHostname {
+func saveObservedRegex(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.ObservedRegex) error {
+	if newValue == oldConfig.ObservedRegex {
+		return nil
+	}
+	return runner.Config.SetObservedRegexLocally(newValue)
+}
+
+func saveOriginHostname(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.HostingOriginHostname) error {
+	if newValue == oldConfig.HostingOriginHostname {
 		return nil
 	}
-	if runner.Config.FullConfig.HostingOriginHostname != "" && newValue == "" {
+	if oldConfig.HostingOriginHostname != "" && newValue == "" {
 		return runner.Frontend.DeleteOriginHostname()
 	}
 	return runner.Frontend.SetOriginHostname(newValue)
 }
 
-func savePerennialBranches(runner *git.ProdRunner, newValue gitdomain.LocalBranchNames) error {
-	oldValue := runner.Config.FullConfig.PerennialBranches
+func saveParkedRegex(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.ParkedRegex) error {
+	if newValue == oldConfig.ParkedRegex {
+		return nil
+	}
+	return runner.Config.SetParkedRegexLocally(newValue)
+}
+
+func savePerennialBranches(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue gitdomain.LocalBranchNames) error {
+	oldValue := oldConfig.PerennialBranches
 	if slices.Compare(oldValue, newValue) != 0 || runner.Config.LocalGitConfig.PerennialBranches == nil {
 		return runner.Config.SetPerennialBranches(newValue)
 	}
 	return nil
 }
 
-func savePerennialRegex(runner *git.ProdRunner, newValue configdomain.PerennialRegex) error {
-	if newValue == runner.Config.FullConfig.PerennialRegex {
+func savePerennialRegex(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.PerennialRegex) error {
+	if newValue == oldConfig.PerennialRegex {
 		return nil
 	}
 	return runner.Config.SetPerennialRegexLocally(newValue)
 }
 
-func savePushHook(runner *git.ProdRunner, newValue configdomain.PushHook) error {
-	if newValue == runner.Config.FullConfig.PushHook {
+func savePushHook(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.PushHook) error {
+	if newValue == oldConfig.PushHook {
 		return nil
 	}
 	return runner.Config.SetPushHookLocally(newValue)
 }
 
-func savePushNewBranches(runner *git.ProdRunner, newValue configdomain.PushNewBranches) error {
-	if newValue == runner.Config.FullConfig.PushNewBranches {
+func savePushNewBranches(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.PushNewBranches) error {
+	if newValue == oldConfig.PushNewBranches {
 		return nil
 	}
 	return runner.Config.SetPushNewBranches(newValue, false)
 }
 
-func saveShipDeleteTrackingBranch(runner *git.ProdRunner, newValue configdomain.ShipDeleteTrackingBranch) error {
-	if newValue == runner.Config.FullConfig.ShipDeleteTrackingBranch {
+func saveShipDeleteTrackingBranch(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.ShipDeleteTrackingBranch) error {
+	if newValue == oldConfig.ShipDeleteTrackingBranch {
 		return nil
 	}
 	return runner.Config.SetShipDeleteTrackingBranch(newValue, false)
 }
 
-func saveSyncFeatureStrategy(runner *git.ProdRunner, newValue configdomain.SyncFeatureStrategy) error {
-	if newValue == runner.Config.FullConfig.SyncFeatureStrategy {
+func saveSyncFeatureStrategy(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.SyncFeatureStrategy) error {
+	if newValue == oldConfig.SyncFeatureStrategy {
 		return nil
 	}
 	return runner.Config.SetSyncFeatureStrategy(newValue)
 }
 
-func saveSyncPerennialStrategy(runner *git.ProdRunner, newValue configdomain.SyncPerennialStrategy) error {
-	if newValue == runner.Config.FullConfig.SyncPerennialStrategy {
+func saveSyncPerennialStrategy(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.SyncPerennialStrategy) error {
+	if newValue == oldConfig.SyncPerennialStrategy {
 		return nil
 	}
 	return runner.Config.SetSyncPerennialStrategy(newValue)
 }
 
-func saveSyncUpstream(runner *git.ProdRunner, newValue configdomain.SyncUpstream) error {
-	if newValue == runner.Config.FullConfig.SyncUpstream {
+func saveSyncUpstream(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.SyncUpstream) error {
+	if newValue == oldConfig.SyncUpstream {
 		return nil
 	}
 	return runner.Config.SetSyncUpstream(newValue, false)
 }
 
-func saveSyncBeforeShip(runner *git.ProdRunner, newValue configdomain.SyncBeforeShip) error {
-	if newValue == runner.Config.FullConfig.SyncBeforeShip {
+func saveSyncBeforeShip(runner *git.ProdRunner, oldConfig *configdomain.FullConfig, newValue configdomain.SyncBeforeShip) error {
+	if newValue == oldConfig.SyncBeforeShip {
 		return nil
 	}
 	return runner.Config.SetSyncBeforeShip(newValue, false)
@@ -423,6 +514,9 @@ func saveToFile(userInput userInput, runner *git.ProdRunner) error {
 	runner.Config.RemoveMainBranch()
 	runner.Config.RemovePerennialBranches()
 	runner.Config.RemovePerennialRegex()
+	runner.Config.RemoveContributionRegex()
+	runner.Config.RemoveObservedRegex()
+	runner.Config.RemoveParkedRegex()
 	runner.Config.RemovePushNewBranches()
 	runner.Config.RemovePushHook()
 	runner.Config.RemoveSyncBeforeShip()
@@ -430,5 +524,15 @@ func saveToFile(userInput userInput, runner *git.ProdRunner) error {
 	runner.Config.RemoveSyncFeatureStrategy()
 	runner.Config.RemoveSyncPerennialStrategy()
 	runner.Config.RemoveSyncUpstream()
+	// the configuration file now also contains these settings
+	for _, key := range []gitconfig.Key{
+		gitconfig.KeyDefaultBranchType,
+		gitconfig.KeyDefaultRemoteBranchType,
+		gitconfig.KeyShareLineage,
+		gitconfig.KeyShipMessageTemplate,
+		gitconfig.KeyTicketRegex,
+	} {
+		_ = runner.Config.GitConfig.RemoveLocalConfigValue(key)
+	}
 	return nil
 }
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/config/settings"
+	"github.com/git-town/git-town/v12/src/git"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+const (
+	answerAliases       = "aliases"        // the commands to alias, "all", or "none"
+	answerConfigStorage = "config-storage" // where to store the configuration: "file" or "git"
+)
+
+// setupAnswerKeys provides the settings that the setup assistant configures.
+func setupAnswerKeys() []gitconfig.Key {
+	return []gitconfig.Key{
+		gitconfig.KeyContributionRegex,
+		gitconfig.KeyGiteaToken,
+		gitconfig.KeyGithubToken,
+		gitconfig.KeyGitlabToken,
+		gitconfig.KeyHostingOriginHostname,
+		gitconfig.KeyHostingPlatform,
+		gitconfig.KeyMainBranch,
+		gitconfig.KeyObservedRegex,
+		gitconfig.KeyParkedRegex,
+		gitconfig.KeyPerennialBranches,
+		gitconfig.KeyPerennialRegex,
+		gitconfig.KeyPushHook,
+		gitconfig.KeyPushNewBranches,
+		gitconfig.KeyShipDeleteTrackingBranch,
+		gitconfig.KeySyncBeforeShip,
+		gitconfig.KeySyncFeatureStrategy,
+		gitconfig.KeySyncPerennialStrategy,
+		gitconfig.KeySyncUpstream,
+	}
+}
+
+// loadAnswers provides the answers in the given answers file and the given "setting=value" assignments.
+// The assignments override the answers file.
+func loadAnswers(fileName string, assignments []string) (settings.Answers, error) {
+	answers := settings.Answers{}
+	if fileName != "" {
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			return answers, fmt.Errorf(messages.FileReadProblem, fileName, err)
+		}
+		answers, err = settings.ParseAnswers(fileName, content)
+		if err != nil {
+			return answers, err
+		}
+	}
+	for _, assignment := range assignments {
+		if err := answers.Add(assignment); err != nil {
+			return answers, err
+		}
+	}
+	return answers, nil
+}
+
+// applyAnswers populates the user input of the given setup config with the given answers
+// and the values that the repository already configures for everything else.
+// Values from the global Git configuration, the user-level configuration file,
+// and environment variables don't become part of the repository configuration.
+func applyAnswers(runner *git.ProdRunner, config *setupConfig, answers settings.Answers) error {
+	config.oldConfig = repositoryConfig(runner)
+	config.userInput.FullConfig = repositoryConfig(runner)
+	config.userInput.Aliases = runner.Config.GitAliases()
+	for aliasableCommand, alias := range config.oldConfig.Aliases {
+		// install the aliases that the configuration file suggests
+		config.userInput.Aliases[aliasableCommand] = alias
+	}
+	if config.userInput.MainBranch.IsEmpty() {
+		config.userInput.MainBranch = runner.Backend.DefaultBranch()
+	}
+	localBranchNames := config.localBranches.Names()
+	if !slices.Contains(localBranchNames, config.userInput.MainBranch) && len(localBranchNames) > 0 {
+		// like the main branch dialog, preselect the first local branch
+		config.userInput.MainBranch = localBranchNames[0]
+	}
+	settingAnswers := settings.Answers{}
+	for name, value := range answers {
+		var err error
+		switch name {
+		case answerAliases:
+			config.userInput.Aliases, err = parseAliasesAnswer(value)
+		case answerConfigStorage:
+			config.userInput.configStorage, err = parseConfigStorageAnswer(value)
+		default:
+			settingAnswers[name] = value
+		}
+		if err != nil {
+			return err
+		}
+	}
+	partialConfig, err := settingAnswers.PartialConfig(setupAnswerKeys())
+	if err != nil {
+		return err
+	}
+	if partialConfig.PerennialBranches != nil {
+		// the answer replaces the existing perennial branches instead of adding to them
+		config.userInput.PerennialBranches = nil
+	}
+	config.userInput.Merge(partialConfig)
+	if config.userInput.MainBranch.IsEmpty() {
+		return errors.New(messages.SetupMainBranchMissing)
+	}
+	if !slices.Contains(localBranchNames, config.userInput.MainBranch) {
+		return fmt.Errorf(messages.SetupMainBranchNotFound, config.userInput.MainBranch)
+	}
+	return nil
+}
+
+// repositoryConfig provides the configuration that the configuration file and the local Git configuration of the given repository define.
+func repositoryConfig(runner *git.ProdRunner) configdomain.FullConfig {
+	result := configdomain.DefaultConfig()
+	if runner.Config.ConfigFile != nil {
+		result.Merge(*runner.Config.ConfigFile)
+	}
+	result.Merge(runner.Config.LocalGitConfig)
+	return result
+}
+
+// parseAliasesAnswer provides the Git aliases that the given answer for the "aliases" question selects.
+func parseAliasesAnswer(answer string) (configdomain.Aliases, error) {
+	result := configdomain.Aliases{}
+	allAliasableCommands := configdomain.AllAliasableCommands()
+	names := strings.Fields(strings.ReplaceAll(answer, ",", " "))
+	if len(names) == 1 && names[0] == "all" {
+		names = allAliasableCommands.Strings()
+	}
+	if len(names) == 1 && names[0] == "none" {
+		names = []string{}
+	}
+	for _, name := range names {
+		aliasableCommand, isAliasable := allAliasableCommands.Find(name)
+		if !isAliasable {
+			return result, fmt.Errorf(messages.SetupAliasUnknown, name, strings.Join(allAliasableCommands.Strings(), ", "))
+		}
+		result[aliasableCommand] = "town " + aliasableCommand.String()
+	}
+	return result, nil
+}
+
+// parseConfigStorageAnswer provides the configuration storage that the given answer for the "config-storage" question selects.
+func parseConfigStorageAnswer(answer string) (dialog.ConfigStorageOption, error) {
+	for _, option := range []dialog.ConfigStorageOption{dialog.ConfigStorageOptionFile, dialog.ConfigStorageOptionGit} {
+		if answer == option.Short() {
+			return option, nil
+		}
+	}
+	return dialog.ConfigStorageOptionFile, fmt.Errorf(messages.SetupConfigStorageUnknown, answer)
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/config/settings"
+	"github.com/spf13/cobra"
+)
+
+// targetFlags provides the "--global", "--local", and "--file" flags
+// that select the configuration layer that a command reads or changes.
+// The read function returns an empty Source if the user provided none of these flags.
+func targetFlags() (flags.AddFunc, func(*cobra.Command) settings.Source) {
+	addGlobalFlag, readGlobalFlag := flags.Bool("global", "", "Use the global Git configuration", flags.FlagTypeNonPersistent)
+	addLocalFlag, readLocalFlag := flags.Bool("local", "", "Use the local Git configuration", flags.FlagTypeNonPersistent)
+	addFileFlag, readFileFlag := flags.Bool("file", "", "Use the "+configfile.FileName+" configuration file", flags.FlagTypeNonPersistent)
+	addFlags := func(cmd *cobra.Command) {
+		addGlobalFlag(cmd)
+		addLocalFlag(cmd)
+		addFileFlag(cmd)
+		cmd.MarkFlagsMutuallyExclusive("global", "local", "file")
+	}
+	readFlags := func(cmd *cobra.Command) settings.Source {
+		switch {
+		case readGlobalFlag(cmd):
+			return settings.SourceGlobal
+		case readLocalFlag(cmd):
+			return settings.SourceLocal
+		case readFileFlag(cmd):
+			return settings.SourceFile
+		}
+		return ""
+	}
+	return addFlags, readFlags
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/config/settings"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	configInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/config"
+	"github.com/spf13/cobra"
+)
+
+const unsetConfigDesc = "Removes a Git Town setting"
+
+const unsetConfigHelp = `
+Removes the given setting from the local Git configuration,
+or from the location selected by --global or --file.`
+
+func unsetConfigCommand() *cobra.Command {
+	addTargetFlags, readTargetFlags := targetFlags()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:       "unset <setting>",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: settings.Names(),
+		Short:     unsetConfigDesc,
+		Long:      cmdhelpers.Long(unsetConfigDesc, unsetConfigHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeUnsetConfig(args[0], readTargetFlags(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addTargetFlags(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeUnsetConfig(name string, target settings.Source, verbose bool) error {
+	setting, err := settings.Lookup(name)
+	if err != nil {
+		return err
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	if target == "" {
+		target = settings.SourceLocal
+	}
+	if _, has := settings.ValueIn(setting, target, repo.Runner.Config.Origins); !has {
+		return fmt.Errorf(messages.SettingNotSet, setting.Name(), target)
+	}
+	switch target {
+	case settings.SourceFile:
+		err = removeConfigFileValue(setting)
+	case settings.SourceGlobal:
+		err = repo.Runner.Frontend.RemoveGitConfig(setting.Key, true)
+	case settings.SourceLocal, settings.SourceDefault:
+		err = repo.Runner.Frontend.RemoveGitConfig(setting.Key, false)
+	}
+	if err != nil {
+		return err
+	}
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		BeginConfigSnapshot: repo.ConfigSnapshot,
+		Command:             "config unset",
+		EndConfigSnapshot:   undoconfig.EmptyConfigSnapshot(),
+		RootDir:             repo.RootDir,
+		Runner:              repo.Runner,
+		Verbose:             verbose,
+	})
+}
+
+func removeConfigFileValue(setting settings.Setting) error {
+	data, err := configfile.LoadData()
+	if err != nil {
+		return err
+	}
+	if err := data.RemoveValue(setting.Key); err != nil {
+		return err
+	}
+	if err := configfile.SaveData(data); err != nil {
+		return err
+	}
+	fmt.Printf(messages.ConfigFileSettingRemoved, setting.Name(), configfile.FileName)
+	return nil
+}
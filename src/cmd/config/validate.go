@@ -0,0 +1,95 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const validateConfigDesc = "Checks the configuration file for errors"
+
+const validateConfigHelp = `
+Reports unknown keys, values with the wrong type,
+and invalid values in the configuration file
+together with their line and column.
+Exits with an error if it finds problems,
+which makes it suitable for CI pipelines.
+
+With --schema, prints a JSON Schema for the configuration file
+that editors can use to validate and auto-complete it.`
+
+func validateConfigCommand() *cobra.Command {
+	addSchemaFlag, readSchemaFlag := flags.Bool("schema", "", "Print the JSON Schema for the configuration file", flags.FlagTypeNonPersistent)
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "validate",
+		Args:  cobra.NoArgs,
+		Short: validateConfigDesc,
+		Long:  cmdhelpers.Long(validateConfigDesc, validateConfigHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if readSchemaFlag(cmd) {
+				return printJSONSchema()
+			}
+			return executeValidateConfig(readVerboseFlag(cmd))
+		},
+	}
+	addSchemaFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeValidateConfig(verbose bool) error {
+	// OpenRepo only warns about unknown keys in the configuration file it loads, so check that file strictly before
+	if _, err := os.Stat(configfile.FileName); err == nil {
+		if err = checkConfigFile(configfile.FileName); err != nil {
+			return err
+		}
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	if err = checkConfigFile(filepath.Join(repo.RootDir.String(), configfile.FileName)); err != nil {
+		return err
+	}
+	fmt.Printf(messages.ConfigFileValid, configfile.FileName)
+	return nil
+}
+
+// checkConfigFile provides all problems in the configuration file at the given path.
+func checkConfigFile(path string) error {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf(messages.ConfigFileNotFound, configfile.FileName)
+		}
+		return fmt.Errorf(messages.ConfigFileCannotRead, configfile.FileName, err)
+	}
+	if problems := configfile.Check(configfile.FileName, string(bytes)); len(problems) > 0 {
+		return problems
+	}
+	return nil
+}
+
+func printJSONSchema() error {
+	schema, err := configfile.JSONSchema()
+	if err != nil {
+		return err
+	}
+	fmt.Print(schema)
+	return nil
+}
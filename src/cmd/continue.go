@@ -102,6 +102,7 @@ func determineContinueConfig(repo *execute.OpenRepoResult, verbose bool) (*conti
 		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
 		Log:             print.Logger{},
 		OriginURL:       originURL,
+		UpstreamURL:     repo.Runner.Config.UpstreamURL(),
 	})
 	return &continueConfig{
 		FullConfig:       &repo.Runner.Config.FullConfig,
@@ -3,7 +3,9 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"os"
 
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/cli/flags"
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v12/src/config"
@@ -22,6 +24,7 @@ const contributeDesc = "Stops syncing some feature branches with their parents"
 const contributeHelp = `
 Marks the given local branches as contribution.
 If no branch is provided, marks the current branch.
+With --interactive, select the branches in a dialog.
 
 Contribution branches are useful when you assist other developers
 and make commits to their branch,
@@ -35,6 +38,7 @@ On a contribution branch, "git sync"
 `
 
 func contributeCmd() *cobra.Command {
+	addInteractiveFlag, readInteractiveFlag := flags.Interactive()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     "contribute [branches]",
@@ -43,14 +47,15 @@ func contributeCmd() *cobra.Command {
 		Short:   contributeDesc,
 		Long:    cmdhelpers.Long(contributeDesc, contributeHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeContribute(args, readVerboseFlag(cmd))
+			return executeContribute(args, readInteractiveFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addInteractiveFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeContribute(args []string, verbose bool) error {
+func executeContribute(args []string, interactive, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  true,
@@ -62,8 +67,8 @@ func executeContribute(args []string, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, err := determineContributeConfig(args, repo)
-	if err != nil {
+	config, exit, err := determineContributeConfig(args, interactive, repo)
+	if err != nil || exit {
 		return err
 	}
 	err = validateContributeConfig(config)
@@ -116,16 +121,43 @@ func removeNonContributionBranchTypes(branches commandconfig.BranchesAndTypes, c
 			if err := config.RemoveFromParkedBranches(branchName); err != nil {
 				return err
 			}
+		case configdomain.BranchTypePrototypeBranch:
+			if err := config.RemoveFromPrototypeBranches(branchName); err != nil {
+				return err
+			}
 		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 		}
 	}
 	return nil
 }
 
-func determineContributeConfig(args []string, repo *execute.OpenRepoResult) (contributeConfig, error) {
+func determineContributeConfig(args []string, interactive bool, repo *execute.OpenRepoResult) (contributeConfig, bool, error) {
 	branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
 	if err != nil {
-		return contributeConfig{}, err
+		return contributeConfig{}, false, err
+	}
+	if interactive {
+		dialogTestInputs := components.LoadTestInputs(os.Environ())
+		preselected := gitdomain.NewLocalBranchNames(args...)
+		if len(args) == 0 {
+			preselected = gitdomain.LocalBranchNames{branchesSnapshot.Active}
+		}
+		selected, aborted, err := selectBranches(selectBranchesArgs{
+			action:           "contribute",
+			branchTypes:      []configdomain.BranchType{configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch},
+			branches:         branchesSnapshot.Branches,
+			dialogTestInputs: &dialogTestInputs,
+			fullConfig:       &repo.Runner.Config.FullConfig,
+			preselected:      preselected,
+		})
+		if err != nil || aborted {
+			return contributeConfig{}, aborted, err
+		}
+		if len(selected) == 0 {
+			fmt.Println(messages.SelectBranchesNone)
+			return contributeConfig{}, true, nil
+		}
+		args = selected.Strings()
 	}
 	branchesToMark := commandconfig.BranchesAndTypes{}
 	checkout := gitdomain.EmptyLocalBranchName()
@@ -146,7 +178,7 @@ func determineContributeConfig(args []string, repo *execute.OpenRepoResult) (con
 		allBranches:    branchesSnapshot.Branches,
 		branchesToMark: branchesToMark,
 		checkout:       checkout,
-	}, nil
+	}, false, nil
 }
 
 func validateContributeConfig(config contributeConfig) error {
@@ -161,7 +193,7 @@ func validateContributeConfig(config contributeConfig) error {
 			return errors.New(messages.PerennialBranchCannotMakeContribution)
 		case configdomain.BranchTypeContributionBranch:
 			return fmt.Errorf(messages.BranchIsAlreadyContribution, branchName)
-		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		}
 	}
 	return nil
@@ -4,6 +4,7 @@ package cmd
 import (
 	"github.com/git-town/git-town/v12/src/cmd/config"
 	"github.com/git-town/git-town/v12/src/cmd/debug"
+	"github.com/git-town/git-town/v12/src/cmd/hooks"
 )
 
 // Execute runs the Cobra stack.
@@ -14,15 +15,19 @@ func Execute() error {
 	rootCmd.AddCommand(config.RootCmd())
 	rootCmd.AddCommand(continueCmd())
 	rootCmd.AddCommand(contributeCmd())
+	rootCmd.AddCommand(dashboardCmd())
 	rootCmd.AddCommand(debug.RootCmd())
 	rootCmd.AddCommand(diffParentCommand())
+	rootCmd.AddCommand(doctorCmd())
 	rootCmd.AddCommand(hackCmd())
+	rootCmd.AddCommand(hooks.RootCmd())
 	rootCmd.AddCommand(killCommand())
 	rootCmd.AddCommand(newPullRequestCommand())
 	rootCmd.AddCommand(observeCmd())
 	rootCmd.AddCommand(offlineCmd())
 	rootCmd.AddCommand(parkCmd())
 	rootCmd.AddCommand(proposeCommand())
+	rootCmd.AddCommand(prototypeCmd())
 	rootCmd.AddCommand(prependCommand())
 	rootCmd.AddCommand(renameBranchCommand())
 	rootCmd.AddCommand(repoCommand())
@@ -32,6 +37,7 @@ func Execute() error {
 	rootCmd.AddCommand(skipCmd())
 	rootCmd.AddCommand(switchCmd())
 	rootCmd.AddCommand(syncCmd())
+	rootCmd.AddCommand(syncStrategyCmd())
 	rootCmd.AddCommand(undoCmd())
 	return rootCmd.Execute()
 }
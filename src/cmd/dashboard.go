@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dashboard"
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/hosting"
+	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
+	"github.com/spf13/cobra"
+)
+
+const dashboardDesc = "Displays the branch hierarchy and runs Git Town commands on its branches"
+
+const dashboardHelp = `
+Displays a full-screen overview of your local branches:
+their lineage, type, sync status, commits ahead and behind their tracking branch,
+and their proposals.
+
+Key bindings run Git Town commands on the selected branch
+and display their output below the branches:
+sync (s), propose (p), ship (S), park (P), kill (x), and switch (ENTER).`
+
+func dashboardCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "dashboard",
+		GroupID: "basic",
+		Args:    cobra.NoArgs,
+		Short:   dashboardDesc,
+		Long:    cmdhelpers.Long(dashboardDesc, dashboardHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeDashboard()
+		},
+	}
+	return &cmd
+}
+
+func executeDashboard() error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    false,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          false,
+	})
+	if err != nil {
+		return err
+	}
+	_, _, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      components.LoadTestInputs(os.Environ()),
+		Fetch:                 false,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+		Verbose:               false,
+	})
+	if err != nil || exit {
+		return err
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	return dashboard.Run(dashboard.RunArgs{
+		Executable: executable,
+		Load: func() (dashboard.Data, error) {
+			return loadDashboardData(repo)
+		},
+	})
+}
+
+// loadDashboardData provides the current state of the given repository for the dashboard.
+func loadDashboardData(repo *execute.OpenRepoResult) (dashboard.Data, error) {
+	repo.Runner.Config.Reload()
+	config := &repo.Runner.Config.FullConfig
+	branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
+	if err != nil {
+		return dashboard.Data{CurrentBranch: "", Entries: []dashboard.Entry{}}, err
+	}
+	worktrees, err := repo.Runner.Backend.Worktrees()
+	if err != nil {
+		return dashboard.Data{CurrentBranch: branchesSnapshot.Active, Entries: []dashboard.Entry{}}, err
+	}
+	var connector hostingdomain.Connector
+	if !repo.IsOffline.Bool() {
+		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			FullConfig:      config,
+			HostingPlatform: config.HostingPlatform,
+			Log:             print.Logger{},
+			OriginURL:       repo.Runner.Config.OriginURL(),
+			UpstreamURL:     repo.Runner.Config.UpstreamURL(),
+		})
+		if err != nil {
+			return dashboard.Data{CurrentBranch: branchesSnapshot.Active, Entries: []dashboard.Entry{}}, err
+		}
+	}
+	entries := []dashboard.Entry{}
+	// errors loading some of the details don't prevent displaying the branches
+	var detailsErr error
+	for _, switchEntry := range dialog.SwitchBranchEntries(branchesSnapshot.Branches, config, worktrees) {
+		if switchEntry.IsRemoteOnly() {
+			continue
+		}
+		entry := dashboard.Entry{SwitchBranchEntry: switchEntry, Ahead: 0, Behind: 0, Proposal: nil}
+		branchInfo := branchesSnapshot.Branches.FindByLocalName(switchEntry.Branch)
+		if branchInfo != nil && branchInfo.SyncStatus == gitdomain.SyncStatusNotInSync {
+			if entry.Ahead, err = repo.Runner.Backend.CommitsAheadCount(branchInfo.LocalName.BranchName(), branchInfo.RemoteName.BranchName()); err != nil {
+				detailsErr = err
+			}
+			if entry.Behind, err = repo.Runner.Backend.CommitsAheadCount(branchInfo.RemoteName.BranchName(), branchInfo.LocalName.BranchName()); err != nil {
+				detailsErr = err
+			}
+		}
+		parent := config.Lineage.Parent(switchEntry.Branch)
+		if connector != nil && branchInfo != nil && branchInfo.HasTrackingBranch() && !parent.IsEmpty() {
+			if entry.Proposal, err = connector.FindProposal(switchEntry.Branch, parent); err != nil {
+				detailsErr = err
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return dashboard.Data{CurrentBranch: branchesSnapshot.Active, Entries: entries}, detailsErr
+}
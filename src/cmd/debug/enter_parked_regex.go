@@ -0,0 +1,21 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+func enterParkedRegex() *cobra.Command {
+	return &cobra.Command{
+		Use: "parked-regex",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dialogInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.ParkedRegex(configdomain.ParkedRegex(""), dialogInputs.Next())
+			return err
+		},
+	}
+}
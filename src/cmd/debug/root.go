@@ -11,12 +11,15 @@ func RootCmd() *cobra.Command {
 		Hidden: true,
 	}
 	debugCommand.AddCommand(enterAliases())
+	debugCommand.AddCommand(enterContributionRegex())
 	debugCommand.AddCommand(enterHostingPlatform())
 	debugCommand.AddCommand(enterGiteaToken())
 	debugCommand.AddCommand(enterGitHubToken())
 	debugCommand.AddCommand(enterGitLabToken())
 	debugCommand.AddCommand(enterMainBranchCmd())
+	debugCommand.AddCommand(enterObservedRegex())
 	debugCommand.AddCommand(enterParentCmd())
+	debugCommand.AddCommand(enterParkedRegex())
 	debugCommand.AddCommand(enterOriginHostname())
 	debugCommand.AddCommand(enterPerennialBranches())
 	debugCommand.AddCommand(enterPerennialRegex())
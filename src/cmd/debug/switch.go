@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/spf13/cobra"
@@ -19,12 +20,21 @@ func switchBranch() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			localBranches := gitdomain.LocalBranchNames{}
+			branches := gitdomain.BranchInfos{}
 			for i := 0; i < int(amount); i++ {
-				localBranches = append(localBranches, gitdomain.NewLocalBranchName(fmt.Sprintf("branch-%d", i)))
+				branch := gitdomain.EmptyBranchInfo()
+				branch.LocalName = gitdomain.NewLocalBranchName(fmt.Sprintf("branch-%d", i))
+				branch.SyncStatus = gitdomain.SyncStatusLocalOnly
+				branches = append(branches, branch)
 			}
-			lineage := configdomain.Lineage{}
-			_, _, err = dialog.SwitchBranch(localBranches, gitdomain.NewLocalBranchName("branch-2"), lineage)
+			config := configdomain.DefaultConfig()
+			_, _, err = dialog.SwitchBranch(dialog.SwitchBranchArgs{
+				Branches:        branches,
+				Config:          &config,
+				DialogTestInput: components.TestInput{},
+				InitialBranch:   gitdomain.NewLocalBranchName("branch-2"),
+				Worktrees:       gitdomain.Worktrees{},
+			})
 			return err
 		},
 	}
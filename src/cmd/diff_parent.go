@@ -25,6 +25,7 @@ Exits with error code 1 if the given branch is a perennial branch or the main br
 
 func diffParentCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
 	cmd := cobra.Command{
 		Use:     "diff-parent [<branch>]",
 		GroupID: "lineage",
@@ -32,14 +33,15 @@ func diffParentCommand() *cobra.Command {
 		Short:   diffParentDesc,
 		Long:    cmdhelpers.Long(diffParentDesc, diffParentHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeDiffParent(args, readVerboseFlag(cmd))
+			return executeDiffParent(args, readInferParentsFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addInferParentsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeDiffParent(args []string, verbose bool) error {
+func executeDiffParent(args []string, inferParents, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  false,
@@ -51,7 +53,7 @@ func executeDiffParent(args []string, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, exit, err := determineDiffParentConfig(args, repo, verbose)
+	config, exit, err := determineDiffParentConfig(args, repo, inferParents, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -69,7 +71,7 @@ type diffParentConfig struct {
 }
 
 // Does not return error because "Ensure" functions will call exit directly.
-func determineDiffParentConfig(args []string, repo *execute.OpenRepoResult, verbose bool) (*diffParentConfig, bool, error) {
+func determineDiffParentConfig(args []string, repo *execute.OpenRepoResult, inferParents, verbose bool) (*diffParentConfig, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, _, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -98,6 +100,7 @@ func determineDiffParentConfig(args []string, repo *execute.OpenRepoResult, verb
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     inferParents,
 		Runner:           repo.Runner,
 	})
 	if err != nil {
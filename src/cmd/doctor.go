@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cli/print"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/doctor"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/git-town/git-town/v12/src/vm/statefile"
+	"github.com/spf13/cobra"
+)
+
+const doctorDesc = "Checks the Git Town setup of this repository for problems"
+
+const doctorHelp = `
+Checks the lineage, the branch type configuration,
+the Git configuration, and the runstate of unfinished Git Town commands.
+Reports each problem it finds with its severity.
+
+With --fix, repairs the problems that can be fixed automatically.
+You can undo these repairs with "git town undo".`
+
+func doctorCmd() *cobra.Command {
+	addFixFlag, readFixFlag := flags.Bool("fix", "", "Repair the problems that can be fixed automatically", flags.FlagTypeNonPersistent)
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "doctor",
+		GroupID: "errors",
+		Args:    cobra.NoArgs,
+		Short:   doctorDesc,
+		Long:    cmdhelpers.Long(doctorDesc, doctorHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeDoctor(readFixFlag(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addFixFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeDoctor(fix, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, err := determineDoctorConfig(repo, verbose)
+	if err != nil {
+		return err
+	}
+	problems := doctor.Diagnose(config.args)
+	printDoctorProblems(problems, fix)
+	if !fix {
+		print.Footer(verbose, repo.Runner.CommandsCounter.Count(), print.NoFinalMessages)
+		return nil
+	}
+	if problems.CountFixable() == 0 {
+		if len(problems) > 0 {
+			fmt.Println(messages.DoctorNothingToFix)
+		}
+		print.Footer(verbose, repo.Runner.CommandsCounter.Count(), print.NoFinalMessages)
+		return nil
+	}
+	if config.args.HasResumableRunState() {
+		return fmt.Errorf(messages.DoctorFixUnfinishedCommand, config.args.RunState.Command)
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: config.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        config.stashSize,
+		Command:               "doctor",
+		DryRun:                false,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            problems.FixProgram(),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               nil,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              &repo.Runner.Config.FullConfig,
+		HasOpenChanges:          config.hasOpenChanges,
+		InitialBranchesSnapshot: config.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        config.stashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type doctorConfig struct {
+	args             doctor.Args
+	branchesSnapshot gitdomain.BranchesSnapshot
+	dialogTestInputs components.TestInputs
+	hasOpenChanges   bool
+	stashSize        gitdomain.StashSize
+}
+
+func determineDoctorConfig(repo *execute.OpenRepoResult, verbose bool) (*doctorConfig, error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	branchesSnapshot, stashSize, repoStatus, _, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 false,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: false,
+		Repo:                  repo,
+		ValidateIsConfigured:  false,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil {
+		return nil, err
+	}
+	runState, err := statefile.Load(repo.RootDir)
+	if err != nil {
+		return nil, fmt.Errorf(messages.RunstateLoadProblem, err)
+	}
+	runStatePath, err := statefile.FilePath(repo.RootDir)
+	if err != nil {
+		return nil, err
+	}
+	return &doctorConfig{
+		args: doctor.Args{
+			Branches:           branchesSnapshot.Branches,
+			Config:             &repo.Runner.Config.FullConfig,
+			DeprecatedSettings: repo.Runner.Config.GitConfig.DeprecatedSettings(),
+			RunState:           runState,
+			RunStatePath:       runStatePath,
+		},
+		branchesSnapshot: branchesSnapshot,
+		dialogTestInputs: dialogTestInputs,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		stashSize:        stashSize,
+	}, nil
+}
+
+func printDoctorProblems(problems doctor.Problems, fix bool) {
+	if len(problems) == 0 {
+		fmt.Println(messages.DoctorNoProblems)
+		return
+	}
+	for _, problem := range problems {
+		fmt.Printf(messages.DoctorProblem, problem.Severity, problem.Message)
+	}
+	fmt.Printf(messages.DoctorProblemsFound, len(problems))
+	fixable := problems.CountFixable()
+	switch {
+	case fixable == 0:
+	case fix:
+		fmt.Printf(messages.DoctorFixingProblems, fixable)
+	default:
+		fmt.Printf(messages.DoctorFixableProblems, fixable)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/git-town/git-town/v12/src/execute"
 	"github.com/git-town/git-town/v12/src/git"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
 	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/git-town/git-town/v12/src/undo/undoconfig"
 	configInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/config"
@@ -27,11 +28,14 @@ const hackDesc = "Creates a new feature branch off the main development branch"
 const hackHelp = `
 Syncs the main branch, forks a new feature branch with the given name off the main branch, pushes the new feature branch to origin (if and only if "push-new-branches" is true), and brings over all uncommitted changes to the new feature branch.
 
+With the "--prototype" flag, creates the new branch as a prototype branch. Git Town never pushes prototype branches.
+
 See "sync" for information regarding upstream remotes.`
 
 func hackCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addPrototypeFlag, readPrototypeFlag := flags.Bool("prototype", "p", "Create a prototype branch", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:     "hack <branch>",
 		GroupID: "basic",
@@ -39,15 +43,16 @@ func hackCmd() *cobra.Command {
 		Short:   hackDesc,
 		Long:    cmdhelpers.Long(hackDesc, hackHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeHack(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeHack(args, readDryRunFlag(cmd), readPrototypeFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addPrototypeFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeHack(args []string, dryRun, verbose bool) error {
+func executeHack(args []string, dryRun, prototype, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -59,7 +64,7 @@ func executeHack(args []string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineHackConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineHackConfig(args, repo, dryRun, prototype, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -93,7 +98,7 @@ type hackConfig struct {
 	makeFeatureConfig *makeFeatureConfig
 }
 
-// this configuration is for when "git hack" is used to make contribution, observed, or parked branches feature branches
+// this configuration is for when "git hack" is used to make contribution, observed, parked, or prototype branches feature branches
 type makeFeatureConfig struct {
 	targetBranches commandconfig.BranchesAndTypes
 }
@@ -136,7 +141,7 @@ type createBranchArgs struct {
 	verbose               bool
 }
 
-func determineHackConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*hackConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineHackConfig(args []string, repo *execute.OpenRepoResult, dryRun, prototype, verbose bool) (*hackConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	fc := execute.FailureCollector{}
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
@@ -154,6 +159,10 @@ func determineHackConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	}
 	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
 	targetBranches := gitdomain.NewLocalBranchNames(args...)
+	if prototype && (len(targetBranches) == 0 || branchesSnapshot.Branches.HasLocalBranches(targetBranches)) {
+		existingBranch := slice.FirstElementOr(targetBranches, branchesSnapshot.Active)
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.HackPrototypeExistingBranch, existingBranch, existingBranch)
+	}
 	if len(targetBranches) == 0 {
 		return &hackConfig{
 			appendConfig: nil,
@@ -195,6 +204,7 @@ func determineHackConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 			newBranchParentCandidates: gitdomain.LocalBranchNames{repo.Runner.Config.FullConfig.MainBranch},
 			parentBranch:              repo.Runner.Config.FullConfig.MainBranch,
 			previousBranch:            previousBranch,
+			prototype:                 prototype,
 			remotes:                   remotes,
 			targetBranch:              targetBranch,
 		},
@@ -207,6 +217,12 @@ func makeFeatureBranch(args makeFeatureBranchArgs) error {
 	if err != nil {
 		return err
 	}
+	for branchName := range args.makeFeatureConfig.targetBranches {
+		fullConfig := args.config.FullConfig
+		if fullConfig.ContributionRegex.MatchesBranch(branchName) || fullConfig.ObservedRegex.MatchesBranch(branchName) || fullConfig.ParkedRegex.MatchesBranch(branchName) {
+			return fmt.Errorf(messages.HackBranchMatchesRegex, branchName)
+		}
+	}
 	for branchName, branchType := range args.makeFeatureConfig.targetBranches {
 		switch branchType {
 		case configdomain.BranchTypeContributionBranch:
@@ -215,6 +231,8 @@ func makeFeatureBranch(args makeFeatureBranchArgs) error {
 			err = args.config.RemoveFromObservedBranches(branchName)
 		case configdomain.BranchTypeParkedBranch:
 			err = args.config.RemoveFromParkedBranches(branchName)
+		case configdomain.BranchTypePrototypeBranch:
+			err = args.config.RemoveFromPrototypeBranches(branchName)
 		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 			panic(fmt.Sprintf("unchecked branch type: %s", branchType))
 		}
@@ -245,7 +263,7 @@ type makeFeatureBranchArgs struct {
 func validateMakeFeatureConfig(config *makeFeatureConfig) error {
 	for branchName, branchType := range config.targetBranches {
 		switch branchType {
-		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 			return nil
 		case configdomain.BranchTypeFeatureBranch:
 			return fmt.Errorf(messages.HackBranchIsAlreadyFeature, branchName)
@@ -0,0 +1,63 @@
+package hooks
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/githooks"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const installDesc = "Installs the Git Town post-checkout hook"
+
+const installHelp = `
+The hook records the previously checked out branch as the parent
+of branches that you create using "git switch -c" or "git checkout -b".
+
+If a post-checkout hook already exists, for example from a hook manager,
+the Git Town hook calls it before recording the lineage.`
+
+func installCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "install",
+		Args:  cobra.NoArgs,
+		Short: installDesc,
+		Long:  cmdhelpers.Long(installDesc, installHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeInstall(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeInstall(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	hooksDir, err := repo.Runner.Backend.HooksDir()
+	if err != nil {
+		return err
+	}
+	chained, err := githooks.Install(hooksDir)
+	if err != nil {
+		return err
+	}
+	fmt.Printf(messages.HooksInstalled, hooksDir)
+	if chained {
+		fmt.Print(messages.HooksChained)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package hooks
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/githooks"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/spf13/cobra"
+)
+
+func postCheckoutCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:    githooks.PostCheckout + " <previous HEAD> <new HEAD> <branch checkout flag>",
+		Args:   cobra.ExactArgs(3),
+		Hidden: true,
+		Short:  "Records the lineage of newly created branches, called by the post-checkout Git hook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executePostCheckout(args[0], args[1], args[2])
+		},
+	}
+}
+
+// executePostCheckout records the previously checked out branch as the parent of a newly created branch.
+// Branches created at a different commit than the previously checked out one don't get a parent
+// because they weren't created on top of the previous branch.
+func executePostCheckout(previousHead, newHead, branchCheckout string) error {
+	if branchCheckout != "1" || previousHead != newHead || os.Getenv(githooks.SkipEnvVar) != "" {
+		return nil
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    false,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          false,
+	})
+	if err != nil {
+		return err
+	}
+	config := &repo.Runner.Config.FullConfig
+	if config.MainBranch.IsEmpty() {
+		return nil
+	}
+	branch, err := repo.Runner.Backend.CurrentBranch()
+	if err != nil || branch.IsEmpty() {
+		return nil //nolint:nilerr // detached HEAD, nothing to record
+	}
+	if config.IsMainOrPerennialBranch(branch) || config.Lineage.HasParents(branch) || !repo.Runner.Backend.IsNewBranch(branch) {
+		return nil
+	}
+	parent := repo.Runner.Backend.PreviouslyCheckedOutBranch()
+	if parent.IsEmpty() || parent == branch || !repo.Runner.Backend.HasLocalBranch(parent) {
+		return nil
+	}
+	err = repo.Runner.Config.SetParent(branch, parent)
+	if err != nil {
+		return err
+	}
+	fmt.Printf(messages.HooksParentRecorded, branch, parent)
+	return nil
+}
@@ -0,0 +1,27 @@
+package hooks
+
+import (
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/spf13/cobra"
+)
+
+const hooksDesc = "Manages the Git hooks of Git Town"
+
+const hooksHelp = `
+Git Town can install a post-checkout Git hook
+that records the lineage of branches you create with plain Git commands
+like "git switch -c" or "git checkout -b".`
+
+func RootCmd() *cobra.Command {
+	hooksCmd := cobra.Command{
+		Use:     "hooks",
+		GroupID: "setup",
+		Args:    cobra.NoArgs,
+		Short:   hooksDesc,
+		Long:    cmdhelpers.Long(hooksDesc, hooksHelp),
+	}
+	hooksCmd.AddCommand(installCommand())
+	hooksCmd.AddCommand(postCheckoutCommand())
+	hooksCmd.AddCommand(uninstallCommand())
+	return &hooksCmd
+}
@@ -0,0 +1,60 @@
+package hooks
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/githooks"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const uninstallDesc = "Removes the Git Town post-checkout hook"
+
+const uninstallHelp = `
+Restores the post-checkout hook that existed before the Git Town hook was installed.`
+
+func uninstallCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "uninstall",
+		Args:  cobra.NoArgs,
+		Short: uninstallDesc,
+		Long:  cmdhelpers.Long(uninstallDesc, uninstallHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeUninstall(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeUninstall(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	hooksDir, err := repo.Runner.Backend.HooksDir()
+	if err != nil {
+		return err
+	}
+	uninstalled, err := githooks.Uninstall(hooksDir)
+	if err != nil {
+		return err
+	}
+	if uninstalled {
+		fmt.Printf(messages.HooksUninstalled, hooksDir)
+	} else {
+		fmt.Printf(messages.HooksNotInstalled, hooksDir)
+	}
+	return nil
+}
@@ -20,31 +20,38 @@ import (
 	"github.com/git-town/git-town/v12/src/vm/program"
 	"github.com/git-town/git-town/v12/src/vm/runstate"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 )
 
 const killDesc = "Removes an obsolete feature branch"
 
 const killHelp = `
-Deletes the current or provided branch from the local and origin repositories. Does not delete perennial branches nor the main branch.`
+Deletes the current or provided branch from the local and origin repositories. Does not delete perennial branches nor the main branch.
+
+With --interactive, select any number of branches to delete in a dialog.`
 
 func killCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addInteractiveFlag, readInteractiveFlag := flags.Interactive()
 	cmd := cobra.Command{
 		Use:   "kill [<branch>]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: killDesc,
 		Long:  cmdhelpers.Long(killDesc, killHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeKill(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeKill(args, readDryRunFlag(cmd), readInferParentsFlag(cmd), readInteractiveFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addInferParentsFlag(&cmd)
+	addInteractiveFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeKill(args []string, dryRun, verbose bool) error {
+func executeKill(args []string, dryRun, inferParents, interactive, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -56,7 +63,7 @@ func executeKill(args []string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineKillConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineKillConfig(args, repo, dryRun, inferParents, interactive, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -94,9 +101,8 @@ func executeKill(args []string, dryRun, verbose bool) error {
 
 type killConfig struct {
 	*configdomain.FullConfig
-	branchNameToKill gitdomain.BranchInfo
-	branchTypeToKill configdomain.BranchType
 	branchWhenDone   gitdomain.LocalBranchName
+	branchesToKill   gitdomain.BranchInfos
 	dialogTestInputs components.TestInputs
 	dryRun           bool
 	hasOpenChanges   bool
@@ -104,7 +110,7 @@ type killConfig struct {
 	previousBranch   gitdomain.LocalBranchName
 }
 
-func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*killConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, inferParents, interactive, verbose bool) (*killConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -119,39 +125,81 @@ func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	if err != nil || exit {
 		return nil, branchesSnapshot, stashSize, exit, err
 	}
-	branchNameToKill := gitdomain.NewLocalBranchName(slice.FirstElementOr(args, branchesSnapshot.Active.String()))
-	branchToKill := branchesSnapshot.Branches.FindByLocalName(branchNameToKill)
-	if branchToKill == nil {
-		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToKill)
-	}
-	if branchToKill.SyncStatus == gitdomain.SyncStatusOtherWorktree {
-		return nil, branchesSnapshot, stashSize, exit, fmt.Errorf(messages.KillBranchOtherWorktree, branchNameToKill)
-	}
-	if branchToKill.IsLocal() {
-		err = execute.EnsureKnownBranchAncestry(branchToKill.LocalName, execute.EnsureKnownBranchAncestryArgs{
-			Config:           &repo.Runner.Config.FullConfig,
-			AllBranches:      branchesSnapshot.Branches,
-			DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
-			DialogTestInputs: &dialogTestInputs,
-			Runner:           repo.Runner,
+	remotes, err := repo.Runner.Backend.Remotes()
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	exit, err = execute.LoadSharedLineage(execute.LoadSharedLineageArgs{
+		DialogTestInputs: &dialogTestInputs,
+		LocalBranches:    branchesSnapshot.Branches.LocalBranches().Names(),
+		Remotes:          remotes,
+		Runner:           repo.Runner,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	branchNamesToKill := gitdomain.NewLocalBranchNames(slice.FirstElementOr(args, branchesSnapshot.Active.String()))
+	if interactive {
+		selectable := gitdomain.BranchInfos{}
+		for _, branch := range branchesSnapshot.Branches {
+			if branch.SyncStatus != gitdomain.SyncStatusOtherWorktree {
+				selectable = append(selectable, branch)
+			}
+		}
+		selected, aborted, err := selectBranches(selectBranchesArgs{
+			action:           "kill",
+			branchTypes:      []configdomain.BranchType{configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch},
+			branches:         selectable,
+			dialogTestInputs: &dialogTestInputs,
+			fullConfig:       &repo.Runner.Config.FullConfig,
+			preselected:      branchNamesToKill,
 		})
-		if err != nil {
-			return nil, branchesSnapshot, stashSize, false, err
+		if err != nil || aborted {
+			return nil, branchesSnapshot, stashSize, aborted, err
 		}
+		if len(selected) == 0 {
+			fmt.Println(messages.SelectBranchesNone)
+			return nil, branchesSnapshot, stashSize, true, nil
+		}
+		branchNamesToKill = selected
+	}
+	branchesToKill := make(gitdomain.BranchInfos, 0, len(branchNamesToKill))
+	for _, branchNameToKill := range branchNamesToKill {
+		branchToKill := branchesSnapshot.Branches.FindByLocalName(branchNameToKill)
+		if branchToKill == nil {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToKill)
+		}
+		if branchToKill.SyncStatus == gitdomain.SyncStatusOtherWorktree {
+			return nil, branchesSnapshot, stashSize, exit, fmt.Errorf(messages.KillBranchOtherWorktree, branchNameToKill)
+		}
+		if branchToKill.IsLocal() {
+			err = execute.EnsureKnownBranchAncestry(branchToKill.LocalName, execute.EnsureKnownBranchAncestryArgs{
+				Config:           &repo.Runner.Config.FullConfig,
+				AllBranches:      branchesSnapshot.Branches,
+				DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+				DialogTestInputs: &dialogTestInputs,
+				InferParents:     inferParents,
+				Runner:           repo.Runner,
+			})
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, err
+			}
+		}
+		branchesToKill = append(branchesToKill, *branchToKill)
 	}
-	branchTypeToKill := repo.Runner.Config.FullConfig.BranchType(branchNameToKill)
 	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
-	var branchWhenDone gitdomain.LocalBranchName
-	if branchNameToKill == branchesSnapshot.Active {
+	branchWhenDone := branchesSnapshot.Active
+	if branchesToKill.HasLocalBranch(branchesSnapshot.Active) {
+		// the previous branch is the natural place to go, unless it also gets deleted
 		branchWhenDone = previousBranch
-	} else {
-		branchWhenDone = branchesSnapshot.Active
+		if branchWhenDone.IsEmpty() || branchesToKill.HasLocalBranch(branchWhenDone) {
+			branchWhenDone = repo.Runner.Config.FullConfig.MainBranch
+		}
 	}
 	return &killConfig{
 		FullConfig:       &repo.Runner.Config.FullConfig,
-		branchNameToKill: *branchToKill,
-		branchTypeToKill: branchTypeToKill,
 		branchWhenDone:   branchWhenDone,
+		branchesToKill:   branchesToKill,
 		dialogTestInputs: dialogTestInputs,
 		dryRun:           dryRun,
 		hasOpenChanges:   repoStatus.OpenChanges,
@@ -160,69 +208,78 @@ func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	}, branchesSnapshot, stashSize, false, nil
 }
 
-func (self killConfig) branchToKillParent() gitdomain.LocalBranchName {
-	return self.Lineage.Parent(self.branchNameToKill.LocalName)
-}
-
 func killProgram(config *killConfig) (runProgram, finalUndoProgram program.Program) {
 	prog := program.Program{}
-	switch config.branchTypeToKill {
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
-		killFeatureBranch(&prog, &finalUndoProgram, config)
-	case configdomain.BranchTypeObservedBranch, configdomain.BranchTypeContributionBranch:
-		killLocalBranch(&prog, &finalUndoProgram, config)
-	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
-		panic(fmt.Sprintf("this branch type should have been filtered in validation: %s", config.branchTypeToKill))
+	for _, branchToKill := range config.branchesToKill {
+		branchType := config.BranchType(branchToKill.LocalName)
+		switch branchType {
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+			killTrackingBranch(&prog, branchToKill, config)
+		case configdomain.BranchTypeObservedBranch, configdomain.BranchTypeContributionBranch:
+		case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+			panic(fmt.Sprintf("this branch type should have been filtered in validation: %s", branchType))
+		}
+	}
+	killsInitialBranch := config.branchesToKill.HasLocalBranch(config.initialBranch)
+	if killsInitialBranch {
+		if config.hasOpenChanges {
+			prog.Add(&opcodes.CommitOpenChanges{})
+			// update the registered initial SHA for this branch so that undo restores the just committed changes
+			prog.Add(&opcodes.UpdateInitialBranchLocalSHA{Branch: config.initialBranch})
+			// when undoing, manually undo the just committed changes so that they are uncommitted again
+			finalUndoProgram.Add(&opcodes.Checkout{Branch: config.initialBranch})
+			finalUndoProgram.Add(&opcodes.UndoLastCommit{})
+		}
+		prog.Add(&opcodes.Checkout{Branch: config.branchWhenDone})
+	}
+	// the lineage as it will be after killing the branches processed so far
+	lineage := maps.Clone(config.Lineage)
+	for _, branchToKill := range config.branchesToKill {
+		killLocalBranch(&prog, branchToKill, lineage, config)
 	}
 	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
 		DryRun:                   config.dryRun,
 		RunInGitRoot:             true,
-		StashOpenChanges:         config.initialBranch != config.branchNameToKill.LocalName && config.hasOpenChanges,
+		StashOpenChanges:         !killsInitialBranch && config.hasOpenChanges,
 		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch, config.initialBranch},
 	})
 	return prog, finalUndoProgram
 }
 
-// killFeatureBranch kills the given feature branch everywhere it exists (locally and remotely).
-func killFeatureBranch(prog *program.Program, finalUndoProgram *program.Program, config *killConfig) {
-	if config.branchNameToKill.HasTrackingBranch() && config.IsOnline() {
-		prog.Add(&opcodes.DeleteTrackingBranch{Branch: config.branchNameToKill.RemoteName})
+// killTrackingBranch deletes the tracking branch of the given feature branch.
+func killTrackingBranch(prog *program.Program, branch gitdomain.BranchInfo, config *killConfig) {
+	if branch.HasTrackingBranch() && config.IsOnline() {
+		prog.Add(&opcodes.DeleteTrackingBranch{Branch: branch.RemoteName})
 	}
-	killLocalBranch(prog, finalUndoProgram, config)
 }
 
-// killFeatureBranch kills the given feature branch everywhere it exists (locally and remotely).
-func killLocalBranch(prog *program.Program, finalUndoProgram *program.Program, config *killConfig) {
-	if config.initialBranch == config.branchNameToKill.LocalName {
-		if config.hasOpenChanges {
-			prog.Add(&opcodes.CommitOpenChanges{})
-			// update the registered initial SHA for this branch so that undo restores the just committed changes
-			prog.Add(&opcodes.UpdateInitialBranchLocalSHA{Branch: config.initialBranch})
-			// when undoing, manually undo the just committed changes so that they are uncommitted again
-			finalUndoProgram.Add(&opcodes.Checkout{Branch: config.branchNameToKill.LocalName})
-			finalUndoProgram.Add(&opcodes.UndoLastCommit{})
-		}
-		prog.Add(&opcodes.Checkout{Branch: config.branchWhenDone})
-	}
-	prog.Add(&opcodes.DeleteLocalBranch{Branch: config.branchNameToKill.LocalName})
+// killLocalBranch deletes the local copy of the given branch
+// and removes it from the given lineage.
+func killLocalBranch(prog *program.Program, branch gitdomain.BranchInfo, lineage configdomain.Lineage, config *killConfig) {
+	prog.Add(&opcodes.DeleteLocalBranch{Branch: branch.LocalName})
 	if !config.dryRun {
 		sync.RemoveBranchFromLineage(sync.RemoveBranchFromLineageArgs{
-			Branch:  config.branchNameToKill.LocalName,
-			Lineage: config.Lineage,
-			Parent:  config.branchToKillParent(),
+			Branch:  branch.LocalName,
+			Lineage: lineage,
+			Parent:  lineage.Parent(branch.LocalName),
 			Program: prog,
 		})
 	}
+	lineage.RemoveBranch(branch.LocalName)
 }
 
 func validateKillConfig(killConfig *killConfig) error {
-	switch killConfig.branchTypeToKill {
-	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
-		return nil
-	case configdomain.BranchTypeMainBranch:
-		return errors.New(messages.KillCannotKillMainBranch)
-	case configdomain.BranchTypePerennialBranch:
-		return errors.New(messages.KillCannotKillPerennialBranches)
-	}
-	panic(fmt.Sprintf("unhandled branch type: %s", killConfig.branchTypeToKill))
+	for _, branchToKill := range killConfig.branchesToKill {
+		branchType := killConfig.BranchType(branchToKill.LocalName)
+		switch branchType {
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		case configdomain.BranchTypeMainBranch:
+			return errors.New(messages.KillCannotKillMainBranch)
+		case configdomain.BranchTypePerennialBranch:
+			return errors.New(messages.KillCannotKillPerennialBranches)
+		default:
+			panic(fmt.Sprintf("unhandled branch type: %s", branchType))
+		}
+	}
+	return nil
 }
@@ -23,7 +23,7 @@ func newPullRequestCommand() *cobra.Command {
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, gitconfig.KeyHostingPlatform, gitconfig.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			printDeprecationNotice()
-			result := executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd))
+			result := executePropose(args, readDryRunFlag(cmd), false, readVerboseFlag(cmd))
 			printDeprecationNotice()
 			return result
 		},
@@ -3,7 +3,9 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"os"
 
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/cli/flags"
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v12/src/config"
@@ -22,6 +24,7 @@ const observeDesc = "Stops your contributions to some feature branches"
 const observeHelp = `
 Marks the given local branches as observed.
 If no branch is provided, observes the current branch.
+With --interactive, select the branches in a dialog.
 
 Observed branches are useful when you assist other developers
 and make local changes to try out ideas,
@@ -34,6 +37,7 @@ On an observed branch, "git sync"
 `
 
 func observeCmd() *cobra.Command {
+	addInteractiveFlag, readInteractiveFlag := flags.Interactive()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     "observe [branches]",
@@ -42,14 +46,15 @@ func observeCmd() *cobra.Command {
 		Short:   observeDesc,
 		Long:    cmdhelpers.Long(observeDesc, observeHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeObserve(args, readVerboseFlag(cmd))
+			return executeObserve(args, readInteractiveFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addInteractiveFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeObserve(args []string, verbose bool) error {
+func executeObserve(args []string, interactive, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  true,
@@ -61,8 +66,8 @@ func executeObserve(args []string, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, err := determineObserveConfig(args, repo)
-	if err != nil {
+	config, exit, err := determineObserveConfig(args, interactive, repo)
+	if err != nil || exit {
 		return err
 	}
 	err = validateObserveConfig(config)
@@ -115,16 +120,43 @@ func removeNonObserveBranchTypes(branches map[gitdomain.LocalBranchName]configdo
 			if err := config.RemoveFromParkedBranches(branchName); err != nil {
 				return err
 			}
+		case configdomain.BranchTypePrototypeBranch:
+			if err := config.RemoveFromPrototypeBranches(branchName); err != nil {
+				return err
+			}
 		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 		}
 	}
 	return nil
 }
 
-func determineObserveConfig(args []string, repo *execute.OpenRepoResult) (observeConfig, error) {
+func determineObserveConfig(args []string, interactive bool, repo *execute.OpenRepoResult) (observeConfig, bool, error) {
 	branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
 	if err != nil {
-		return observeConfig{}, err
+		return observeConfig{}, false, err
+	}
+	if interactive {
+		dialogTestInputs := components.LoadTestInputs(os.Environ())
+		preselected := gitdomain.NewLocalBranchNames(args...)
+		if len(args) == 0 {
+			preselected = gitdomain.LocalBranchNames{branchesSnapshot.Active}
+		}
+		selected, aborted, err := selectBranches(selectBranchesArgs{
+			action:           "observe",
+			branchTypes:      []configdomain.BranchType{configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch},
+			branches:         branchesSnapshot.Branches,
+			dialogTestInputs: &dialogTestInputs,
+			fullConfig:       &repo.Runner.Config.FullConfig,
+			preselected:      preselected,
+		})
+		if err != nil || aborted {
+			return observeConfig{}, aborted, err
+		}
+		if len(selected) == 0 {
+			fmt.Println(messages.SelectBranchesNone)
+			return observeConfig{}, true, nil
+		}
+		args = selected.Strings()
 	}
 	branchesToObserve := commandconfig.BranchesAndTypes{}
 	checkout := gitdomain.EmptyLocalBranchName()
@@ -145,7 +177,7 @@ func determineObserveConfig(args []string, repo *execute.OpenRepoResult) (observ
 		allBranches:       branchesSnapshot.Branches,
 		branchesToObserve: branchesToObserve,
 		checkout:          checkout,
-	}, nil
+	}, false, nil
 }
 
 func validateObserveConfig(config observeConfig) error {
@@ -160,7 +192,7 @@ func validateObserveConfig(config observeConfig) error {
 			return errors.New(messages.PerennialBranchCannotObserve)
 		case configdomain.BranchTypeObservedBranch:
 			return fmt.Errorf(messages.BranchIsAlreadyObserved, branchName)
-		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeParkedBranch:
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		}
 	}
 	return nil
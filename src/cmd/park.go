@@ -3,7 +3,9 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"os"
 
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/cli/flags"
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v12/src/config"
@@ -22,12 +24,14 @@ const parkDesc = "Suspends syncing of some feature branches"
 const parkHelp = `
 Parks the given local feature branches.
 If no branch is provided, parks the current branch.
+With --interactive, select the branches in a dialog.
 
 Git Town does not sync parked branches.
 The currently checked out branch gets synced even if parked.
 `
 
 func parkCmd() *cobra.Command {
+	addInteractiveFlag, readInteractiveFlag := flags.Interactive()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     "park [branches]",
@@ -36,14 +40,15 @@ func parkCmd() *cobra.Command {
 		Short:   parkDesc,
 		Long:    cmdhelpers.Long(parkDesc, parkHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePark(args, readVerboseFlag(cmd))
+			return executePark(args, readInteractiveFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addInteractiveFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executePark(args []string, verbose bool) error {
+func executePark(args []string, interactive, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  true,
@@ -55,8 +60,8 @@ func executePark(args []string, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, err := determineParkConfig(args, repo)
-	if err != nil {
+	config, exit, err := determineParkConfig(args, interactive, repo)
+	if err != nil || exit {
 		return err
 	}
 	err = validateParkConfig(config)
@@ -103,16 +108,43 @@ func removeNonParkBranchTypes(branches map[gitdomain.LocalBranchName]configdomai
 			if err := config.RemoveFromObservedBranches(branchName); err != nil {
 				return err
 			}
+		case configdomain.BranchTypePrototypeBranch:
+			if err := config.RemoveFromPrototypeBranches(branchName); err != nil {
+				return err
+			}
 		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 		}
 	}
 	return nil
 }
 
-func determineParkConfig(args []string, repo *execute.OpenRepoResult) (parkConfig, error) {
+func determineParkConfig(args []string, interactive bool, repo *execute.OpenRepoResult) (parkConfig, bool, error) {
 	branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
 	if err != nil {
-		return parkConfig{}, err
+		return parkConfig{}, false, err
+	}
+	if interactive {
+		dialogTestInputs := components.LoadTestInputs(os.Environ())
+		preselected := gitdomain.NewLocalBranchNames(args...)
+		if len(args) == 0 {
+			preselected = gitdomain.LocalBranchNames{branchesSnapshot.Active}
+		}
+		selected, aborted, err := selectBranches(selectBranchesArgs{
+			action:           "park",
+			branchTypes:      []configdomain.BranchType{configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePrototypeBranch},
+			branches:         branchesSnapshot.Branches,
+			dialogTestInputs: &dialogTestInputs,
+			fullConfig:       &repo.Runner.Config.FullConfig,
+			preselected:      preselected,
+		})
+		if err != nil || aborted {
+			return parkConfig{}, aborted, err
+		}
+		if len(selected) == 0 {
+			fmt.Println(messages.SelectBranchesNone)
+			return parkConfig{}, true, nil
+		}
+		args = selected.Strings()
 	}
 	branchesToPark := commandconfig.BranchesAndTypes{}
 	if len(args) == 0 {
@@ -123,7 +155,7 @@ func determineParkConfig(args []string, repo *execute.OpenRepoResult) (parkConfi
 	return parkConfig{
 		allBranches:    branchesSnapshot.Branches,
 		branchesToPark: branchesToPark,
-	}, nil
+	}, false, nil
 }
 
 func validateParkConfig(config parkConfig) error {
@@ -138,7 +170,7 @@ func validateParkConfig(config parkConfig) error {
 			return errors.New(messages.PerennialBranchCannotPark)
 		case configdomain.BranchTypeParkedBranch:
 			return fmt.Errorf(messages.BranchIsAlreadyParked, branchName)
-		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch:
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypePrototypeBranch:
 		}
 	}
 	return nil
@@ -30,6 +30,7 @@ See "sync" for upstream remote options.`
 
 func prependCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	cmd := cobra.Command{
 		Use:     "prepend <branch>",
@@ -38,15 +39,16 @@ func prependCommand() *cobra.Command {
 		Short:   prependDesc,
 		Long:    cmdhelpers.Long(prependDesc, prependHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePrepend(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executePrepend(args, readDryRunFlag(cmd), readInferParentsFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addInferParentsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executePrepend(args []string, dryRun, verbose bool) error {
+func executePrepend(args []string, dryRun, inferParents, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -58,7 +60,7 @@ func executePrepend(args []string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determinePrependConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determinePrependConfig(args, repo, dryRun, inferParents, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -103,7 +105,7 @@ type prependConfig struct {
 	targetBranch              gitdomain.LocalBranchName
 }
 
-func determinePrependConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*prependConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determinePrependConfig(args []string, repo *execute.OpenRepoResult, dryRun, inferParents, verbose bool) (*prependConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	fc := execute.FailureCollector{}
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
@@ -121,6 +123,15 @@ func determinePrependConfig(args []string, repo *execute.OpenRepoResult, dryRun,
 	}
 	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
 	remotes := fc.Remotes(repo.Runner.Backend.Remotes())
+	exit, err = execute.LoadSharedLineage(execute.LoadSharedLineageArgs{
+		DialogTestInputs: &dialogTestInputs,
+		LocalBranches:    branchesSnapshot.Branches.LocalBranches().Names(),
+		Remotes:          remotes,
+		Runner:           repo.Runner,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
 	targetBranch := gitdomain.NewLocalBranchName(args[0])
 	if branchesSnapshot.Branches.HasLocalBranch(targetBranch) {
 		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchAlreadyExistsLocally, targetBranch)
@@ -136,6 +147,7 @@ func determinePrependConfig(args []string, repo *execute.OpenRepoResult, dryRun,
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     inferParents,
 		Runner:           repo.Runner,
 	})
 	if err != nil {
@@ -166,12 +178,13 @@ func prependProgram(config *prependConfig) program.Program {
 	prog := program.Program{}
 	for _, branchToSync := range config.branchesToSync {
 		sync.BranchProgram(branchToSync, sync.BranchProgramArgs{
-			Config:        config.FullConfig,
-			BranchInfos:   config.allBranches,
-			InitialBranch: config.initialBranch,
-			Program:       &prog,
-			PushBranch:    true,
-			Remotes:       config.remotes,
+			Config:               config.FullConfig,
+			BranchInfos:          config.allBranches,
+			InitialBranch:        config.initialBranch,
+			Program:              &prog,
+			PushBranch:           true,
+			Remotes:              config.remotes,
+			SquashMergedBranches: gitdomain.LocalBranchNames{},
 		})
 	}
 	prog.Add(&opcodes.CreateBranchExistingParent{
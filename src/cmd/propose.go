@@ -30,29 +30,33 @@ const proposeDesc = "Creates a proposal to merge a feature branch"
 const proposeHelp = `
 Syncs the current branch and opens a browser window to the new proposal page of your repository.
 
+When given a branch name, proposes that branch instead of the current one and returns to the current branch afterwards.
+
 The form is pre-populated for the current branch so that the proposal only shows the changes made against the immediate parent branch.
 
 Supported only for repositories hosted on GitHub, GitLab, Gitea and Bitbucket. When using self-hosted versions this command needs to be configured with "git config %s <driver>" where driver is "github", "gitlab", "gitea", or "bitbucket". When using SSH identities, this command needs to be configured with "git config %s <hostname>" where hostname matches what is in your ssh config file.`
 
 func proposeCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	cmd := cobra.Command{
-		Use:     "propose",
+		Use:     "propose [<branch>]",
 		GroupID: "basic",
-		Args:    cobra.NoArgs,
+		Args:    cobra.MaximumNArgs(1),
 		Short:   proposeDesc,
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, gitconfig.KeyHostingPlatform, gitconfig.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executePropose(args, readDryRunFlag(cmd), readInferParentsFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
+	addInferParentsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executePropose(dryRun, verbose bool) error {
+func executePropose(args []string, dryRun, inferParents, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -64,7 +68,7 @@ func executePropose(dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineProposeConfig(repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineProposeConfig(args, repo, dryRun, inferParents, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -100,6 +104,7 @@ func executePropose(dryRun, verbose bool) error {
 type proposeConfig struct {
 	*configdomain.FullConfig
 	allBranches      gitdomain.BranchInfos
+	branchToPropose  gitdomain.LocalBranchName
 	branchesToSync   gitdomain.BranchInfos
 	connector        hostingdomain.Connector
 	dialogTestInputs components.TestInputs
@@ -110,7 +115,7 @@ type proposeConfig struct {
 	remotes          gitdomain.Remotes
 }
 
-func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool) (*proposeConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineProposeConfig(args []string, repo *execute.OpenRepoResult, dryRun, inferParents, verbose bool) (*proposeConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -130,11 +135,28 @@ func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool)
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
 	}
-	err = execute.EnsureKnownBranchAncestry(branchesSnapshot.Active, execute.EnsureKnownBranchAncestryArgs{
+	exit, err = execute.LoadSharedLineage(execute.LoadSharedLineageArgs{
+		DialogTestInputs: &dialogTestInputs,
+		LocalBranches:    branchesSnapshot.Branches.LocalBranches().Names(),
+		Remotes:          remotes,
+		Runner:           repo.Runner,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	branchToPropose := branchesSnapshot.Active
+	if len(args) > 0 {
+		branchToPropose = gitdomain.NewLocalBranchName(args[0])
+		if !branchesSnapshot.Branches.HasLocalBranch(branchToPropose) {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchToPropose)
+		}
+	}
+	err = execute.EnsureKnownBranchAncestry(branchToPropose, execute.EnsureKnownBranchAncestryArgs{
 		Config:           &repo.Runner.Config.FullConfig,
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     inferParents,
 		Runner:           repo.Runner,
 	})
 	if err != nil {
@@ -146,6 +168,7 @@ func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool)
 		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
 		Log:             print.Logger{},
 		OriginURL:       originURL,
+		UpstreamURL:     repo.Runner.Config.UpstreamURL(),
 	})
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
@@ -153,11 +176,12 @@ func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool)
 	if connector == nil {
 		return nil, branchesSnapshot, stashSize, false, hostingdomain.UnsupportedServiceError()
 	}
-	branchNamesToSync := repo.Runner.Config.FullConfig.Lineage.BranchAndAncestors(branchesSnapshot.Active)
+	branchNamesToSync := repo.Runner.Config.FullConfig.Lineage.BranchAndAncestors(branchToPropose)
 	branchesToSync, err := branchesSnapshot.Branches.Select(branchNamesToSync)
 	return &proposeConfig{
 		FullConfig:       &repo.Runner.Config.FullConfig,
 		allBranches:      branchesSnapshot.Branches,
+		branchToPropose:  branchToPropose,
 		branchesToSync:   branchesToSync,
 		connector:        connector,
 		dialogTestInputs: dialogTestInputs,
@@ -173,27 +197,29 @@ func proposeProgram(config *proposeConfig) program.Program {
 	prog := program.Program{}
 	for _, branch := range config.branchesToSync {
 		sync.BranchProgram(branch, sync.BranchProgramArgs{
-			Config:        config.FullConfig,
-			BranchInfos:   config.allBranches,
-			InitialBranch: config.initialBranch,
-			Remotes:       config.remotes,
-			Program:       &prog,
-			PushBranch:    true,
+			Config:               config.FullConfig,
+			BranchInfos:          config.allBranches,
+			InitialBranch:        config.initialBranch,
+			Remotes:              config.remotes,
+			SquashMergedBranches: gitdomain.LocalBranchNames{},
+			Program:              &prog,
+			PushBranch:           true,
 		})
 	}
+	prog.Add(&opcodes.CheckoutIfExists{Branch: config.initialBranch})
 	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
 		DryRun:                   config.dryRun,
 		RunInGitRoot:             true,
 		StashOpenChanges:         config.hasOpenChanges,
 		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
 	})
-	prog.Add(&opcodes.CreateProposal{Branch: config.initialBranch})
+	prog.Add(&opcodes.CreateProposal{Branch: config.branchToPropose})
 	return prog
 }
 
 func validateProposeConfig(config *proposeConfig) error {
-	initialBranchType := config.FullConfig.BranchType(config.initialBranch)
-	switch initialBranchType {
+	branchType := config.FullConfig.BranchType(config.branchToPropose)
+	switch branchType {
 	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch:
@@ -204,6 +230,8 @@ func validateProposeConfig(config *proposeConfig) error {
 		return errors.New(messages.ObservedBranchCannotPropose)
 	case configdomain.BranchTypePerennialBranch:
 		return errors.New(messages.PerennialBranchCannotPropose)
+	case configdomain.BranchTypePrototypeBranch:
+		return fmt.Errorf(messages.PrototypeBranchCannotPropose, config.branchToPropose)
 	}
-	panic(fmt.Sprintf("unhandled branch type: %v", initialBranchType))
+	panic(fmt.Sprintf("unhandled branch type: %v", branchType))
 }
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config"
+	"github.com/git-town/git-town/v12/src/config/commandconfig"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	configInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/config"
+	"github.com/spf13/cobra"
+)
+
+const prototypeDesc = "Makes some feature branches local-only prototype branches"
+
+const prototypeHelp = `
+Makes the given local branches prototype branches.
+If no branch is provided, makes the current branch a prototype branch.
+
+Prototype branches are local-only feature branches for experiments.
+Git Town syncs them with their parent branch
+but never pushes them, not even when "push-new-branches" is enabled.
+To make a prototype branch a normal feature branch, run "git town hack" on it.
+`
+
+func prototypeCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "prototype [branches]",
+		Args:    cobra.ArbitraryArgs,
+		GroupID: "types",
+		Short:   prototypeDesc,
+		Long:    cmdhelpers.Long(prototypeDesc, prototypeHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executePrototype(args, readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executePrototype(args []string, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, err := determinePrototypeConfig(args, repo)
+	if err != nil {
+		return err
+	}
+	err = validatePrototypeConfig(config)
+	if err != nil {
+		return err
+	}
+	branchNames := config.branchesToPrototype.Keys()
+	if err = repo.Runner.Config.AddToPrototypeBranches(branchNames...); err != nil {
+		return err
+	}
+	if err = removeNonPrototypeBranchTypes(config.branchesToPrototype, repo.Runner.Config); err != nil {
+		return err
+	}
+	printPrototypeBranches(branchNames)
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		BeginConfigSnapshot: repo.ConfigSnapshot,
+		Command:             "prototype",
+		EndConfigSnapshot:   undoconfig.EmptyConfigSnapshot(),
+		RootDir:             repo.RootDir,
+		Runner:              repo.Runner,
+		Verbose:             verbose,
+	})
+}
+
+type prototypeConfig struct {
+	allBranches         gitdomain.BranchInfos
+	branchesToPrototype commandconfig.BranchesAndTypes
+}
+
+func printPrototypeBranches(branches gitdomain.LocalBranchNames) {
+	for _, branch := range branches {
+		fmt.Printf(messages.PrototypeBranchIsNowPrototype, branch)
+	}
+}
+
+func removeNonPrototypeBranchTypes(branches commandconfig.BranchesAndTypes, config *config.Config) error {
+	for branchName, branchType := range branches {
+		switch branchType {
+		case configdomain.BranchTypeContributionBranch:
+			if err := config.RemoveFromContributionBranches(branchName); err != nil {
+				return err
+			}
+		case configdomain.BranchTypeObservedBranch:
+			if err := config.RemoveFromObservedBranches(branchName); err != nil {
+				return err
+			}
+		case configdomain.BranchTypeParkedBranch:
+			if err := config.RemoveFromParkedBranches(branchName); err != nil {
+				return err
+			}
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypePrototypeBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+		}
+	}
+	return nil
+}
+
+func determinePrototypeConfig(args []string, repo *execute.OpenRepoResult) (prototypeConfig, error) {
+	branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
+	if err != nil {
+		return prototypeConfig{}, err
+	}
+	branchesToPrototype := commandconfig.BranchesAndTypes{}
+	if len(args) == 0 {
+		branchesToPrototype.Add(branchesSnapshot.Active, &repo.Runner.Config.FullConfig)
+	} else {
+		branchesToPrototype.AddMany(gitdomain.NewLocalBranchNames(args...), &repo.Runner.Config.FullConfig)
+	}
+	return prototypeConfig{
+		allBranches:         branchesSnapshot.Branches,
+		branchesToPrototype: branchesToPrototype,
+	}, nil
+}
+
+func validatePrototypeConfig(config prototypeConfig) error {
+	for branchName, branchType := range config.branchesToPrototype {
+		if !config.allBranches.HasLocalBranch(branchName) {
+			return fmt.Errorf(messages.BranchDoesntExist, branchName)
+		}
+		switch branchType {
+		case configdomain.BranchTypeMainBranch:
+			return errors.New(messages.MainBranchCannotPrototype)
+		case configdomain.BranchTypePerennialBranch:
+			return errors.New(messages.PerennialBranchCannotPrototype)
+		case configdomain.BranchTypePrototypeBranch:
+			return fmt.Errorf(messages.BranchIsAlreadyPrototype, branchName)
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		}
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/git-town/git-town/v12/src/cli/flags"
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
 	"github.com/git-town/git-town/v12/src/execute"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/messages"
@@ -186,6 +187,10 @@ func renameBranchProgram(config *renameBranchConfig) program.Program {
 			result.Add(&opcodes.DeleteParentBranch{Branch: config.oldBranch.LocalName})
 			result.Add(&opcodes.SetParent{Branch: config.newBranch, Parent: config.Lineage.Parent(config.oldBranch.LocalName)})
 		}
+		if syncStrategy, hasSyncStrategy := config.BranchSyncStrategies[config.oldBranch.LocalName]; hasSyncStrategy {
+			result.Add(&opcodes.RemoveLocalConfig{Key: gitconfig.NewSyncStrategyKey(config.oldBranch.LocalName)})
+			result.Add(&opcodes.SetLocalConfig{Key: gitconfig.NewSyncStrategyKey(config.newBranch), Value: syncStrategy.String()})
+		}
 	}
 	for _, child := range config.Lineage.Children(config.oldBranch.LocalName) {
 		result.Add(&opcodes.SetParent{Branch: child, Parent: config.newBranch})
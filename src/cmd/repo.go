@@ -75,6 +75,7 @@ func determineRepoConfig(repo *execute.OpenRepoResult) (*repoConfig, error) {
 		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
 		Log:             print.Logger{},
 		OriginURL:       repo.Runner.Config.OriginURL(),
+		UpstreamURL:     repo.Runner.Config.UpstreamURL(),
 	})
 	if err != nil {
 		return nil, err
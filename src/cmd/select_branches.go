@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"slices"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// selectBranches lets the user select the local branches that the given command should act on.
+func selectBranches(args selectBranchesArgs) (gitdomain.LocalBranchNames, bool, error) {
+	selectable := gitdomain.LocalBranchNames{}
+	for _, branch := range args.branches.LocalBranches().Names() {
+		if slices.Contains(args.branchTypes, args.fullConfig.BranchType(branch)) {
+			selectable = append(selectable, branch)
+		}
+	}
+	return dialog.SelectBranches(dialog.SelectBranchesArgs{
+		Action:      args.action,
+		Branches:    selectable,
+		Inputs:      args.dialogTestInputs.Next(),
+		Lineage:     args.fullConfig.Lineage,
+		Preselected: args.preselected,
+	})
+}
+
+type selectBranchesArgs struct {
+	action           string                    // what the command does with the selected branches, for example "park"
+	branchTypes      []configdomain.BranchType // the types of branches that the user can select
+	branches         gitdomain.BranchInfos
+	dialogTestInputs *components.TestInputs
+	fullConfig       *configdomain.FullConfig
+	preselected      gitdomain.LocalBranchNames
+}
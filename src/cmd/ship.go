@@ -12,6 +12,8 @@ import (
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/config/gitconfig"
 	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git"
+	"github.com/git-town/git-town/v12/src/git/commitmessage"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/gohacks/slice"
 	"github.com/git-town/git-town/v12/src/gohacks/stringslice"
@@ -50,11 +52,17 @@ If you use GitHub, this command can squash merge pull requests via the GitHub AP
 
 Now anytime you ship a branch with a pull request on GitHub, it will squash merge via the GitHub API. It will also update the base branch for any pull requests against that branch.
 
+If several people committed to the branch, Git Town asks which of them should author the squash commit. With the "--co-authors" flag, the squash commit credits all other branch authors via "Co-authored-by" trailers.
+
+If your origin repository is a fork of the repository in your "upstream" remote, branches ship by merging their proposal on the upstream repository via the API. Afterwards this command pulls the squash commit from the upstream repository into your main branch and pushes it to your fork.
+
 If your origin server deletes shipped branches, for example GitHub's feature to automatically delete head branches, run "git config %s false" and Git Town will leave it up to your origin server to delete the tracking branch of the branch you are shipping.`
 
 func shipCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
 	addMessageFlag, readMessageFlag := flags.String("message", "m", "", "Specify the commit message for the squash commit")
+	addCoAuthorsFlag, readCoAuthorsFlag := flags.Bool("co-authors", "", "Credit all other branch authors via Co-authored-by trailers", flags.FlagTypeNonPersistent)
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	cmd := cobra.Command{
 		Use:     "ship",
@@ -63,16 +71,18 @@ func shipCmd() *cobra.Command {
 		Short:   shipDesc,
 		Long:    cmdhelpers.Long(shipDesc, fmt.Sprintf(shipHelp, gitconfig.KeyGithubToken, gitconfig.KeyShipDeleteTrackingBranch)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeShip(args, readMessageFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeShip(args, readMessageFlag(cmd), readCoAuthorsFlag(cmd), readDryRunFlag(cmd), readInferParentsFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addCoAuthorsFlag(&cmd)
 	addDryRunFlag(&cmd)
+	addInferParentsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addMessageFlag(&cmd)
 	return &cmd
 }
 
-func executeShip(args []string, message string, dryRun, verbose bool) error {
+func executeShip(args []string, message string, coAuthors, dryRun, inferParents, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -84,7 +94,7 @@ func executeShip(args []string, message string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineShipConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineShipConfig(args, repo, dryRun, inferParents, verbose)
 	if err != nil || exit {
 		return err
 	}
@@ -107,7 +117,7 @@ func executeShip(args []string, message string, dryRun, verbose bool) error {
 		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
 		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
 		EndStashSize:          0,
-		RunProgram:            shipProgram(config, message),
+		RunProgram:            shipProgram(config, message, coAuthors),
 	}
 	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
 		Connector:               config.connector,
@@ -131,10 +141,12 @@ type shipConfig struct {
 	canShipViaAPI            bool
 	childBranches            gitdomain.LocalBranchNames
 	connector                hostingdomain.Connector
+	defaultCommitMessage     string
 	dialogTestInputs         components.TestInputs
 	dryRun                   bool
 	hasOpenChanges           bool
 	initialBranch            gitdomain.LocalBranchName
+	isFork                   bool // whether the origin repository is a fork of the upstream repository
 	isShippingInitialBranch  bool
 	previousBranch           gitdomain.LocalBranchName
 	proposal                 *hostingdomain.Proposal
@@ -144,7 +156,7 @@ type shipConfig struct {
 	targetBranch             gitdomain.BranchInfo
 }
 
-func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*shipConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, inferParents, verbose bool) (*shipConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -164,6 +176,15 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
 	}
+	exit, err = execute.LoadSharedLineage(execute.LoadSharedLineageArgs{
+		DialogTestInputs: &dialogTestInputs,
+		LocalBranches:    branchesSnapshot.Branches.LocalBranches().Names(),
+		Remotes:          remotes,
+		Runner:           repo.Runner,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
 	branchNameToShip := gitdomain.NewLocalBranchName(slice.FirstElementOr(args, branchesSnapshot.Active.String()))
 	branchToShip := branchesSnapshot.Branches.FindByLocalName(branchNameToShip)
 	if branchToShip != nil && branchToShip.SyncStatus == gitdomain.SyncStatusOtherWorktree {
@@ -183,6 +204,7 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     inferParents,
 		Runner:           repo.Runner,
 	})
 	if err != nil {
@@ -201,11 +223,13 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 	childBranches := repo.Runner.Config.FullConfig.Lineage.Children(branchNameToShip)
 	proposalsOfChildBranches := []hostingdomain.Proposal{}
 	originURL := repo.Runner.Config.OriginURL()
+	upstreamURL := repo.Runner.Config.UpstreamURL()
 	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
 		FullConfig:      &repo.Runner.Config.FullConfig,
 		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
 		Log:             print.Logger{},
 		OriginURL:       originURL,
+		UpstreamURL:     upstreamURL,
 	})
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
@@ -233,6 +257,16 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 			}
 		}
 	}
+	// branches of forks get shipped by merging their proposal on the upstream repository
+	upstreamRepo := hostingdomain.NewUpstreamConfig(originURL, upstreamURL)
+	if upstreamRepo != nil && !canShipViaAPI {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ShipForkWithoutProposal, branchNameToShip, upstreamRepo.Organization, upstreamRepo.Repository)
+	}
+	isFork := canShipViaAPI && connector.UpstreamRepo() != nil
+	defaultCommitMessage, err := renderShipMessageTemplate(&repo.Runner.Config.FullConfig, &repo.Runner.Backend, branchNameToShip, targetBranchName, proposal)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
 	return &shipConfig{
 		FullConfig:               &repo.Runner.Config.FullConfig,
 		allBranches:              branchesSnapshot.Branches,
@@ -240,10 +274,12 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 		canShipViaAPI:            canShipViaAPI,
 		childBranches:            childBranches,
 		connector:                connector,
+		defaultCommitMessage:     defaultCommitMessage,
 		dialogTestInputs:         dialogTestInputs,
 		dryRun:                   dryRun,
 		hasOpenChanges:           repoStatus.OpenChanges,
 		initialBranch:            branchesSnapshot.Active,
+		isFork:                   isFork,
 		isShippingInitialBranch:  isShippingInitialBranch,
 		previousBranch:           previousBranch,
 		proposal:                 proposal,
@@ -265,26 +301,61 @@ func ensureParentBranchIsMainOrPerennialBranch(branch gitdomain.LocalBranchName,
 	return nil
 }
 
-func shipProgram(config *shipConfig, commitMessage string) program.Program {
+// renderShipMessageTemplate provides the default commit message for the squash commit
+// as defined by the configured ship-message-template.
+func renderShipMessageTemplate(config *configdomain.FullConfig, backend *git.BackendCommands, branch, parent gitdomain.LocalBranchName, proposal *hostingdomain.Proposal) (string, error) {
+	if config.ShipMessageTemplate == "" {
+		return "", nil
+	}
+	authors, err := backend.BranchAuthors(branch, parent)
+	if err != nil {
+		return "", err
+	}
+	data := commitmessage.TemplateData{
+		Authors:        authors,
+		Branch:         branch.String(),
+		Parent:         parent.String(),
+		ProposalBody:   "",
+		ProposalNumber: 0,
+		ProposalTitle:  "",
+		Tickets:        []string{},
+	}
+	ticketSources := []string{branch.String()}
+	if proposal != nil {
+		data.ProposalBody = proposal.Body
+		data.ProposalNumber = proposal.Number
+		data.ProposalTitle = proposal.Title
+		ticketSources = append(ticketSources, proposal.Title, proposal.Body)
+	}
+	data.Tickets, err = config.TicketRegex.Tickets(ticketSources...)
+	if err != nil {
+		return "", err
+	}
+	return commitmessage.RenderTemplate(config.ShipMessageTemplate.String(), data), nil
+}
+
+func shipProgram(config *shipConfig, commitMessage string, coAuthors bool) program.Program {
 	prog := program.Program{}
 	if config.SyncBeforeShip {
 		// sync the parent branch
 		sync.BranchProgram(config.targetBranch, sync.BranchProgramArgs{
-			Config:        config.FullConfig,
-			BranchInfos:   config.allBranches,
-			InitialBranch: config.initialBranch,
-			Remotes:       config.remotes,
-			Program:       &prog,
-			PushBranch:    true,
+			Config:               config.FullConfig,
+			BranchInfos:          config.allBranches,
+			InitialBranch:        config.initialBranch,
+			Remotes:              config.remotes,
+			SquashMergedBranches: gitdomain.LocalBranchNames{},
+			Program:              &prog,
+			PushBranch:           true,
 		})
 		// sync the branch to ship (local sync only)
 		sync.BranchProgram(config.branchToShip, sync.BranchProgramArgs{
-			Config:        config.FullConfig,
-			BranchInfos:   config.allBranches,
-			InitialBranch: config.initialBranch,
-			Remotes:       config.remotes,
-			Program:       &prog,
-			PushBranch:    false,
+			Config:               config.FullConfig,
+			BranchInfos:          config.allBranches,
+			InitialBranch:        config.initialBranch,
+			Remotes:              config.remotes,
+			SquashMergedBranches: gitdomain.LocalBranchNames{},
+			Program:              &prog,
+			PushBranch:           false,
 		})
 	}
 	prog.Add(&opcodes.EnsureHasShippableChanges{Branch: config.branchToShip.LocalName, Parent: config.MainBranch})
@@ -299,14 +370,29 @@ func shipProgram(config *shipConfig, commitMessage string) program.Program {
 		}
 		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: config.branchToShip.LocalName})
 		prog.Add(&opcodes.ConnectorMergeProposal{
-			Branch:          config.branchToShip.LocalName,
-			ProposalNumber:  config.proposal.Number,
-			CommitMessage:   commitMessage,
-			ProposalMessage: config.proposalMessage,
+			Branch:               config.branchToShip.LocalName,
+			CoAuthors:            coAuthors,
+			CommitMessage:        commitMessage,
+			DefaultCommitMessage: config.defaultCommitMessage,
+			Parent:               config.targetBranch.LocalName,
+			ProposalMessage:      config.proposalMessage,
+			ProposalNumber:       config.proposal.Number,
 		})
-		prog.Add(&opcodes.PullCurrentBranch{})
+		if config.isFork {
+			// the squash commit exists only in the upstream repository
+			prog.Add(&opcodes.FetchUpstream{Branch: config.targetBranch.LocalName})
+			prog.Add(&opcodes.RebaseBranch{Branch: gitdomain.NewBranchName("upstream/" + config.targetBranch.LocalName.String())})
+		} else {
+			prog.Add(&opcodes.PullCurrentBranch{})
+		}
 	} else {
-		prog.Add(&opcodes.SquashMerge{Branch: config.branchToShip.LocalName, CommitMessage: commitMessage, Parent: config.targetBranch.LocalName})
+		prog.Add(&opcodes.SquashMerge{
+			Branch:               config.branchToShip.LocalName,
+			CoAuthors:            coAuthors,
+			CommitMessage:        commitMessage,
+			DefaultCommitMessage: config.defaultCommitMessage,
+			Parent:               config.targetBranch.LocalName,
+		})
 	}
 	if config.remotes.HasOrigin() && config.IsOnline() {
 		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: config.targetBranch.LocalName})
@@ -343,7 +429,7 @@ func validateShippableBranchType(branchType configdomain.BranchType) error {
 	switch branchType {
 	case configdomain.BranchTypeContributionBranch:
 		return errors.New(messages.ContributionBranchCannotShip)
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch:
 		return errors.New(messages.MainBranchCannotShip)
@@ -77,6 +77,7 @@ func executeSkip(verbose bool) error {
 		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
 		Log:             print.Logger{},
 		OriginURL:       originURL,
+		UpstreamURL:     repo.Runner.Config.UpstreamURL(),
 	})
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 
@@ -9,30 +10,35 @@ import (
 	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/cli/flags"
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/execute"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/spf13/cobra"
 )
 
 const switchDesc = "Displays the local branches visually and allows switching between them"
 
+const switchHelp = `
+When given a branch name, switches to that branch without displaying the branches.`
+
 func switchCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
-		Use:     "switch",
+		Use:     "switch [<branch>]",
 		GroupID: "basic",
-		Args:    cobra.NoArgs,
+		Args:    cobra.MaximumNArgs(1),
 		Short:   switchDesc,
-		Long:    cmdhelpers.Long(switchDesc),
+		Long:    cmdhelpers.Long(switchDesc, switchHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeSwitch(readVerboseFlag(cmd))
+			return executeSwitch(args, readVerboseFlag(cmd))
 		},
 	}
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeSwitch(verbose bool) error {
+func executeSwitch(args []string, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  false,
@@ -48,14 +54,22 @@ func executeSwitch(verbose bool) error {
 	if err != nil || exit {
 		return err
 	}
-	branchToCheckout, abort, err := dialog.SwitchBranch(config.branchNames, config.initialBranch, repo.Runner.Config.FullConfig.Lineage)
+	selected, abort, err := selectSwitchBranch(args, config, &repo.Runner.Config.FullConfig)
 	if err != nil || abort {
 		return err
 	}
-	if branchToCheckout == config.initialBranch {
+	if selected.Branch == config.initialBranch {
+		return nil
+	}
+	if selected.Worktree != "" {
+		fmt.Printf(messages.SwitchBranchInOtherWorktree, selected.Branch, selected.Worktree)
 		return nil
 	}
-	err = repo.Runner.Frontend.CheckoutBranch(branchToCheckout)
+	if selected.IsRemoteOnly() {
+		err = repo.Runner.Frontend.CheckoutRemoteBranch(selected.RemoteBranch)
+	} else {
+		err = repo.Runner.Frontend.CheckoutBranch(selected.Branch)
+	}
 	if err != nil {
 		exitCode := 1
 		var exitErr *exec.ExitError
@@ -67,9 +81,32 @@ func executeSwitch(verbose bool) error {
 	return nil
 }
 
+// selectSwitchBranch provides the branch to switch to:
+// the given branch if there is one, otherwise the branch that the user selects in the dialog.
+func selectSwitchBranch(args []string, config *switchConfig, fullConfig *configdomain.FullConfig) (dialog.SwitchBranchEntry, bool, error) {
+	if len(args) == 0 {
+		return dialog.SwitchBranch(dialog.SwitchBranchArgs{
+			Branches:        config.branches,
+			Config:          fullConfig,
+			DialogTestInput: config.dialogTestInputs.Next(),
+			InitialBranch:   config.initialBranch,
+			Worktrees:       config.worktrees,
+		})
+	}
+	branch := gitdomain.NewLocalBranchName(args[0])
+	for _, entry := range dialog.SwitchBranchEntries(config.branches, fullConfig, config.worktrees) {
+		if entry.Branch == branch {
+			return entry, false, nil
+		}
+	}
+	return dialog.SwitchBranchEntry{}, false, fmt.Errorf(messages.BranchDoesntExist, branch) //nolint:exhaustruct
+}
+
 type switchConfig struct {
-	branchNames   gitdomain.LocalBranchNames
-	initialBranch gitdomain.LocalBranchName
+	branches         gitdomain.BranchInfos
+	dialogTestInputs components.TestInputs
+	initialBranch    gitdomain.LocalBranchName
+	worktrees        gitdomain.Worktrees
 }
 
 func determineSwitchConfig(repo *execute.OpenRepoResult, verbose bool) (*switchConfig, bool, error) {
@@ -87,8 +124,14 @@ func determineSwitchConfig(repo *execute.OpenRepoResult, verbose bool) (*switchC
 	if err != nil || exit {
 		return nil, exit, err
 	}
+	worktrees, err := repo.Runner.Backend.Worktrees()
+	if err != nil {
+		return nil, false, err
+	}
 	return &switchConfig{
-		branchNames:   branchesSnapshot.Branches.Names(),
-		initialBranch: branchesSnapshot.Active,
+		branches:         branchesSnapshot.Branches,
+		dialogTestInputs: dialogTestInputs,
+		initialBranch:    branchesSnapshot.Active,
+		worktrees:        worktrees,
 	}, false, err
 }
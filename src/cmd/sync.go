@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -10,9 +11,13 @@ import (
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/config/gitconfig"
 	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
+	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/git-town/git-town/v12/src/sync"
 	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	"github.com/git-town/git-town/v12/src/validate"
 	fullInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/full"
 	"github.com/git-town/git-town/v12/src/vm/program"
 	"github.com/git-town/git-town/v12/src/vm/runstate"
@@ -29,34 +34,45 @@ When run on a feature branch:
 - pulls updates for the current branch
 - merges the parent branch into the current branch
 - pushes the current branch
+- deletes ancestor branches that were squash-merged into their parent
 
 When run on the main branch or a perennial branch:
 - pulls and pushes updates for the current branch
 - pushes tags
 
+If all feature branches of a stack use the rebase sync strategy and your Git version supports it,
+rebases the entire stack at once using "git rebase --update-refs".
+
+When given a branch name, syncs that branch instead of the current one and returns to the current branch afterwards.
+
 If the repository contains an "upstream" remote, syncs the main branch with its upstream counterpart. You can disable this by running "git config %s false".`
 
 func syncCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	addAllFlag, readAllFlag := flags.Bool("all", "a", "Sync all local branches", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
-		Use:     "sync",
+		Use:     "sync [<branch>]",
 		GroupID: "basic",
-		Args:    cobra.NoArgs,
+		Args:    cobra.MaximumNArgs(1),
 		Short:   syncDesc,
 		Long:    cmdhelpers.Long(syncDesc, fmt.Sprintf(syncHelp, gitconfig.KeySyncUpstream)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeSync(readAllFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeSync(args, readAllFlag(cmd), readDryRunFlag(cmd), readInferParentsFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
 	addAllFlag(&cmd)
+	addInferParentsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addDryRunFlag(&cmd)
 	return &cmd
 }
 
-func executeSync(all, dryRun, verbose bool) error {
+func executeSync(args []string, all, dryRun, inferParents, verbose bool) error {
+	if all && len(args) > 0 {
+		return errors.New(messages.SyncAllWithBranch)
+	}
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -68,19 +84,20 @@ func executeSync(all, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineSyncConfig(all, repo, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineSyncConfig(args, all, repo, inferParents, verbose)
 	if err != nil || exit {
 		return err
 	}
 	runProgram := program.Program{}
 	sync.BranchesProgram(sync.BranchesProgramArgs{
 		BranchProgramArgs: sync.BranchProgramArgs{
-			Config:        config.FullConfig,
-			BranchInfos:   config.allBranches,
-			InitialBranch: config.initialBranch,
-			Remotes:       config.remotes,
-			Program:       &runProgram,
-			PushBranch:    true,
+			Config:               config.FullConfig,
+			BranchInfos:          config.allBranches,
+			InitialBranch:        config.initialBranch,
+			Remotes:              config.remotes,
+			Program:              &runProgram,
+			PushBranch:           true,
+			SquashMergedBranches: config.squashMergedBranches,
 		},
 		BranchesToSync: config.branchesToSync,
 		DryRun:         dryRun,
@@ -88,6 +105,7 @@ func executeSync(all, dryRun, verbose bool) error {
 		InitialBranch:  config.initialBranch,
 		PreviousBranch: config.previousBranch,
 		ShouldPushTags: config.shouldPushTags,
+		Stack:          config.stack,
 	})
 	runProgram.RemoveDuplicateCheckout()
 	runState := runstate.RunState{
@@ -118,17 +136,19 @@ func executeSync(all, dryRun, verbose bool) error {
 
 type syncConfig struct {
 	*configdomain.FullConfig
-	allBranches      gitdomain.BranchInfos
-	branchesToSync   gitdomain.BranchInfos
-	dialogTestInputs components.TestInputs
-	hasOpenChanges   bool
-	initialBranch    gitdomain.LocalBranchName
-	previousBranch   gitdomain.LocalBranchName
-	remotes          gitdomain.Remotes
-	shouldPushTags   bool
+	allBranches          gitdomain.BranchInfos
+	branchesToSync       gitdomain.BranchInfos
+	dialogTestInputs     components.TestInputs
+	hasOpenChanges       bool
+	initialBranch        gitdomain.LocalBranchName
+	previousBranch       gitdomain.LocalBranchName
+	remotes              gitdomain.Remotes
+	shouldPushTags       bool
+	squashMergedBranches gitdomain.LocalBranchNames
+	stack                gitdomain.LocalBranchNames
 }
 
-func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult, verbose bool) (*syncConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineSyncConfig(args []string, allFlag bool, repo *execute.OpenRepoResult, inferParents, verbose bool) (*syncConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -148,6 +168,15 @@ func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult, verbose boo
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
 	}
+	exit, err = execute.LoadSharedLineage(execute.LoadSharedLineageArgs{
+		DialogTestInputs: &dialogTestInputs,
+		LocalBranches:    branchesSnapshot.Branches.LocalBranches().Names(),
+		Remotes:          remotes,
+		Runner:           repo.Runner,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
 	var branchNamesToSync gitdomain.LocalBranchNames
 	var shouldPushTags bool
 	if allFlag {
@@ -155,6 +184,7 @@ func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult, verbose boo
 		err = execute.EnsureKnownBranchesAncestry(execute.EnsureKnownBranchesAncestryArgs{
 			Config:           &repo.Runner.Config.FullConfig,
 			DialogTestInputs: &dialogTestInputs,
+			InferParents:     inferParents,
 			LocalBranches:    localBranches,
 			Runner:           repo.Runner,
 		})
@@ -164,30 +194,159 @@ func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult, verbose boo
 		branchNamesToSync = localBranches.Names()
 		shouldPushTags = true
 	} else {
-		err = execute.EnsureKnownBranchAncestry(branchesSnapshot.Active, execute.EnsureKnownBranchAncestryArgs{
+		branchToSync := branchesSnapshot.Active
+		if len(args) > 0 {
+			branchToSync = gitdomain.NewLocalBranchName(args[0])
+			if !branchesSnapshot.Branches.HasLocalBranch(branchToSync) {
+				return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchToSync)
+			}
+		}
+		err = execute.EnsureKnownBranchAncestry(branchToSync, execute.EnsureKnownBranchAncestryArgs{
 			Config:           &repo.Runner.Config.FullConfig,
 			AllBranches:      branchesSnapshot.Branches,
 			DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 			DialogTestInputs: &dialogTestInputs,
+			InferParents:     inferParents,
 			Runner:           repo.Runner,
 		})
 		if err != nil {
 			return nil, branchesSnapshot, stashSize, false, err
 		}
-		branchNamesToSync = gitdomain.LocalBranchNames{branchesSnapshot.Active}
-		shouldPushTags = repo.Runner.Config.FullConfig.IsMainOrPerennialBranch(branchesSnapshot.Active)
+		branchNamesToSync = gitdomain.LocalBranchNames{branchToSync}
+		shouldPushTags = repo.Runner.Config.FullConfig.IsMainOrPerennialBranch(branchToSync)
 	}
 	allBranchNamesToSync := repo.Runner.Config.FullConfig.Lineage.BranchesAndAncestors(branchNamesToSync)
 	branchesToSync, err := branchesSnapshot.Branches.Select(allBranchNamesToSync)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	squashMergedBranches, err := determineSquashMergedBranches(branchesToSync, branchesSnapshot.Branches, &repo.Runner.Config.FullConfig, &repo.Runner.Backend)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	stack, err := determineStack(branchesToSync, squashMergedBranches, &repo.Runner.Config.FullConfig, &repo.Runner.Backend)
 	return &syncConfig{
-		FullConfig:       &repo.Runner.Config.FullConfig,
-		allBranches:      branchesSnapshot.Branches,
-		branchesToSync:   branchesToSync,
-		dialogTestInputs: dialogTestInputs,
-		hasOpenChanges:   repoStatus.OpenChanges,
-		initialBranch:    branchesSnapshot.Active,
-		previousBranch:   previousBranch,
-		remotes:          remotes,
-		shouldPushTags:   shouldPushTags,
+		FullConfig:           &repo.Runner.Config.FullConfig,
+		allBranches:          branchesSnapshot.Branches,
+		branchesToSync:       branchesToSync,
+		dialogTestInputs:     dialogTestInputs,
+		hasOpenChanges:       repoStatus.OpenChanges,
+		initialBranch:        branchesSnapshot.Active,
+		previousBranch:       previousBranch,
+		remotes:              remotes,
+		shouldPushTags:       shouldPushTags,
+		squashMergedBranches: squashMergedBranches,
+		stack:                stack,
 	}, branchesSnapshot, stashSize, false, err
 }
+
+// determineSquashMergedBranches provides the feature branches among the given branches
+// whose changes have already landed in their parent branch, for example through a squash-merge in the web UI.
+// The given branches must be ordered hierarchically.
+func determineSquashMergedBranches(branchesToSync, allBranches gitdomain.BranchInfos, config *configdomain.FullConfig, backend *git.BackendCommands) (gitdomain.LocalBranchNames, error) {
+	result := gitdomain.LocalBranchNames{}
+	for _, branch := range branchesToSync {
+		// Only branches that were pushed can have been squash-merged on the code hosting platform.
+		// A branch that is out of sync with its tracking branch contains changes that weren't part of the squash-merge.
+		switch branch.SyncStatus {
+		case gitdomain.SyncStatusUpToDate, gitdomain.SyncStatusDeletedAtRemote:
+		case gitdomain.SyncStatusNotInSync, gitdomain.SyncStatusLocalOnly, gitdomain.SyncStatusRemoteOnly, gitdomain.SyncStatusOtherWorktree:
+			continue
+		}
+		if config.BranchType(branch.LocalName) != configdomain.BranchTypeFeatureBranch {
+			continue
+		}
+		// If the parent was squash-merged as well, this branch only got its own commits squash-merged
+		// into the closest ancestor that still exists.
+		base := gitdomain.EmptySHA()
+		parentName := config.Lineage.Parent(branch.LocalName)
+		if squashMergedParent := allBranches.FindByLocalName(parentName); squashMergedParent != nil && slice.Contains(result, parentName) {
+			base = squashMergedParent.LocalSHA
+		}
+		parent := allBranches.FindByLocalName(sync.ExistingAncestor(branch.LocalName, config.Lineage, result))
+		if parent == nil {
+			continue
+		}
+		target := parent.LocalName.BranchName()
+		if parent.HasTrackingBranch() {
+			target = parent.RemoteName.BranchName()
+		}
+		squashMerged, err := backend.IsSquashMergedInto(branch.LocalName.BranchName(), target, base)
+		if err != nil {
+			return result, fmt.Errorf(messages.BranchSquashMergedProblem, branch.LocalName, err)
+		}
+		if squashMerged {
+			result = append(result, branch.LocalName)
+		}
+	}
+	return result, nil
+}
+
+// determineStack provides the feature branches among the given branches that Git Town can sync in one go
+// by rebasing the topmost branch using "git rebase --update-refs", ordered from the bottom to the top.
+// This is possible for a single chain of at least two feature branches that use the rebase sync strategy,
+// contain their parent branch and all commits of their tracking branch, and contain no merge commits.
+// Provides no branches if the given branches need to be synced one by one.
+func determineStack(branchesToSync gitdomain.BranchInfos, squashMergedBranches gitdomain.LocalBranchNames, config *configdomain.FullConfig, backend *git.BackendCommands) (gitdomain.LocalBranchNames, error) {
+	noStack := gitdomain.LocalBranchNames{}
+	if len(squashMergedBranches) > 0 {
+		return noStack, nil
+	}
+	stack := gitdomain.LocalBranchNames{}
+	for _, branch := range branchesToSync {
+		switch config.BranchType(branch.LocalName) {
+		case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+			continue
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+			return noStack, nil
+		case configdomain.BranchTypeFeatureBranch:
+		}
+		if config.SyncFeatureStrategyFor(branch.LocalName) != configdomain.SyncFeatureStrategyRebase {
+			return noStack, nil
+		}
+		switch branch.SyncStatus {
+		case gitdomain.SyncStatusUpToDate, gitdomain.SyncStatusNotInSync, gitdomain.SyncStatusLocalOnly:
+		case gitdomain.SyncStatusRemoteOnly, gitdomain.SyncStatusDeletedAtRemote, gitdomain.SyncStatusOtherWorktree:
+			return noStack, nil
+		}
+		parent := config.Lineage.Parent(branch.LocalName)
+		if len(stack) == 0 && !config.IsMainOrPerennialBranch(parent) {
+			return noStack, nil
+		}
+		if len(stack) > 0 && parent != stack[len(stack)-1] {
+			// the branches form a tree, not a single chain
+			return noStack, nil
+		}
+		stack = append(stack, branch.LocalName)
+	}
+	if len(stack) < 2 {
+		return noStack, nil
+	}
+	base := branchesToSync.FindByLocalName(config.Lineage.Parent(stack[0]))
+	if base == nil || !base.HasLocalBranch() || base.SyncStatus == gitdomain.SyncStatusOtherWorktree {
+		return noStack, nil
+	}
+	major, minor, err := backend.Version()
+	if err != nil {
+		return noStack, err
+	}
+	if !validate.SupportsRebaseUpdateRefs(major, minor) {
+		return noStack, nil
+	}
+	for i, branchName := range stack {
+		branch := branchesToSync.FindByLocalName(branchName)
+		if branch.SyncStatus == gitdomain.SyncStatusNotInSync && !backend.IsAncestor(branch.RemoteName.BranchName(), branchName.BranchName()) {
+			// the tracking branch contains commits that the local branch doesn't have
+			return noStack, nil
+		}
+		if i > 0 && !backend.IsAncestor(stack[i-1].BranchName(), branchName.BranchName()) {
+			// the branch doesn't contain all commits of its parent
+			return noStack, nil
+		}
+	}
+	hasMergeCommits, err := backend.HasMergeCommits(stack[len(stack)-1].BranchName(), base.LocalName.BranchName())
+	if err != nil || hasMergeCommits {
+		return noStack, err
+	}
+	return stack, nil
+}
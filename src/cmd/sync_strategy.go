@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/flags"
+	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v12/src/config/commandconfig"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/execute"
+	"github.com/git-town/git-town/v12/src/git"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/undo/undoconfig"
+	configInterpreter "github.com/git-town/git-town/v12/src/vm/interpreter/config"
+	"github.com/spf13/cobra"
+)
+
+const syncStrategyDesc = "Displays or sets the sync strategy of feature branches"
+
+const syncStrategyHelp = `
+Feature branches sync using the configured sync-feature-strategy.
+This command overrides it for individual branches,
+for example to merge branches that you share with other people
+while rebasing your personal branches.
+
+Without arguments, displays the sync strategy of the current branch.
+"merge" or "rebase" make the given branches use that strategy.
+"default" makes the given branches use the sync-feature-strategy again.
+If no branch is provided, updates the current branch.`
+
+const syncStrategyDefault = "default"
+
+func syncStrategyCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "sync-strategy [(merge | rebase | default) [branches]]",
+		Args:    cobra.ArbitraryArgs,
+		GroupID: "types",
+		Short:   syncStrategyDesc,
+		Long:    cmdhelpers.Long(syncStrategyDesc, syncStrategyHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeSyncStrategy(args, readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeSyncStrategy(args []string, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, err := determineSyncStrategyConfig(args, repo)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		displaySyncStrategy(config, repo.Runner)
+	} else {
+		err = validateSyncStrategyConfig(config)
+		if err != nil {
+			return err
+		}
+		err = setSyncStrategies(config, repo.Runner)
+		if err != nil {
+			return err
+		}
+	}
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		BeginConfigSnapshot: repo.ConfigSnapshot,
+		Command:             "sync-strategy",
+		EndConfigSnapshot:   undoconfig.EmptyConfigSnapshot(),
+		RootDir:             repo.RootDir,
+		Runner:              repo.Runner,
+		Verbose:             verbose,
+	})
+}
+
+type syncStrategyConfig struct {
+	allBranches gitdomain.BranchInfos
+	branches    commandconfig.BranchesAndTypes
+	strategy    *configdomain.SyncFeatureStrategy // nil means the branches should use the default sync strategy
+}
+
+func determineSyncStrategyConfig(args []string, repo *execute.OpenRepoResult) (syncStrategyConfig, error) {
+	branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
+	if err != nil {
+		return syncStrategyConfig{}, err
+	}
+	var strategy *configdomain.SyncFeatureStrategy
+	if len(args) > 0 && args[0] != syncStrategyDefault {
+		strategy, err = configdomain.NewSyncFeatureStrategyRef(args[0])
+		if err != nil {
+			return syncStrategyConfig{}, err
+		}
+	}
+	branches := commandconfig.BranchesAndTypes{}
+	if len(args) < 2 {
+		branches.Add(branchesSnapshot.Active, &repo.Runner.Config.FullConfig)
+	} else {
+		branches.AddMany(gitdomain.NewLocalBranchNames(args[1:]...), &repo.Runner.Config.FullConfig)
+	}
+	return syncStrategyConfig{
+		allBranches: branchesSnapshot.Branches,
+		branches:    branches,
+		strategy:    strategy,
+	}, nil
+}
+
+func displaySyncStrategy(config syncStrategyConfig, run *git.ProdRunner) {
+	for _, branch := range config.branches.Keys() {
+		fmt.Println(run.Config.FullConfig.SyncFeatureStrategyFor(branch))
+	}
+}
+
+func setSyncStrategies(config syncStrategyConfig, run *git.ProdRunner) error {
+	for _, branch := range config.branches.Keys() {
+		if config.strategy == nil {
+			run.Config.RemoveBranchSyncStrategy(branch)
+			fmt.Printf(messages.SyncStrategyIsNowDefault, branch)
+			continue
+		}
+		if err := run.Config.SetBranchSyncStrategy(branch, *config.strategy); err != nil {
+			return err
+		}
+		fmt.Printf(messages.SyncStrategyIsNow, branch, *config.strategy)
+	}
+	return nil
+}
+
+func validateSyncStrategyConfig(config syncStrategyConfig) error {
+	for branchName, branchType := range config.branches {
+		if !config.allBranches.HasLocalBranch(branchName) {
+			return fmt.Errorf(messages.BranchDoesntExist, branchName)
+		}
+		switch branchType {
+		case configdomain.BranchTypeMainBranch:
+			return errors.New(messages.MainBranchCannotSyncStrategy)
+		case configdomain.BranchTypePerennialBranch:
+			return errors.New(messages.PerennialBranchCannotSyncStrategy)
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		}
+	}
+	return nil
+}
@@ -106,6 +106,7 @@ func determineUndoConfig(repo *execute.OpenRepoResult, verbose bool) (*undoConfi
 		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
 		Log:             print.Logger{},
 		OriginURL:       originURL,
+		UpstreamURL:     repo.Runner.Config.UpstreamURL(),
 	})
 	if err != nil {
 		return nil, initialStashSize, repo.Runner.Config.FullConfig.Lineage, err
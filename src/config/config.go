@@ -20,10 +20,13 @@ import (
 type Config struct {
 	ConfigFile      *configdomain.PartialConfig // content of git-town.toml, nil = no config file exists
 	DryRun          bool
-	FullConfig      configdomain.FullConfig    // the merged configuration data
-	GitConfig       gitconfig.Access           // access to the Git configuration settings
-	GlobalGitConfig configdomain.PartialConfig // content of the global Git configuration
-	LocalGitConfig  configdomain.PartialConfig // content of the local Git configuration
+	EnvConfig       configdomain.PartialConfig  // settings that GIT_TOWN_* environment variables override
+	FullConfig      configdomain.FullConfig     // the merged configuration data
+	GitConfig       gitconfig.Access            // access to the Git configuration settings
+	GlobalGitConfig configdomain.PartialConfig  // content of the global Git configuration
+	LocalGitConfig  configdomain.PartialConfig  // content of the local Git configuration
+	Origins         configdomain.ConfigOrigins  // the raw values of all settings in all configuration layers, recorded while merging them
+	UserConfigFile  *configdomain.PartialConfig // content of the user-level configuration file, nil = no user-level configuration file exists
 	originURLCache  configdomain.OriginURLCache
 }
 
@@ -51,6 +54,24 @@ func (self *Config) AddToPerennialBranches(branches ...gitdomain.LocalBranchName
 	return self.SetPerennialBranches(append(self.FullConfig.PerennialBranches, branches...))
 }
 
+// AddToPrototypeBranches registers the given branch names as prototype branches.
+// The branches must exist.
+func (self *Config) AddToPrototypeBranches(branches ...gitdomain.LocalBranchName) error {
+	return self.SetPrototypeBranches(append(self.FullConfig.PrototypeBranches, branches...))
+}
+
+// GitAliases provides the aliases that exist in the Git configuration.
+// Unlike FullConfig.Aliases, this doesn't include the aliases that the configuration file suggests.
+func (self *Config) GitAliases() configdomain.Aliases {
+	result := configdomain.Aliases{}
+	for _, partialConfig := range []configdomain.PartialConfig{self.GlobalGitConfig, self.LocalGitConfig} {
+		for aliasableCommand, value := range partialConfig.Aliases {
+			result[aliasableCommand] = value
+		}
+	}
+	return result
+}
+
 // OriginURL provides the URL for the "origin" remote.
 // Tests can stub this through the GIT_TOWN_REMOTE environment variable.
 // Caches its result so can be called repeatedly.
@@ -72,16 +93,18 @@ func (self *Config) OriginURLString() string {
 	return self.GitConfig.OriginRemote()
 }
 
-func (self *Config) Reload() {
-	_, self.GlobalGitConfig, _ = self.GitConfig.LoadGlobal() // we ignore the Git cache here because reloading a config in the middle of a Git Town command doesn't change the cached initial state of the repo
-	_, self.LocalGitConfig, _ = self.GitConfig.LoadLocal()   // we ignore the Git cache here because reloading a config in the middle of a Git Town command doesn't change the cached initial state of the repo
-	self.FullConfig = configdomain.DefaultConfig()
-	// TODO: merge this code with the similar code in NewConfig.
-	if self.ConfigFile != nil {
-		self.FullConfig.Merge(*self.ConfigFile)
+// UpstreamURL provides the URL for the "upstream" remote.
+func (self *Config) UpstreamURL() *giturl.Parts {
+	if self.FullConfig.UpstreamURL == "" {
+		return nil
 	}
-	self.FullConfig.Merge(self.GlobalGitConfig)
-	self.FullConfig.Merge(self.LocalGitConfig)
+	return confighelpers.DetermineOriginURL(self.FullConfig.UpstreamURL, self.FullConfig.HostingOriginHostname, self.originURLCache)
+}
+
+func (self *Config) Reload() {
+	_, self.GlobalGitConfig, _, _ = self.GitConfig.LoadGlobal() // we ignore the Git cache here because reloading a config in the middle of a Git Town command doesn't change the cached initial state of the repo
+	_, self.LocalGitConfig, _, _ = self.GitConfig.LoadLocal()   // we ignore the Git cache here because reloading a config in the middle of a Git Town command doesn't change the cached initial state of the repo
+	self.FullConfig, self.Origins = mergeConfigs(self.ConfigFile, self.UserConfigFile, self.GlobalGitConfig, self.LocalGitConfig, self.EnvConfig)
 }
 
 // RemoveFromContributionBranches removes the given branch as a perennial branch.
@@ -102,16 +125,39 @@ func (self *Config) RemoveFromParkedBranches(branch gitdomain.LocalBranchName) e
 	return self.SetParkedBranches(self.FullConfig.ParkedBranches)
 }
 
+// RemoveFromPrototypeBranches removes the given branch as a prototype branch.
+func (self *Config) RemoveFromPrototypeBranches(branch gitdomain.LocalBranchName) error {
+	self.FullConfig.PrototypeBranches = slice.Remove(self.FullConfig.PrototypeBranches, branch)
+	return self.SetPrototypeBranches(self.FullConfig.PrototypeBranches)
+}
+
 // RemoveFromPerennialBranches removes the given branch as a perennial branch.
 func (self *Config) RemoveFromPerennialBranches(branch gitdomain.LocalBranchName) error {
 	self.FullConfig.PerennialBranches = slice.Remove(self.FullConfig.PerennialBranches, branch)
 	return self.SetPerennialBranches(self.FullConfig.PerennialBranches)
 }
 
+// RemoveBranchSyncStrategy removes the sync strategy override for the given branch from the Git configuration.
+func (self *Config) RemoveBranchSyncStrategy(branch gitdomain.LocalBranchName) {
+	delete(self.FullConfig.BranchSyncStrategies, branch)
+	if self.LocalGitConfig.BranchSyncStrategies != nil {
+		delete(*self.LocalGitConfig.BranchSyncStrategies, branch)
+	}
+	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.NewSyncStrategyKey(branch))
+}
+
+func (self *Config) RemoveContributionRegex() {
+	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyContributionRegex)
+}
+
 func (self *Config) RemoveMainBranch() {
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyMainBranch)
 }
 
+func (self *Config) RemoveObservedRegex() {
+	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyObservedRegex)
+}
+
 // RemoveParent removes the parent branch entry for the given branch from the Git configuration.
 func (self *Config) RemoveParent(branch gitdomain.LocalBranchName) {
 	if self.LocalGitConfig.Lineage != nil {
@@ -120,6 +166,10 @@ func (self *Config) RemoveParent(branch gitdomain.LocalBranchName) {
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.NewParentKey(branch))
 }
 
+func (self *Config) RemoveParkedRegex() {
+	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyParkedRegex)
+}
+
 func (self *Config) RemovePerennialBranches() {
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyPerennialBranches)
 }
@@ -156,6 +206,13 @@ func (self *Config) RemoveSyncUpstream() {
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeySyncUpstream)
 }
 
+// SetBranchSyncStrategy makes the given branch sync using the given strategy
+// instead of the configured sync-feature-strategy.
+func (self *Config) SetBranchSyncStrategy(branch gitdomain.LocalBranchName, strategy configdomain.SyncFeatureStrategy) error {
+	self.FullConfig.BranchSyncStrategies[branch] = strategy
+	return self.GitConfig.SetLocalConfigValue(gitconfig.NewSyncStrategyKey(branch), strategy.String())
+}
+
 // SetObservedBranches marks the given branches as observed branches.
 func (self *Config) SetContributionBranches(branches gitdomain.LocalBranchNames) error {
 	self.FullConfig.ContributionBranches = branches
@@ -163,6 +220,13 @@ func (self *Config) SetContributionBranches(branches gitdomain.LocalBranchNames)
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyContributionBranches, branches.Join(" "))
 }
 
+// SetContributionRegexLocally updates the locally configured contribution regex.
+func (self *Config) SetContributionRegexLocally(value configdomain.ContributionRegex) error {
+	self.LocalGitConfig.ContributionRegex = &value
+	self.FullConfig.ContributionRegex = value
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyContributionRegex, value.String())
+}
+
 // SetMainBranch marks the given branch as the main branch
 // in the Git Town configuration.
 func (self *Config) SetMainBranch(branch gitdomain.LocalBranchName) error {
@@ -178,6 +242,13 @@ func (self *Config) SetObservedBranches(branches gitdomain.LocalBranchNames) err
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyObservedBranches, branches.Join(" "))
 }
 
+// SetObservedRegexLocally updates the locally configured observed regex.
+func (self *Config) SetObservedRegexLocally(value configdomain.ObservedRegex) error {
+	self.LocalGitConfig.ObservedRegex = &value
+	self.FullConfig.ObservedRegex = value
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyObservedRegex, value.String())
+}
+
 // SetOffline updates whether Git Town is in offline mode.
 func (self *Config) SetOffline(value configdomain.Offline) error {
 	self.FullConfig.Offline = value
@@ -209,6 +280,13 @@ func (self *Config) SetParkedBranches(branches gitdomain.LocalBranchNames) error
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyParkedBranches, branches.Join(" "))
 }
 
+// SetParkedRegexLocally updates the locally configured parked regex.
+func (self *Config) SetParkedRegexLocally(value configdomain.ParkedRegex) error {
+	self.LocalGitConfig.ParkedRegex = &value
+	self.FullConfig.ParkedRegex = value
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyParkedRegex, value.String())
+}
+
 // SetPerennialBranches marks the given branches as perennial branches.
 func (self *Config) SetPerennialBranches(branches gitdomain.LocalBranchNames) error {
 	self.FullConfig.PerennialBranches = branches
@@ -216,6 +294,13 @@ func (self *Config) SetPerennialBranches(branches gitdomain.LocalBranchNames) er
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyPerennialBranches, branches.Join(" "))
 }
 
+// SetPrototypeBranches marks the given branches as prototype branches.
+func (self *Config) SetPrototypeBranches(branches gitdomain.LocalBranchNames) error {
+	self.FullConfig.PrototypeBranches = branches
+	self.LocalGitConfig.PrototypeBranches = &branches
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyPrototypeBranches, branches.Join(" "))
+}
+
 // SetPushHookLocally updates the locally configured push-hook strategy.
 func (self *Config) SetPerennialRegexLocally(value configdomain.PerennialRegex) error {
 	self.LocalGitConfig.PerennialRegex = &value
@@ -301,27 +386,49 @@ func (self *Config) SetSyncUpstream(value configdomain.SyncUpstream, global bool
 }
 
 func NewConfig(args NewConfigArgs) (*Config, error) {
-	config := configdomain.DefaultConfig()
-	if args.ConfigFile != nil {
-		config.Merge(*args.ConfigFile)
-	}
-	config.Merge(args.GlobalConfig)
-	config.Merge(args.LocalConfig)
+	fullConfig, origins := mergeConfigs(args.ConfigFile, args.UserConfigFile, args.GlobalConfig, args.LocalConfig, args.EnvConfig)
 	return &Config{
 		ConfigFile:      args.ConfigFile,
 		DryRun:          args.DryRun,
-		FullConfig:      config,
+		EnvConfig:       args.EnvConfig,
+		FullConfig:      fullConfig,
 		GitConfig:       gitconfig.Access{Runner: args.Runner},
 		GlobalGitConfig: args.GlobalConfig,
 		LocalGitConfig:  args.LocalConfig,
+		Origins:         origins,
+		UserConfigFile:  args.UserConfigFile,
 		originURLCache:  configdomain.OriginURLCache{},
 	}, nil
 }
 
 type NewConfigArgs struct {
-	ConfigFile   *configdomain.PartialConfig
-	DryRun       bool
-	GlobalConfig configdomain.PartialConfig
-	LocalConfig  configdomain.PartialConfig
-	Runner       gitconfig.Runner
+	ConfigFile     *configdomain.PartialConfig
+	DryRun         bool
+	EnvConfig      configdomain.PartialConfig
+	GlobalConfig   configdomain.PartialConfig
+	LocalConfig    configdomain.PartialConfig
+	Runner         gitconfig.Runner
+	UserConfigFile *configdomain.PartialConfig
+}
+
+// mergeConfigs provides the effective configuration from the given configuration layers
+// and where the values of all settings come from.
+// Later layers override earlier ones: configuration file, user-level configuration file,
+// global Git configuration, local Git configuration, environment variables.
+func mergeConfigs(configFile, userConfigFile *configdomain.PartialConfig, globalConfig, localConfig, envConfig configdomain.PartialConfig) (configdomain.FullConfig, configdomain.ConfigOrigins) {
+	result := configdomain.DefaultConfig()
+	origins := configdomain.ConfigOrigins{}
+	merge := func(source configdomain.ConfigSource, partialConfig *configdomain.PartialConfig) {
+		if partialConfig == nil {
+			return
+		}
+		result.Merge(*partialConfig)
+		origins.Add(source, *partialConfig)
+	}
+	merge(configdomain.ConfigSourceFile, configFile)
+	merge(configdomain.ConfigSourceUser, userConfigFile)
+	merge(configdomain.ConfigSourceGlobal, &globalConfig)
+	merge(configdomain.ConfigSourceLocal, &localConfig)
+	merge(configdomain.ConfigSourceEnv, &envConfig)
+	return result, origins
 }
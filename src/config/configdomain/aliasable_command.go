@@ -7,6 +7,16 @@ func (self AliasableCommand) String() string { return string(self) }
 
 type AliasableCommands []AliasableCommand
 
+// Find provides the command with the given name.
+func (self AliasableCommands) Find(name string) (AliasableCommand, bool) {
+	for _, command := range self {
+		if command.String() == name {
+			return command, true
+		}
+	}
+	return "", false
+}
+
 func (self AliasableCommands) Strings() []string {
 	result := make([]string, len(self))
 	for c, command := range self {
@@ -10,6 +10,15 @@ import (
 func TestAliasableCommand(t *testing.T) {
 	t.Parallel()
 
+	t.Run("Find", func(t *testing.T) {
+		t.Parallel()
+		have, has := configdomain.AllAliasableCommands().Find("diff-parent")
+		must.True(t, has)
+		must.EqOp(t, configdomain.AliasableCommandDiffParent, have)
+		_, has = configdomain.AllAliasableCommands().Find("zonk")
+		must.False(t, has)
+	})
+
 	t.Run("Strings", func(t *testing.T) {
 		t.Parallel()
 		give := configdomain.AliasableCommands{
@@ -0,0 +1,10 @@
+package configdomain
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// BranchPushRemotes contains the remotes that individual branches push to,
+// as configured via Git's "branch.<name>.pushRemote" setting.
+// branch --> the remote it pushes to
+type BranchPushRemotes map[gitdomain.LocalBranchName]gitdomain.Remote
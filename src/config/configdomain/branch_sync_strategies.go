@@ -0,0 +1,18 @@
+package configdomain
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"golang.org/x/exp/maps"
+)
+
+// BranchSyncStrategies contains the sync strategies that individual feature branches use
+// instead of the configured sync-feature-strategy.
+// branch --> its sync strategy
+type BranchSyncStrategies map[gitdomain.LocalBranchName]SyncFeatureStrategy
+
+// BranchNames provides the names of all branches that have a sync strategy override, sorted alphabetically.
+func (self BranchSyncStrategies) BranchNames() gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames(maps.Keys(self))
+	result.Sort()
+	return result
+}
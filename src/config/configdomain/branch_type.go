@@ -1,6 +1,12 @@
 package configdomain
 
-import "github.com/git-town/git-town/v12/src/git/gitdomain"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
 
 type BranchType int
 
@@ -11,6 +17,7 @@ const (
 	BranchTypeParkedBranch
 	BranchTypeContributionBranch
 	BranchTypeObservedBranch
+	BranchTypePrototypeBranch
 )
 
 // ShouldPush indicates whether a branch with this type should push its local commit to origin.
@@ -18,7 +25,7 @@ func (self BranchType) ShouldPush(currentBranch, initialBranch gitdomain.LocalBr
 	switch self {
 	case BranchTypeMainBranch, BranchTypeFeatureBranch, BranchTypePerennialBranch, BranchTypeContributionBranch:
 		return true
-	case BranchTypeObservedBranch:
+	case BranchTypeObservedBranch, BranchTypePrototypeBranch:
 		return false
 	case BranchTypeParkedBranch:
 		return currentBranch == initialBranch
@@ -40,6 +47,31 @@ func (self BranchType) String() string {
 		return "contribution branch"
 	case BranchTypeObservedBranch:
 		return "observed branch"
+	case BranchTypePrototypeBranch:
+		return "prototype branch"
 	}
 	panic("unhandled branch type")
 }
+
+// NewBranchType parses the given user-provided text into a BranchType.
+// Only the types that users can assign to arbitrary branches are allowed.
+func NewBranchType(text string) (BranchType, error) {
+	switch strings.TrimSuffix(strings.ToLower(strings.TrimSpace(text)), " branch") {
+	case "feature", "":
+		return BranchTypeFeatureBranch, nil
+	case "contribution":
+		return BranchTypeContributionBranch, nil
+	case "observed":
+		return BranchTypeObservedBranch, nil
+	case "parked":
+		return BranchTypeParkedBranch, nil
+	case "prototype":
+		return BranchTypePrototypeBranch, nil
+	}
+	return BranchTypeFeatureBranch, fmt.Errorf(messages.ConfigBranchTypeUnknown, text)
+}
+
+func NewBranchTypeRef(text string) (*BranchType, error) {
+	result, err := NewBranchType(text)
+	return &result, err
+}
@@ -0,0 +1,47 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestNewBranchType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid content", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]configdomain.BranchType{
+			"feature":             configdomain.BranchTypeFeatureBranch,
+			"contribution":        configdomain.BranchTypeContributionBranch,
+			"observed":            configdomain.BranchTypeObservedBranch,
+			"parked":              configdomain.BranchTypeParkedBranch,
+			"prototype":           configdomain.BranchTypePrototypeBranch,
+			"Observed":            configdomain.BranchTypeObservedBranch,
+			"prototype branch":    configdomain.BranchTypePrototypeBranch,
+			" contribution ":      configdomain.BranchTypeContributionBranch,
+			"Contribution Branch": configdomain.BranchTypeContributionBranch,
+		}
+		for give, want := range tests {
+			have, err := configdomain.NewBranchType(give)
+			must.NoError(t, err)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("defaults to feature", func(t *testing.T) {
+		t.Parallel()
+		have, err := configdomain.NewBranchType("")
+		must.NoError(t, err)
+		must.EqOp(t, configdomain.BranchTypeFeatureBranch, have)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+		for _, give := range []string{"zonk", "main", "perennial"} {
+			_, err := configdomain.NewBranchType(give)
+			must.Error(t, err)
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package configdomain
+
+// ConfigSource describes a configuration layer that can contain Git Town settings.
+type ConfigSource string
+
+const (
+	ConfigSourceDefault ConfigSource = "default" // the built-in default value of Git Town
+	ConfigSourceEnv     ConfigSource = "env"     // the GIT_TOWN_* environment variables
+	ConfigSourceFile    ConfigSource = "file"    // the configuration file in the repository
+	ConfigSourceGlobal  ConfigSource = "global"  // the global Git configuration
+	ConfigSourceLocal   ConfigSource = "local"   // the local Git configuration of the repository
+	ConfigSourceUser    ConfigSource = "user"    // the user-level configuration file
+)
+
+func (self ConfigSource) String() string {
+	return string(self)
+}
+
+// ConfigOrigin describes the raw value of a setting in one configuration layer.
+type ConfigOrigin struct {
+	Source ConfigSource
+	Value  string
+}
+
+// ConfigOrigins contains the raw values of all settings in all configuration layers.
+// The keys are Git configuration keys, the values are ordered from highest to lowest precedence.
+type ConfigOrigins map[string][]ConfigOrigin
+
+// Add registers the values in the given configuration layer,
+// which overrides all layers added before it.
+func (self ConfigOrigins) Add(source ConfigSource, config PartialConfig) {
+	for key, value := range config.Values {
+		self[key] = append([]ConfigOrigin{{Source: source, Value: value}}, self[key]...)
+	}
+}
@@ -0,0 +1,22 @@
+package configdomain
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// ContributionRegex contains the "branches.contribution-regex" setting.
+type ContributionRegex string
+
+// MatchesBranch indicates whether the given branch matches this ContributionRegex.
+func (self ContributionRegex) MatchesBranch(branch gitdomain.LocalBranchName) bool {
+	return matchesBranch("contribution regex", string(self), branch)
+}
+
+func (self ContributionRegex) String() string {
+	return string(self)
+}
+
+func NewContributionRegexRef(value string) *ContributionRegex {
+	result := ContributionRegex(value)
+	return &result
+}
@@ -8,28 +8,42 @@ import (
 // FullConfig is the merged configuration to be used by Git Town commands.
 type FullConfig struct {
 	Aliases                  Aliases
+	BranchPushRemotes        BranchPushRemotes
+	BranchSyncStrategies     BranchSyncStrategies
 	ContributionBranches     gitdomain.LocalBranchNames
+	ContributionRegex        ContributionRegex
+	DefaultBranchType        BranchType
+	DefaultRemoteBranchType  BranchType
 	GitHubToken              GitHubToken
 	GitLabToken              GitLabToken
 	GitUserEmail             string
 	GitUserName              string
 	GiteaToken               GiteaToken
+	HostingAPIURL            HostingAPIURL
 	HostingOriginHostname    HostingOriginHostname
 	HostingPlatform          HostingPlatform
 	Lineage                  Lineage
 	MainBranch               gitdomain.LocalBranchName
 	ObservedBranches         gitdomain.LocalBranchNames
+	ObservedRegex            ObservedRegex
 	Offline                  Offline
 	ParkedBranches           gitdomain.LocalBranchNames
+	ParkedRegex              ParkedRegex
 	PerennialBranches        gitdomain.LocalBranchNames
 	PerennialRegex           PerennialRegex
+	PrototypeBranches        gitdomain.LocalBranchNames
+	PushDefault              gitdomain.Remote
 	PushHook                 PushHook
 	PushNewBranches          PushNewBranches
+	ShareLineage             ShareLineage
 	ShipDeleteTrackingBranch ShipDeleteTrackingBranch
+	ShipMessageTemplate      ShipMessageTemplate
 	SyncBeforeShip           SyncBeforeShip
 	SyncFeatureStrategy      SyncFeatureStrategy
 	SyncPerennialStrategy    SyncPerennialStrategy
 	SyncUpstream             SyncUpstream
+	TicketRegex              TicketRegex
+	UpstreamURL              string // URL of the "upstream" remote
 }
 
 func (self *FullConfig) BranchType(branch gitdomain.LocalBranchName) BranchType {
@@ -44,6 +58,14 @@ func (self *FullConfig) BranchType(branch gitdomain.LocalBranchName) BranchType
 		return BranchTypeObservedBranch
 	case self.IsParkedBranch(branch):
 		return BranchTypeParkedBranch
+	case self.IsPrototypeBranch(branch):
+		return BranchTypePrototypeBranch
+	case self.ContributionRegex.MatchesBranch(branch):
+		return BranchTypeContributionBranch
+	case self.ObservedRegex.MatchesBranch(branch):
+		return BranchTypeObservedBranch
+	case self.ParkedRegex.MatchesBranch(branch):
+		return BranchTypeParkedBranch
 	}
 	return BranchTypeFeatureBranch
 }
@@ -88,6 +110,10 @@ func (self *FullConfig) IsPerennialBranch(branch gitdomain.LocalBranchName) bool
 	return self.PerennialRegex.MatchesBranch(branch)
 }
 
+func (self *FullConfig) IsPrototypeBranch(branch gitdomain.LocalBranchName) bool {
+	return slice.Contains(self.PrototypeBranches, branch)
+}
+
 func (self *FullConfig) MainAndPerennials() gitdomain.LocalBranchNames {
 	return append(gitdomain.LocalBranchNames{self.MainBranch}, self.PerennialBranches...)
 }
@@ -102,9 +128,31 @@ func (self *FullConfig) Merge(other PartialConfig) {
 			self.Lineage[child] = parent
 		}
 	}
+	if other.BranchPushRemotes != nil {
+		for branch, remote := range *other.BranchPushRemotes {
+			self.BranchPushRemotes[branch] = remote
+		}
+	}
+	if other.BranchSyncStrategies != nil {
+		for branch, strategy := range *other.BranchSyncStrategies {
+			self.BranchSyncStrategies[branch] = strategy
+		}
+	}
 	if other.ContributionBranches != nil {
 		self.ContributionBranches = append(self.ContributionBranches, *other.ContributionBranches...)
 	}
+	if other.ContributionRegex != nil {
+		self.ContributionRegex = *other.ContributionRegex
+	}
+	if other.DefaultBranchType != nil {
+		self.DefaultBranchType = *other.DefaultBranchType
+	}
+	if other.DefaultRemoteBranchType != nil {
+		self.DefaultRemoteBranchType = *other.DefaultRemoteBranchType
+	}
+	if other.HostingAPIURL != nil {
+		self.HostingAPIURL = *other.HostingAPIURL
+	}
 	if other.HostingOriginHostname != nil {
 		self.HostingOriginHostname = *other.HostingOriginHostname
 	}
@@ -135,24 +183,42 @@ func (self *FullConfig) Merge(other PartialConfig) {
 	if other.ObservedBranches != nil {
 		self.ObservedBranches = append(self.ObservedBranches, *other.ObservedBranches...)
 	}
+	if other.ObservedRegex != nil {
+		self.ObservedRegex = *other.ObservedRegex
+	}
 	if other.Offline != nil {
 		self.Offline = *other.Offline
 	}
 	if other.ParkedBranches != nil {
 		self.ParkedBranches = append(self.ParkedBranches, *other.ParkedBranches...)
 	}
+	if other.ParkedRegex != nil {
+		self.ParkedRegex = *other.ParkedRegex
+	}
 	if other.PerennialBranches != nil {
 		self.PerennialBranches = append(self.PerennialBranches, *other.PerennialBranches...)
 	}
 	if other.PerennialRegex != nil {
 		self.PerennialRegex = *other.PerennialRegex
 	}
+	if other.PrototypeBranches != nil {
+		self.PrototypeBranches = append(self.PrototypeBranches, *other.PrototypeBranches...)
+	}
+	if other.PushDefault != nil {
+		self.PushDefault = *other.PushDefault
+	}
 	if other.PushHook != nil {
 		self.PushHook = *other.PushHook
 	}
+	if other.ShareLineage != nil {
+		self.ShareLineage = *other.ShareLineage
+	}
 	if other.ShipDeleteTrackingBranch != nil {
 		self.ShipDeleteTrackingBranch = *other.ShipDeleteTrackingBranch
 	}
+	if other.ShipMessageTemplate != nil {
+		self.ShipMessageTemplate = *other.ShipMessageTemplate
+	}
 	if other.SyncBeforeShip != nil {
 		self.SyncBeforeShip = *other.SyncBeforeShip
 	}
@@ -165,6 +231,12 @@ func (self *FullConfig) Merge(other PartialConfig) {
 	if other.SyncUpstream != nil {
 		self.SyncUpstream = *other.SyncUpstream
 	}
+	if other.TicketRegex != nil {
+		self.TicketRegex = *other.TicketRegex
+	}
+	if other.UpstreamURL != nil {
+		self.UpstreamURL = *other.UpstreamURL
+	}
 }
 
 func (self *FullConfig) NoPushHook() NoPushHook {
@@ -175,35 +247,69 @@ func (self *FullConfig) Online() Online {
 	return self.Offline.ToOnline()
 }
 
+// PushRemote provides the remote that the branch with the given name pushes to.
+// This honors Git's "branch.<name>.pushRemote" and "remote.pushDefault" settings and defaults to the origin remote.
+func (self *FullConfig) PushRemote(branch gitdomain.LocalBranchName) gitdomain.Remote {
+	if remote, has := self.BranchPushRemotes[branch]; has {
+		return remote
+	}
+	if !self.PushDefault.IsEmpty() {
+		return self.PushDefault
+	}
+	return gitdomain.OriginRemote
+}
+
 func (self *FullConfig) ShouldPushNewBranches() bool {
 	return self.PushNewBranches.Bool()
 }
 
+// SyncFeatureStrategyFor provides the sync strategy to use for the feature branch with the given name.
+func (self *FullConfig) SyncFeatureStrategyFor(branch gitdomain.LocalBranchName) SyncFeatureStrategy {
+	if strategy, has := self.BranchSyncStrategies[branch]; has {
+		return strategy
+	}
+	return self.SyncFeatureStrategy
+}
+
 // DefaultConfig provides the default configuration data to use when nothing is configured.
 func DefaultConfig() FullConfig {
 	return FullConfig{
 		Aliases:                  Aliases{},
+		BranchPushRemotes:        BranchPushRemotes{},
+		BranchSyncStrategies:     BranchSyncStrategies{},
 		ContributionBranches:     gitdomain.NewLocalBranchNames(),
+		ContributionRegex:        "",
+		DefaultBranchType:        BranchTypeFeatureBranch,
+		DefaultRemoteBranchType:  BranchTypeFeatureBranch,
 		GitHubToken:              "",
 		GitLabToken:              "",
 		GitUserEmail:             "",
 		GitUserName:              "",
 		GiteaToken:               "",
+		HostingAPIURL:            "",
 		HostingOriginHostname:    "",
 		HostingPlatform:          HostingPlatformNone,
 		Lineage:                  Lineage{},
 		MainBranch:               gitdomain.EmptyLocalBranchName(),
 		ObservedBranches:         gitdomain.NewLocalBranchNames(),
+		ObservedRegex:            "",
 		Offline:                  false,
 		ParkedBranches:           gitdomain.NewLocalBranchNames(),
+		ParkedRegex:              "",
 		PerennialBranches:        gitdomain.NewLocalBranchNames(),
 		PerennialRegex:           "",
+		PrototypeBranches:        gitdomain.NewLocalBranchNames(),
+		PushDefault:              gitdomain.NoRemote,
 		PushHook:                 true,
 		PushNewBranches:          false,
+		ShareLineage:             false,
 		ShipDeleteTrackingBranch: true,
+		ShipMessageTemplate:      "",
 		SyncBeforeShip:           false,
 		SyncFeatureStrategy:      SyncFeatureStrategyMerge,
 		SyncPerennialStrategy:    SyncPerennialStrategyRebase,
 		SyncUpstream:             true,
+		TicketRegex:              "",
+		UpstreamURL:              "",
 	}
 }
@@ -12,6 +12,46 @@ import (
 func TestFullConfig(t *testing.T) {
 	t.Parallel()
 
+	t.Run("BranchType", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			MainBranch:        gitdomain.NewLocalBranchName("main"),
+			PerennialBranches: gitdomain.NewLocalBranchNames("perennial"),
+			PrototypeBranches: gitdomain.NewLocalBranchNames("prototype"),
+		}
+		must.EqOp(t, configdomain.BranchTypeMainBranch, config.BranchType(gitdomain.NewLocalBranchName("main")))
+		must.EqOp(t, configdomain.BranchTypePerennialBranch, config.BranchType(gitdomain.NewLocalBranchName("perennial")))
+		must.EqOp(t, configdomain.BranchTypePrototypeBranch, config.BranchType(gitdomain.NewLocalBranchName("prototype")))
+		must.EqOp(t, configdomain.BranchTypeFeatureBranch, config.BranchType(gitdomain.NewLocalBranchName("feature")))
+	})
+
+	t.Run("BranchType with regexes", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			ContributionRegex: "^release-",
+			MainBranch:        gitdomain.NewLocalBranchName("main"),
+			ObservedBranches:  gitdomain.NewLocalBranchNames("release-observed"),
+			ObservedRegex:     "^(renovate|dependabot)/",
+			ParkedBranches:    gitdomain.NewLocalBranchNames("renovate/parked"),
+			ParkedRegex:       "^old-",
+			PerennialRegex:    "^staging",
+		}
+		tests := map[string]configdomain.BranchType{
+			"dependabot/foo":   configdomain.BranchTypeObservedBranch,
+			"feature":          configdomain.BranchTypeFeatureBranch,
+			"old-feature":      configdomain.BranchTypeParkedBranch,
+			"release-1":        configdomain.BranchTypeContributionBranch,
+			"release-observed": configdomain.BranchTypeObservedBranch,
+			"renovate/foo":     configdomain.BranchTypeObservedBranch,
+			"renovate/parked":  configdomain.BranchTypeParkedBranch,
+			"staging":          configdomain.BranchTypePerennialBranch,
+		}
+		for give, want := range tests {
+			have := config.BranchType(gitdomain.NewLocalBranchName(give))
+			must.EqOp(t, want, have)
+		}
+	})
+
 	t.Run("IsMainOrPerennialBranch", func(t *testing.T) {
 		t.Parallel()
 		config := configdomain.FullConfig{ //nolint:exhaustruct
@@ -82,4 +122,46 @@ func TestFullConfig(t *testing.T) {
 		want := gitdomain.NewLocalBranchNames("main", "perennial-1", "perennial-2")
 		must.Eq(t, want, have)
 	})
+
+	t.Run("PushRemote", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch push remote", func(t *testing.T) {
+			t.Parallel()
+			config := configdomain.FullConfig{ //nolint:exhaustruct
+				BranchPushRemotes: configdomain.BranchPushRemotes{
+					gitdomain.NewLocalBranchName("feature"): gitdomain.NewRemote("fork"),
+				},
+				PushDefault: gitdomain.NewRemote("other"),
+			}
+			must.EqOp(t, gitdomain.NewRemote("fork"), config.PushRemote(gitdomain.NewLocalBranchName("feature")))
+		})
+		t.Run("push default", func(t *testing.T) {
+			t.Parallel()
+			config := configdomain.FullConfig{ //nolint:exhaustruct
+				BranchPushRemotes: configdomain.BranchPushRemotes{},
+				PushDefault:       gitdomain.NewRemote("fork"),
+			}
+			must.EqOp(t, gitdomain.NewRemote("fork"), config.PushRemote(gitdomain.NewLocalBranchName("feature")))
+		})
+		t.Run("no settings", func(t *testing.T) {
+			t.Parallel()
+			config := configdomain.FullConfig{ //nolint:exhaustruct
+				BranchPushRemotes: configdomain.BranchPushRemotes{},
+				PushDefault:       gitdomain.NoRemote,
+			}
+			must.EqOp(t, gitdomain.OriginRemote, config.PushRemote(gitdomain.NewLocalBranchName("feature")))
+		})
+	})
+
+	t.Run("SyncFeatureStrategyFor", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			BranchSyncStrategies: configdomain.BranchSyncStrategies{
+				gitdomain.NewLocalBranchName("shared"): configdomain.SyncFeatureStrategyMerge,
+			},
+			SyncFeatureStrategy: configdomain.SyncFeatureStrategyRebase,
+		}
+		must.EqOp(t, configdomain.SyncFeatureStrategyMerge, config.SyncFeatureStrategyFor(gitdomain.NewLocalBranchName("shared")))
+		must.EqOp(t, configdomain.SyncFeatureStrategyRebase, config.SyncFeatureStrategyFor(gitdomain.NewLocalBranchName("personal")))
+	})
 }
@@ -0,0 +1,14 @@
+package configdomain
+
+// HostingAPIURL is the base URL of the API of the code hosting platform,
+// for self-hosted instances whose API doesn't run on the hostname of the origin remote.
+type HostingAPIURL string
+
+func (self HostingAPIURL) String() string {
+	return string(self)
+}
+
+func NewHostingAPIURLRef(value string) *HostingAPIURL {
+	url := HostingAPIURL(value)
+	return &url
+}
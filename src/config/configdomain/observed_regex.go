@@ -0,0 +1,22 @@
+package configdomain
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// ObservedRegex contains the "branches.observed-regex" setting.
+type ObservedRegex string
+
+// MatchesBranch indicates whether the given branch matches this ObservedRegex.
+func (self ObservedRegex) MatchesBranch(branch gitdomain.LocalBranchName) bool {
+	return matchesBranch("observed regex", string(self), branch)
+}
+
+func (self ObservedRegex) String() string {
+	return string(self)
+}
+
+func NewObservedRegexRef(value string) *ObservedRegex {
+	result := ObservedRegex(value)
+	return &result
+}
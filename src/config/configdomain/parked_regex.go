@@ -0,0 +1,22 @@
+package configdomain
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// ParkedRegex contains the "branches.parked-regex" setting.
+type ParkedRegex string
+
+// MatchesBranch indicates whether the given branch matches this ParkedRegex.
+func (self ParkedRegex) MatchesBranch(branch gitdomain.LocalBranchName) bool {
+	return matchesBranch("parked regex", string(self), branch)
+}
+
+func (self ParkedRegex) String() string {
+	return string(self)
+}
+
+func NewParkedRegexRef(value string) *ParkedRegex {
+	result := ParkedRegex(value)
+	return &result
+}
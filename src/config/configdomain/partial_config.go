@@ -7,32 +7,48 @@ import (
 // PartialConfig contains configuration data as it is stored in the local or global Git configuration.
 type PartialConfig struct {
 	Aliases                  Aliases
+	BranchPushRemotes        *BranchPushRemotes
+	BranchSyncStrategies     *BranchSyncStrategies
 	ContributionBranches     *gitdomain.LocalBranchNames
+	ContributionRegex        *ContributionRegex
+	DefaultBranchType        *BranchType
+	DefaultRemoteBranchType  *BranchType
 	GitHubToken              *GitHubToken
 	GitLabToken              *GitLabToken
 	GitUserEmail             *string
 	GitUserName              *string
 	GiteaToken               *GiteaToken
+	HostingAPIURL            *HostingAPIURL
 	HostingOriginHostname    *HostingOriginHostname
 	HostingPlatform          *HostingPlatform
 	Lineage                  *Lineage
 	MainBranch               *gitdomain.LocalBranchName
 	ObservedBranches         *gitdomain.LocalBranchNames
+	ObservedRegex            *ObservedRegex
 	Offline                  *Offline
 	ParkedBranches           *gitdomain.LocalBranchNames
+	ParkedRegex              *ParkedRegex
 	PerennialBranches        *gitdomain.LocalBranchNames
 	PerennialRegex           *PerennialRegex
+	PrototypeBranches        *gitdomain.LocalBranchNames
+	PushDefault              *gitdomain.Remote
 	PushHook                 *PushHook
 	PushNewBranches          *PushNewBranches
+	ShareLineage             *ShareLineage
 	ShipDeleteTrackingBranch *ShipDeleteTrackingBranch
+	ShipMessageTemplate      *ShipMessageTemplate
 	SyncBeforeShip           *SyncBeforeShip
 	SyncFeatureStrategy      *SyncFeatureStrategy
 	SyncPerennialStrategy    *SyncPerennialStrategy
 	SyncUpstream             *SyncUpstream
+	TicketRegex              *TicketRegex
+	UpstreamURL              *string
+	Values                   map[string]string // raw values of all settings in this configuration layer, by Git configuration key
 }
 
 func EmptyPartialConfig() PartialConfig {
 	return PartialConfig{ //nolint:exhaustruct
 		Aliases: Aliases{},
+		Values:  map[string]string{},
 	}
 }
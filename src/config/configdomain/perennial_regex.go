@@ -1,10 +1,6 @@
 package configdomain
 
 import (
-	"fmt"
-	"regexp"
-
-	"github.com/git-town/git-town/v12/src/cli/dialog/components"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 )
 
@@ -13,15 +9,7 @@ type PerennialRegex string
 
 // MatchesBranch indicates whether the given branch matches this PerennialRegex.
 func (self PerennialRegex) MatchesBranch(branch gitdomain.LocalBranchName) bool {
-	if self == "" {
-		return false
-	}
-	re, err := regexp.Compile(string(self))
-	if err != nil {
-		fmt.Println(components.Red().Styled(fmt.Sprintf("Error in perennial regex %q: %s", self, err.Error())))
-		return false
-	}
-	return re.MatchString(branch.String())
+	return matchesBranch("perennial regex", string(self), branch)
 }
 
 func (self PerennialRegex) String() string {
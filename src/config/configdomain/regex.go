@@ -0,0 +1,23 @@
+package configdomain
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// matchesBranch indicates whether the given branch matches the given regex.
+// Invalid regexes print an error mentioning the given setting name and match nothing.
+func matchesBranch(setting, regex string, branch gitdomain.LocalBranchName) bool {
+	if regex == "" {
+		return false
+	}
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		fmt.Println(components.Red().Styled(fmt.Sprintf("Error in %s %q: %s", setting, regex, err.Error())))
+		return false
+	}
+	return re.MatchString(branch.String())
+}
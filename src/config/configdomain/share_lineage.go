@@ -0,0 +1,39 @@
+package configdomain
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/git-town/git-town/v12/src/gohacks"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// ShareLineage contains the configuration setting whether to share the lineage with teammates
+// via the "refs/git-town/lineage" ref at the origin remote.
+type ShareLineage bool
+
+func (self ShareLineage) Bool() bool {
+	return bool(self)
+}
+
+func (self ShareLineage) String() string {
+	return strconv.FormatBool(self.Bool())
+}
+
+func NewShareLineageRef(value bool) *ShareLineage {
+	result := ShareLineage(value)
+	return &result
+}
+
+func ParseShareLineage(value, source string) (ShareLineage, error) {
+	parsed, err := gohacks.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf(messages.ValueInvalid, source, value)
+	}
+	return ShareLineage(parsed), nil
+}
+
+func ParseShareLineageRef(value, source string) (*ShareLineage, error) {
+	result, err := ParseShareLineage(value, source)
+	return &result, err
+}
@@ -0,0 +1,14 @@
+package configdomain
+
+// ShipMessageTemplate contains the "ship-message-template" setting:
+// the template for the commit message of the squash commits that "git town ship" creates.
+type ShipMessageTemplate string
+
+func (self ShipMessageTemplate) String() string {
+	return string(self)
+}
+
+func NewShipMessageTemplateRef(value string) *ShipMessageTemplate {
+	result := ShipMessageTemplate(value)
+	return &result
+}
@@ -0,0 +1,47 @@
+package configdomain
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// TicketRegex contains the "ticket-regex" setting:
+// a regular expression that matches the IDs of tickets in the issue tracker.
+// If the regex contains a capture group, the ticket ID is the content of the first capture group.
+type TicketRegex string
+
+func (self TicketRegex) String() string {
+	return string(self)
+}
+
+// Tickets provides the ticket IDs that this TicketRegex finds in the given texts, in order and without duplicates.
+func (self TicketRegex) Tickets(texts ...string) ([]string, error) {
+	result := []string{}
+	if self == "" {
+		return result, nil
+	}
+	re, err := regexp.Compile(string(self))
+	if err != nil {
+		return result, fmt.Errorf(messages.SettingRegexInvalid, "ticket-regex", self, err)
+	}
+	for _, text := range texts {
+		for _, match := range re.FindAllStringSubmatch(text, -1) {
+			ticket := match[0]
+			if len(match) > 1 {
+				ticket = match[1]
+			}
+			if ticket != "" && !slice.Contains(result, ticket) {
+				result = append(result, ticket)
+			}
+		}
+	}
+	return result, nil
+}
+
+func NewTicketRegexRef(value string) *TicketRegex {
+	result := TicketRegex(value)
+	return &result
+}
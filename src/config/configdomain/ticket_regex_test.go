@@ -0,0 +1,49 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestTicketRegex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Tickets", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("finds all tickets in the given texts without duplicates", func(t *testing.T) {
+			t.Parallel()
+			regex := configdomain.TicketRegex(`[A-Z]+-\d+`)
+			have, err := regex.Tickets("kg/ABC-123-fix-login", "ABC-123: fix login, see also XYZ-7")
+			want := []string{"ABC-123", "XYZ-7"}
+			must.NoError(t, err)
+			must.Eq(t, want, have)
+		})
+
+		t.Run("uses the first capture group", func(t *testing.T) {
+			t.Parallel()
+			regex := configdomain.TicketRegex(`#(\d+)`)
+			have, err := regex.Tickets("fixes #12 and #34")
+			want := []string{"12", "34"}
+			must.NoError(t, err)
+			must.Eq(t, want, have)
+		})
+
+		t.Run("empty regex", func(t *testing.T) {
+			t.Parallel()
+			regex := configdomain.TicketRegex("")
+			have, err := regex.Tickets("ABC-123")
+			must.NoError(t, err)
+			must.Len(t, 0, have)
+		})
+
+		t.Run("invalid regex", func(t *testing.T) {
+			t.Parallel()
+			regex := configdomain.TicketRegex("(")
+			_, err := regex.Tickets("ABC-123")
+			must.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,273 @@
+package configfile
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/git-town/git-town/v12/src/messages"
+	"golang.org/x/exp/maps"
+)
+
+// Problem describes an error in the configuration file.
+type Problem struct {
+	Column     int    // column of the problem, starting at 1, or 0 if unknown
+	File       string // name of the configuration file that contains the problem
+	Line       int    // line of the problem, starting at 1, or 0 if unknown
+	Message    string
+	UnknownKey bool // whether this problem is a key that Git Town doesn't know
+}
+
+func (self Problem) String() string {
+	if self.Line == 0 {
+		return fmt.Sprintf("%s: %s", self.File, self.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", self.File, self.Line, self.Column, self.Message)
+}
+
+// Problems is a non-empty list of problems in a configuration file. It can be used as an error.
+type Problems []Problem
+
+func (self Problems) Error() string {
+	return fmt.Sprintf(messages.ConfigFileInvalid, self[0].File, len(self), self.List())
+}
+
+// List provides the given problems as an indented list.
+func (self Problems) List() string {
+	lines := make([]string, len(self))
+	for p, problem := range self {
+		lines[p] = "  " + problem.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Check provides all problems in the given content of the configuration file with the given name,
+// in the order in which the affected keys appear in the file.
+func Check(fileName, text string) Problems {
+	var content map[string]any
+	metaData, err := toml.Decode(text, &content)
+	if err != nil {
+		var parseErr toml.ParseError
+		if errors.As(err, &parseErr) {
+			line, column := position(text, parseErr.Position.Start)
+			return Problems{{
+				Column:     column,
+				File:       fileName,
+				Line:       line,
+				Message:    parseErrorPrefixRE.ReplaceAllString(parseErr.Error(), ""),
+				UnknownKey: false,
+			}}
+		}
+		return Problems{{Column: 0, File: fileName, Line: 0, Message: err.Error(), UnknownKey: false}}
+	}
+	checker := checker{
+		fileName:     fileName,
+		mistypedKeys: []string{},
+		problems:     []keyProblem{},
+	}
+	checker.checkTable("", content)
+	checker.checkUnknownKeys(content)
+	order := map[string]int{}
+	for k, key := range metaData.Keys() {
+		order[strings.Join(key, ".")] = k
+	}
+	slices.SortStableFunc(checker.problems, func(a, b keyProblem) int {
+		return cmp.Compare(order[a.key], order[b.key])
+	})
+	result := make(Problems, len(checker.problems))
+	for p, problem := range checker.problems {
+		result[p] = problem.problem
+	}
+	return result
+}
+
+// checker collects the problems in the content of a configuration file.
+type checker struct {
+	fileName     string
+	mistypedKeys []string // keys whose values have the wrong type
+	problems     []keyProblem
+}
+
+// keyProblem is a Problem with the key that causes it.
+type keyProblem struct {
+	key     string
+	problem Problem
+}
+
+func (self *checker) add(key string, unknownKey bool, format string, args ...any) {
+	self.problems = append(self.problems, keyProblem{
+		key: key,
+		problem: Problem{
+			Column:     0,
+			File:       self.fileName,
+			Line:       0,
+			Message:    fmt.Sprintf(format, args...),
+			UnknownKey: unknownKey,
+		},
+	})
+}
+
+func (self *checker) checkField(field Field, value any) {
+	switch field.Type {
+	case FieldTypeBool:
+		if _, isBool := value.(bool); !isBool {
+			self.addMistyped(field.Path, field.Type, value)
+		}
+	case FieldTypeString:
+		text, isString := value.(string)
+		if !isString {
+			self.addMistyped(field.Path, field.Type, value)
+			return
+		}
+		if field.Check != nil {
+			if err := field.Check(text); err != nil {
+				self.add(field.Path, false, messages.ConfigFileValueInvalid, field.Path, err)
+			}
+		}
+	case FieldTypeStringList:
+		list, isList := value.([]any)
+		if !isList {
+			self.addMistyped(field.Path, field.Type, value)
+			return
+		}
+		for _, element := range list {
+			if _, isString := element.(string); !isString {
+				self.mistypedKeys = append(self.mistypedKeys, field.Path)
+				self.add(field.Path, false, messages.ConfigFileTypeMismatch, field.Path, field.Type, "list containing "+tomlType(element))
+				return
+			}
+		}
+	case FieldTypeStringMap:
+		table, isTable := value.(map[string]any)
+		if !isTable {
+			self.addMistyped(field.Path, "table", value)
+			return
+		}
+		for _, key := range sortedKeys(table) {
+			path := field.Path + "." + key
+			if !slices.Contains(field.Enum, key) {
+				self.add(path, true, messages.ConfigFileKeyUnknown, path)
+				continue
+			}
+			if _, isString := table[key].(string); !isString {
+				self.add(path, false, messages.ConfigFileTypeMismatch, path, FieldTypeString, tomlType(table[key]))
+			}
+		}
+	}
+}
+
+func (self *checker) checkTable(prefix string, table map[string]any) {
+	for _, key := range sortedKeys(table) {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if field, isField := LookupField(path); isField {
+			self.checkField(field, table[key])
+			continue
+		}
+		if isSection(path) {
+			subTable, isTable := table[key].(map[string]any)
+			if !isTable {
+				self.addMistyped(path, "table", table[key])
+				continue
+			}
+			self.checkTable(path, subTable)
+		}
+		// checkUnknownKeys reports all other keys
+	}
+}
+
+// addMistyped registers that the key with the given path has a value of the wrong type.
+func (self *checker) addMistyped(path string, want FieldType, value any) {
+	self.mistypedKeys = append(self.mistypedKeys, path)
+	self.add(path, false, messages.ConfigFileTypeMismatch, path, want, tomlType(value))
+}
+
+// checkUnknownKeys reports the keys in the given content that Git Town doesn't know.
+// It decodes the content without the mistyped keys into Data and uses the keys that the TOML decoder didn't use.
+func (self *checker) checkUnknownKeys(content map[string]any) {
+	for _, path := range self.mistypedKeys {
+		removeKey(content, strings.Split(path, "."))
+	}
+	var buffer bytes.Buffer
+	if err := toml.NewEncoder(&buffer).Encode(content); err != nil {
+		return
+	}
+	var data Data
+	metaData, err := toml.Decode(buffer.String(), &data)
+	if err != nil {
+		return
+	}
+	undecoded := map[string]bool{}
+	for _, key := range metaData.Undecoded() {
+		path := key.String()
+		undecoded[path] = true
+		if parent := key[:len(key)-1]; len(parent) > 0 && undecoded[parent.String()] {
+			continue
+		}
+		self.add(path, true, messages.ConfigFileKeyUnknown, path)
+	}
+}
+
+// removeKey removes the entry with the given path from the given table.
+func removeKey(table map[string]any, path []string) {
+	if len(path) == 1 {
+		delete(table, path[0])
+		return
+	}
+	if subTable, isTable := table[path[0]].(map[string]any); isTable {
+		removeKey(subTable, path[1:])
+	}
+}
+
+// position provides the 1-based line and column of the given byte offset in the given text.
+func position(text string, offset int) (line, column int) { //nolint:nonamedreturns
+	if offset > len(text) {
+		offset = len(text)
+	}
+	before := text[:offset]
+	return strings.Count(before, "\n") + 1, offset - strings.LastIndex(before, "\n")
+}
+
+// isSection indicates whether the given path is a table that contains fields.
+func isSection(path string) bool {
+	for _, field := range Fields() {
+		if strings.HasPrefix(field.Path, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+var parseErrorPrefixRE = regexp.MustCompile(`^toml: line \d+( \(last key "[^"]*"\))?: `) //nolint:gochecknoglobals
+
+func sortedKeys(table map[string]any) []string {
+	result := maps.Keys(table)
+	slices.Sort(result)
+	return result
+}
+
+// tomlType provides the name of the TOML type of the given decoded value.
+func tomlType(value any) string {
+	switch value.(type) {
+	case bool:
+		return string(FieldTypeBool)
+	case string:
+		return string(FieldTypeString)
+	case int64:
+		return "integer"
+	case float64:
+		return "float"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "table"
+	}
+	return "datetime"
+}
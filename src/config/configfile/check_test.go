@@ -0,0 +1,163 @@
+package configfile_test
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/shoenig/test/must"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid content", func(t *testing.T) {
+		t.Parallel()
+		give := `
+push-hook = true
+branches.main = "main"
+
+[aliases]
+sync = "town sync"
+
+[branches]
+perennials = [ "qa" ]
+default-type = "Prototype"
+
+[sync-strategy]
+feature-branches = "rebase"
+`[1:]
+		must.SliceEmpty(t, configfile.Check(configfile.FileName, give))
+	})
+
+	t.Run("invalid content", func(t *testing.T) {
+		t.Parallel()
+		give := `
+push-hook = "yes"
+zonk = 1
+
+[branches]
+main = "main"
+  perennials = [ "qa", 1 ]
+parked-regex = "old-("
+
+[sync-strategy]
+feature-branches = "squash"
+
+[aliases]
+zonk = "town zonk"
+`[1:]
+		have := configfile.Check(configfile.FileName, give)
+		want := configfile.Problems{
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `"push-hook" must be of type boolean, not string`, UnknownKey: false},
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `unknown key "zonk"`, UnknownKey: true},
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `"branches.perennials" must be of type string list, not list containing integer`, UnknownKey: false},
+			{Column: 0, File: configfile.FileName, Line: 0, Message: "invalid value for \"branches.parked-regex\": error parsing regexp: missing closing ): `old-(`", UnknownKey: false},
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `invalid value for "sync-strategy.feature-branches": unknown sync-feature strategy: "squash"`, UnknownKey: false},
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `unknown key "aliases.zonk"`, UnknownKey: true},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("unknown table", func(t *testing.T) {
+		t.Parallel()
+		give := `
+[zonk]
+foo = "bar"
+`[1:]
+		want := configfile.Problems{
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `unknown key "zonk"`, UnknownKey: true},
+		}
+		must.Eq(t, want, configfile.Check(configfile.FileName, give))
+	})
+
+	t.Run("unknown keys in known tables and tables with the wrong type", func(t *testing.T) {
+		t.Parallel()
+		give := `
+sync-strategy = "rebase"
+
+[branches]
+mian = "main"
+
+[hosting.github]
+token = "secret"
+`[1:]
+		want := configfile.Problems{
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `"sync-strategy" must be of type table, not string`, UnknownKey: false},
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `unknown key "branches.mian"`, UnknownKey: true},
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `unknown key "hosting.github"`, UnknownKey: true},
+		}
+		must.Eq(t, want, configfile.Check(configfile.FileName, give))
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		t.Parallel()
+		give := `
+push-hook = true
+sync-upstream =
+`[1:]
+		want := configfile.Problems{
+			{Column: 16, File: configfile.FileName, Line: 2, Message: `expected value but found '\n' instead`, UnknownKey: false},
+		}
+		must.Eq(t, want, configfile.Check(configfile.FileName, give))
+	})
+
+	t.Run("Problems.Error", func(t *testing.T) {
+		t.Parallel()
+		give := configfile.Problems{
+			{Column: 16, File: configfile.FileName, Line: 2, Message: `expected value but found '\n' instead`, UnknownKey: false},
+			{Column: 0, File: configfile.FileName, Line: 0, Message: `unknown key "zonk"`, UnknownKey: true},
+		}
+		want := "the configuration file .git-branches.toml contains 2 problem(s):\n  .git-branches.toml:2:16: expected value but found '\\n' instead\n  .git-branches.toml: unknown key \"zonk\""
+		must.EqOp(t, want, give.Error())
+	})
+
+	t.Run("JSONSchema", func(t *testing.T) {
+		t.Parallel()
+		t.Run("published schema is up to date", func(t *testing.T) {
+			t.Parallel()
+			have, err := os.ReadFile("../../../website/src/git-branches.schema.json")
+			must.NoError(t, err)
+			want, err := configfile.JSONSchema()
+			must.NoError(t, err)
+			must.EqOp(t, want, string(have), must.Sprint(`please run "git town config validate --schema > website/src/git-branches.schema.json"`))
+		})
+		t.Run("describes all fields", func(t *testing.T) {
+			t.Parallel()
+			schema, err := configfile.JSONSchema()
+			must.NoError(t, err)
+			for _, field := range configfile.Fields() {
+				segments := strings.Split(field.Path, ".")
+				must.StrContains(t, schema, fmt.Sprintf("%q: {", segments[len(segments)-1]))
+			}
+		})
+	})
+
+	t.Run("Fields cover all entries of Data", func(t *testing.T) {
+		t.Parallel()
+		paths := tomlPaths("", reflect.TypeOf(configfile.Data{})) //nolint:exhaustruct
+		must.Eq(t, len(paths), len(configfile.Fields()))
+		for _, path := range paths {
+			_, has := configfile.LookupField(path)
+			must.True(t, has, must.Sprint(path))
+		}
+	})
+}
+
+// tomlPaths provides the dotted TOML paths of all entries in the given struct type.
+func tomlPaths(prefix string, structType reflect.Type) []string {
+	result := []string{}
+	for f := 0; f < structType.NumField(); f++ {
+		field := structType.Field(f)
+		path := prefix + field.Tag.Get("toml")
+		if field.Type.Kind() == reflect.Pointer && field.Type.Elem().Kind() == reflect.Struct {
+			result = append(result, tomlPaths(path+".", field.Type.Elem())...)
+			continue
+		}
+		result = append(result, path)
+	}
+	return result
+}
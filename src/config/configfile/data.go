@@ -2,33 +2,51 @@ package configfile
 
 // Data defines the Go equivalent of the TOML file content.
 type Data struct {
-	Branches                 *Branches     `toml:"branches"`
-	Hosting                  *Hosting      `toml:"hosting"`
-	PushHook                 *bool         `toml:"push-hook"`
-	PushNewbranches          *bool         `toml:"push-new-branches"`
-	ShipDeleteTrackingBranch *bool         `toml:"ship-delete-tracking-branch"`
-	SyncBeforeShip           *bool         `toml:"sync-before-ship"`
-	SyncStrategy             *SyncStrategy `toml:"sync-strategy"`
-	SyncUpstream             *bool         `toml:"sync-upstream"`
+	Aliases                  map[string]string `toml:"aliases"`
+	Branches                 *Branches         `toml:"branches"`
+	Hosting                  *Hosting          `toml:"hosting"`
+	Offline                  *bool             `toml:"offline"`
+	PushHook                 *bool             `toml:"push-hook"`
+	PushNewbranches          *bool             `toml:"push-new-branches"`
+	ShareLineage             *bool             `toml:"share-lineage"`
+	ShipDeleteTrackingBranch *bool             `toml:"ship-delete-tracking-branch"`
+	ShipMessageTemplate      *string           `toml:"ship-message-template"`
+	SyncBeforeShip           *bool             `toml:"sync-before-ship"`
+	SyncStrategy             *SyncStrategy     `toml:"sync-strategy"`
+	SyncUpstream             *bool             `toml:"sync-upstream"`
+	TicketRegex              *string           `toml:"ticket-regex"`
 }
 
 type Branches struct {
-	Main           *string  `toml:"main"`
-	Perennials     []string `toml:"perennials"`
-	PerennialRegex *string  `toml:"perennial-regex"`
+	ContributionRegex *string  `toml:"contribution-regex"`
+	DefaultRemoteType *string  `toml:"default-remote-type"`
+	DefaultType       *string  `toml:"default-type"`
+	Main              *string  `toml:"main"`
+	ObservedRegex     *string  `toml:"observed-regex"`
+	ParkedRegex       *string  `toml:"parked-regex"`
+	Perennials        []string `toml:"perennials"`
+	PerennialRegex    *string  `toml:"perennial-regex"`
 }
 
 func (self Branches) IsEmpty() bool {
-	return self.Main == nil && len(self.Perennials) == 0
+	return self.ContributionRegex == nil &&
+		self.DefaultRemoteType == nil &&
+		self.DefaultType == nil &&
+		self.Main == nil &&
+		self.ObservedRegex == nil &&
+		self.ParkedRegex == nil &&
+		len(self.Perennials) == 0 &&
+		self.PerennialRegex == nil
 }
 
 type Hosting struct {
+	APIURL         *string `toml:"api-url"`
 	OriginHostname *string `toml:"origin-hostname"`
 	Platform       *string `toml:"platform"`
 }
 
 func (self Hosting) IsEmpty() bool {
-	return self.Platform == nil && self.OriginHostname == nil
+	return self.APIURL == nil && self.OriginHostname == nil && self.Platform == nil
 }
 
 type SyncStrategy struct {
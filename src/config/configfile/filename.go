@@ -1,3 +1,25 @@
 package configfile
 
+import (
+	"os"
+	"path/filepath"
+)
+
 const FileName = ".git-branches.toml"
+
+// UserFilePath provides the location of the user-level configuration file,
+// which contains personal defaults for all repositories.
+// This is $XDG_CONFIG_HOME/git-town/config.toml, or ~/.config/git-town/config.toml
+// if XDG_CONFIG_HOME is not set to an absolute path.
+// Returns an empty string if the home directory is unknown.
+func UserFilePath() string {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if !filepath.IsAbs(configDir) {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		configDir = filepath.Join(homeDir, ".config")
+	}
+	return filepath.Join(configDir, "git-town", "config.toml")
+}
@@ -0,0 +1,66 @@
+package configfile
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// JSONSchemaURL is the location where the JSON Schema for the configuration file is published.
+const JSONSchemaURL = "https://www.git-town.com/git-branches.schema.json"
+
+// JSONSchema provides a JSON Schema describing the configuration file,
+// which editors can use to validate and auto-complete it.
+func JSONSchema() (string, error) {
+	root := newSchemaObject()
+	root["$schema"] = "http://json-schema.org/draft-07/schema#"
+	root["$id"] = JSONSchemaURL
+	root["title"] = "Git Town configuration file (" + FileName + ")"
+	for _, field := range Fields() {
+		parent := root
+		segments := strings.Split(field.Path, ".")
+		for _, segment := range segments[:len(segments)-1] {
+			properties := parent["properties"].(map[string]any) //nolint:forcetypeassert
+			child, has := properties[segment].(map[string]any)
+			if !has {
+				child = newSchemaObject()
+				properties[segment] = child
+			}
+			parent = child
+		}
+		properties := parent["properties"].(map[string]any) //nolint:forcetypeassert
+		properties[segments[len(segments)-1]] = fieldSchema(field)
+	}
+	bytes, err := json.MarshalIndent(root, "", "  ")
+	return string(bytes) + "\n", err
+}
+
+func fieldSchema(field Field) map[string]any {
+	result := map[string]any{
+		"description": field.Description,
+	}
+	switch field.Type {
+	case FieldTypeBool:
+		result["type"] = "boolean"
+	case FieldTypeString:
+		result["type"] = "string"
+		if len(field.Enum) > 0 {
+			result["enum"] = field.Enum
+		}
+	case FieldTypeStringList:
+		result["type"] = "array"
+		result["items"] = map[string]any{"type": "string"}
+	case FieldTypeStringMap:
+		result["type"] = "object"
+		result["propertyNames"] = map[string]any{"enum": field.Enum}
+		result["additionalProperties"] = map[string]any{"type": "string"}
+	}
+	return result
+}
+
+func newSchemaObject() map[string]any {
+	return map[string]any{
+		"additionalProperties": false,
+		"properties":           map[string]any{},
+		"type":                 "object",
+	}
+}
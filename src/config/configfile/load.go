@@ -7,6 +7,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/messages"
 )
@@ -18,29 +19,84 @@ func Decode(text string) (*Data, error) {
 	return &result, err
 }
 
-func Load() (*configdomain.PartialConfig, error) {
-	file, err := os.Open(FileName)
+// Load provides the validated content of the configuration file in the current directory
+// and the unknown keys in it, which Git Town ignores.
+// Returns nil if no configuration file exists.
+func Load() (*configdomain.PartialConfig, Problems, error) {
+	return loadFile(FileName)
+}
+
+// LoadUser provides the validated content of the user-level configuration file
+// and the unknown keys in it, which Git Town ignores.
+// Returns nil if no user-level configuration file exists.
+func LoadUser() (*configdomain.PartialConfig, Problems, error) {
+	path := UserFilePath()
+	if path == "" {
+		return nil, nil, nil
+	}
+	return loadFile(path)
+}
+
+func loadFile(path string) (*configdomain.PartialConfig, Problems, error) {
+	file, err := os.Open(path)
 	if err != nil {
-		return nil, nil //nolint:nilerr,nilnil
+		return nil, nil, nil //nolint:nilerr
 	}
 	defer file.Close()
 	bytes, err := io.ReadAll(file)
 	if err != nil {
-		return nil, fmt.Errorf(messages.ConfigFileCannotRead, ".git-branches.yml", err)
+		return nil, nil, fmt.Errorf(messages.ConfigFileCannotRead, path, err)
+	}
+	errs := Problems{}
+	unknownKeys := Problems{}
+	for _, problem := range Check(path, string(bytes)) {
+		if problem.UnknownKey {
+			unknownKeys = append(unknownKeys, problem)
+		} else {
+			errs = append(errs, problem)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, nil, errs
 	}
 	configFileData, err := Decode(string(bytes))
 	if err != nil {
-		return nil, fmt.Errorf(messages.ConfigFileInvalidData, ".git-branches.yml", err)
+		return nil, nil, fmt.Errorf(messages.ConfigFileInvalidData, path, err)
+	}
+	for command := range configFileData.Aliases {
+		if _, isAliasable := configdomain.AllAliasableCommands().Find(command); !isAliasable {
+			delete(configFileData.Aliases, command)
+		}
 	}
 	result, err := Validate(*configFileData)
-	return &result, err
+	return &result, unknownKeys, err
 }
 
 // Validate converts the given low-level configfile data into high-level config data.
 func Validate(data Data) (configdomain.PartialConfig, error) {
-	result := configdomain.PartialConfig{} //nolint:exhaustruct
+	result := configdomain.EmptyPartialConfig()
 	var err error
+	for command, value := range data.Aliases {
+		aliasableCommand, isAliasable := configdomain.AllAliasableCommands().Find(command)
+		if !isAliasable {
+			return result, fmt.Errorf(messages.ConfigFileAliasUnknown, command, configdomain.AllAliasableCommands().Strings())
+		}
+		result.Aliases[aliasableCommand] = value
+		result.Values[gitconfig.KeyForAliasableCommand(aliasableCommand).String()] = value
+	}
 	if data.Branches != nil {
+		if data.Branches.DefaultType != nil {
+			result.DefaultBranchType, err = configdomain.NewBranchTypeRef(*data.Branches.DefaultType)
+			if err != nil {
+				return result, err
+			}
+		}
+		if data.Branches.DefaultRemoteType != nil {
+			result.DefaultRemoteBranchType, err = configdomain.NewBranchTypeRef(*data.Branches.DefaultRemoteType)
+			if err != nil {
+				return result, err
+			}
+		}
 		if data.Branches.Main != nil {
 			result.MainBranch = gitdomain.NewLocalBranchNameRef(*data.Branches.Main)
 		}
@@ -50,10 +106,25 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 		if data.Branches.PerennialRegex != nil {
 			result.PerennialRegex = configdomain.NewPerennialRegexRef(*data.Branches.PerennialRegex)
 		}
+		if data.Branches.ContributionRegex != nil {
+			result.ContributionRegex = configdomain.NewContributionRegexRef(*data.Branches.ContributionRegex)
+		}
+		if data.Branches.ObservedRegex != nil {
+			result.ObservedRegex = configdomain.NewObservedRegexRef(*data.Branches.ObservedRegex)
+		}
+		if data.Branches.ParkedRegex != nil {
+			result.ParkedRegex = configdomain.NewParkedRegexRef(*data.Branches.ParkedRegex)
+		}
 	}
 	if data.Hosting != nil {
 		if data.Hosting.Platform != nil {
 			result.HostingPlatform, err = configdomain.NewHostingPlatformRef(*data.Hosting.Platform)
+			if err != nil {
+				return result, err
+			}
+		}
+		if data.Hosting.APIURL != nil {
+			result.HostingAPIURL = configdomain.NewHostingAPIURLRef(*data.Hosting.APIURL)
 		}
 		if data.Hosting.OriginHostname != nil {
 			result.HostingOriginHostname = configdomain.NewHostingOriginHostnameRef(*data.Hosting.OriginHostname)
@@ -62,22 +133,50 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 	if data.SyncStrategy != nil {
 		if data.SyncStrategy.FeatureBranches != nil {
 			result.SyncFeatureStrategy, err = configdomain.NewSyncFeatureStrategyRef(*data.SyncStrategy.FeatureBranches)
+			if err != nil {
+				return result, err
+			}
 		}
 		if data.SyncStrategy.PerennialBranches != nil {
 			result.SyncPerennialStrategy, err = configdomain.NewSyncPerennialStrategyRef(*data.SyncStrategy.PerennialBranches)
+			if err != nil {
+				return result, err
+			}
 		}
 	}
+	if data.Offline != nil {
+		offline := configdomain.Offline(*data.Offline)
+		result.Offline = &offline
+	}
+	if data.PushHook != nil {
+		pushHook := configdomain.PushHook(*data.PushHook)
+		result.PushHook = &pushHook
+	}
 	if data.PushNewbranches != nil {
 		result.PushNewBranches = configdomain.NewPushNewBranchesRef(*data.PushNewbranches)
 	}
+	if data.ShareLineage != nil {
+		result.ShareLineage = configdomain.NewShareLineageRef(*data.ShareLineage)
+	}
 	if data.ShipDeleteTrackingBranch != nil {
 		result.ShipDeleteTrackingBranch = configdomain.NewShipDeleteTrackingBranchRef(*data.ShipDeleteTrackingBranch)
 	}
+	if data.ShipMessageTemplate != nil {
+		result.ShipMessageTemplate = configdomain.NewShipMessageTemplateRef(*data.ShipMessageTemplate)
+	}
 	if data.SyncBeforeShip != nil {
 		result.SyncBeforeShip = configdomain.NewSyncBeforeShipRef(*data.SyncBeforeShip)
 	}
 	if data.SyncUpstream != nil {
 		result.SyncUpstream = configdomain.NewSyncUpstreamRef(*data.SyncUpstream)
 	}
-	return result, err
+	if data.TicketRegex != nil {
+		result.TicketRegex = configdomain.NewTicketRegexRef(*data.TicketRegex)
+	}
+	for _, key := range gitconfig.Keys() {
+		if value, has := data.Value(key); has {
+			result.Values[key.String()] = value
+		}
+	}
+	return result, nil
 }
@@ -3,6 +3,7 @@ package configfile_test
 import (
 	"testing"
 
+	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/config/configfile"
 	"github.com/shoenig/test/must"
 )
@@ -15,16 +16,28 @@ func TestConfigfile(t *testing.T) {
 		t.Run("complete content", func(t *testing.T) {
 			t.Parallel()
 			give := `
+offline = true
 push-hook = true
 push-new-branches = true
+share-lineage = true
 ship-delete-tracking-branch = false
+ship-message-template = "{{proposal-title}}"
 sync-before-ship = false
 sync-upstream = true
+ticket-regex = "[A-Z]+-[0-9]+"
+
+[aliases]
+sync = "town sync"
 
 [branches]
 main = "main"
 perennials = [ "public", "staging" ]
 perennial-regex = "release-.*"
+contribution-regex = "coworker-.*"
+observed-regex = "renovate/.*"
+parked-regex = "old-.*"
+default-type = "prototype"
+default-remote-type = "observed"
 
 [hosting]
 platform = "github"
@@ -36,22 +49,37 @@ perennial-branches = "rebase"
 `[1:]
 			have, err := configfile.Decode(give)
 			must.NoError(t, err)
+			contributionRegex := "coworker-.*"
+			defaultRemoteType := "observed"
+			defaultType := "prototype"
 			github := "github"
 			githubCom := "github.com"
 			main := "main"
 			merge := "merge"
+			observedRegex := "renovate/.*"
+			offline := true
+			parkedRegex := "old-.*"
 			pushNewBranches := true
 			pushHook := true
 			rebase := "rebase"
 			releaseRegex := "release-.*"
+			shareLineage := true
 			shipDeleteTrackingBranch := false
+			shipMessageTemplate := "{{proposal-title}}"
 			syncBeforeShip := false
 			syncUpstream := true
+			ticketRegex := "[A-Z]+-[0-9]+"
 			want := configfile.Data{
+				Aliases: map[string]string{"sync": "town sync"},
 				Branches: &configfile.Branches{
-					Main:           &main,
-					Perennials:     []string{"public", "staging"},
-					PerennialRegex: &releaseRegex,
+					ContributionRegex: &contributionRegex,
+					DefaultRemoteType: &defaultRemoteType,
+					DefaultType:       &defaultType,
+					Main:              &main,
+					ObservedRegex:     &observedRegex,
+					ParkedRegex:       &parkedRegex,
+					Perennials:        []string{"public", "staging"},
+					PerennialRegex:    &releaseRegex,
 				},
 				Hosting: &configfile.Hosting{
 					Platform:       &github,
@@ -61,11 +89,15 @@ perennial-branches = "rebase"
 					FeatureBranches:   &merge,
 					PerennialBranches: &rebase,
 				},
+				Offline:                  &offline,
 				PushHook:                 &pushHook,
 				PushNewbranches:          &pushNewBranches,
+				ShareLineage:             &shareLineage,
 				ShipDeleteTrackingBranch: &shipDeleteTrackingBranch,
+				ShipMessageTemplate:      &shipMessageTemplate,
 				SyncBeforeShip:           &syncBeforeShip,
 				SyncUpstream:             &syncUpstream,
+				TicketRegex:              &ticketRegex,
 			}
 			must.Eq(t, want, *have)
 		})
@@ -131,4 +163,47 @@ perennials = [
 			must.Eq(t, want, *have)
 		})
 	})
+
+	t.Run("Validate", func(t *testing.T) {
+		t.Parallel()
+		t.Run("all settings", func(t *testing.T) {
+			t.Parallel()
+			give, err := configfile.Decode(`
+offline = true
+ship-message-template = "{{proposal-title}}"
+ticket-regex = "[A-Z]+-[0-9]+"
+
+[aliases]
+sync = "town sync"
+`)
+			must.NoError(t, err)
+			have, err := configfile.Validate(*give)
+			must.NoError(t, err)
+			must.Eq(t, configdomain.Aliases{configdomain.AliasableCommandSync: "town sync"}, have.Aliases)
+			must.True(t, have.Offline.Bool())
+			must.EqOp(t, "{{proposal-title}}", have.ShipMessageTemplate.String())
+			must.EqOp(t, "[A-Z]+-[0-9]+", have.TicketRegex.String())
+		})
+		t.Run("alias for unknown command", func(t *testing.T) {
+			t.Parallel()
+			give, err := configfile.Decode(`
+[aliases]
+zonk = "town zonk"
+`)
+			must.NoError(t, err)
+			_, err = configfile.Validate(*give)
+			must.Error(t, err)
+		})
+		t.Run("invalid value", func(t *testing.T) {
+			t.Parallel()
+			give, err := configfile.Decode(`
+[sync-strategy]
+feature-branches = "zonk"
+perennial-branches = "rebase"
+`)
+			must.NoError(t, err)
+			_, err = configfile.Validate(*give)
+			must.Error(t, err)
+		})
+	})
 }
@@ -10,6 +10,43 @@ import (
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 )
 
+const (
+	aliasesHelp = `
+Git aliases that "git town config setup" offers to install,
+so that you can call Git Town commands like "git sync".
+`
+	defaultBranchTypesHelp = `
+The type of branches that have no configured type.
+"default-type" applies to branches that you create locally,
+"default-remote-type" to branches that you check out from origin.
+Possible values: "feature", "contribution", "observed",
+"parked", or "prototype".
+`
+	hostingAPIURLHelp = `
+The base URL of the API of your code hosting platform.
+Set this only for self-hosted instances whose API
+doesn't run on the hostname of the origin remote.
+`
+	offlineHelp = `
+Should Git Town work without a network connection by default?
+This is usually a personal choice. Use "git town offline"
+to change it only on your machine.
+`
+	shareLineageHelp = `
+Should Git Town share the lineage of branches with your teammates
+via the "refs/git-town/lineage" ref at the origin remote?
+`
+	shipMessageTemplateHelp = `
+The template for the commit message of squash commits
+that "git town ship" creates.
+More info at https://www.git-town.com/preferences/ship-message-template.
+`
+	ticketRegexHelp = `
+A regular expression that matches the IDs of tickets in your issue tracker.
+More info at https://www.git-town.com/preferences/ticket-regex.
+`
+)
+
 func RenderPerennialBranches(perennials gitdomain.LocalBranchNames) string {
 	if len(perennials) == 0 {
 		return "[]"
@@ -17,6 +54,14 @@ func RenderPerennialBranches(perennials gitdomain.LocalBranchNames) string {
 	return fmt.Sprintf(`["%s"]`, perennials.Join(`", "`))
 }
 
+// RenderOptional renders the given TOML entry, commented out if it contains no value worth storing.
+func RenderOptional(entry string, hasValue bool) string {
+	if hasValue {
+		return entry
+	}
+	return "# " + entry
+}
+
 func RenderTOML(config *configdomain.FullConfig) string {
 	result := strings.Builder{}
 	result.WriteString("# Git Town configuration file\n")
@@ -33,14 +78,40 @@ func RenderTOML(config *configdomain.FullConfig) string {
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.SyncBeforeShipHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("sync-before-ship = %t\n\n", config.SyncBeforeShip))
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.SyncUpstreamHelp)) + "\n")
-	result.WriteString(fmt.Sprintf("sync-upstream = %t\n", config.SyncUpstream))
+	result.WriteString(fmt.Sprintf("sync-upstream = %t\n\n", config.SyncUpstream))
+	result.WriteString(TOMLComment(strings.TrimSpace(shareLineageHelp)) + "\n")
+	result.WriteString(fmt.Sprintf("share-lineage = %t\n\n", config.ShareLineage))
+	result.WriteString(TOMLComment(strings.TrimSpace(offlineHelp)) + "\n")
+	result.WriteString("# offline = false\n\n")
+	result.WriteString(TOMLComment(strings.TrimSpace(shipMessageTemplateHelp)) + "\n")
+	result.WriteString(RenderOptional(fmt.Sprintf("ship-message-template = %q\n\n", config.ShipMessageTemplate), config.ShipMessageTemplate != ""))
+	result.WriteString(TOMLComment(strings.TrimSpace(ticketRegexHelp)) + "\n")
+	result.WriteString(RenderOptional(fmt.Sprintf("ticket-regex = %q\n", config.TicketRegex), config.TicketRegex != ""))
+	if len(config.Aliases) > 0 {
+		result.WriteString("\n[aliases]\n\n")
+		result.WriteString(TOMLComment(strings.TrimSpace(aliasesHelp)) + "\n")
+		for _, aliasableCommand := range configdomain.AllAliasableCommands() {
+			if alias, has := config.Aliases[aliasableCommand]; has {
+				result.WriteString(fmt.Sprintf("%s = %q\n", aliasableCommand, alias))
+			}
+		}
+	}
 	result.WriteString("\n[branches]\n\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.MainBranchHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("main = %q\n\n", config.MainBranch))
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.PerennialBranchesHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("perennials = %s\n", RenderPerennialBranches(config.PerennialBranches)) + "\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.PerennialRegexHelp)) + "\n")
-	result.WriteString(fmt.Sprintf("perennial-regex = %q\n", config.PerennialRegex))
+	result.WriteString(fmt.Sprintf("perennial-regex = %q\n\n", config.PerennialRegex))
+	result.WriteString(TOMLComment(strings.TrimSpace(dialog.ContributionRegexHelp)) + "\n")
+	result.WriteString(fmt.Sprintf("contribution-regex = %q\n\n", config.ContributionRegex))
+	result.WriteString(TOMLComment(strings.TrimSpace(dialog.ObservedRegexHelp)) + "\n")
+	result.WriteString(fmt.Sprintf("observed-regex = %q\n\n", config.ObservedRegex))
+	result.WriteString(TOMLComment(strings.TrimSpace(dialog.ParkedRegexHelp)) + "\n")
+	result.WriteString(fmt.Sprintf("parked-regex = %q\n\n", config.ParkedRegex))
+	result.WriteString(TOMLComment(strings.TrimSpace(defaultBranchTypesHelp)) + "\n")
+	result.WriteString(RenderOptional(fmt.Sprintf("default-type = %q\n", renderBranchType(config.DefaultBranchType)), config.DefaultBranchType != configdomain.BranchTypeFeatureBranch))
+	result.WriteString(RenderOptional(fmt.Sprintf("default-remote-type = %q\n", renderBranchType(config.DefaultRemoteBranchType)), config.DefaultRemoteBranchType != configdomain.BranchTypeFeatureBranch))
 	result.WriteString("\n[hosting]\n\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.HostingPlatformHelp)) + "\n")
 	if config.HostingPlatform == configdomain.HostingPlatformNone {
@@ -50,10 +121,12 @@ func RenderTOML(config *configdomain.FullConfig) string {
 	}
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.OriginHostnameHelp)) + "\n")
 	if config.HostingOriginHostname == "" {
-		result.WriteString("# origin-hostname = \"\"\n")
+		result.WriteString("# origin-hostname = \"\"\n\n")
 	} else {
-		result.WriteString(fmt.Sprintf("origin-hostname = %q\n", config.HostingOriginHostname))
+		result.WriteString(fmt.Sprintf("origin-hostname = %q\n\n", config.HostingOriginHostname))
 	}
+	result.WriteString(TOMLComment(strings.TrimSpace(hostingAPIURLHelp)) + "\n")
+	result.WriteString(RenderOptional(fmt.Sprintf("api-url = %q\n", config.HostingAPIURL), config.HostingAPIURL != ""))
 	result.WriteString("\n[sync-strategy]\n\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.SyncFeatureStrategyHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("feature-branches = %q\n\n", config.SyncFeatureStrategy))
@@ -62,6 +135,11 @@ func RenderTOML(config *configdomain.FullConfig) string {
 	return result.String()
 }
 
+// renderBranchType provides the short name of the given branch type used in the configuration file, like "feature".
+func renderBranchType(branchType configdomain.BranchType) string {
+	return strings.TrimSuffix(branchType.String(), " branch")
+}
+
 func Save(config *configdomain.FullConfig) error {
 	return os.WriteFile(FileName, []byte(RenderTOML(config)), 0o600)
 }
@@ -100,6 +100,24 @@ sync-before-ship = false
 # and you want to keep it in sync with the repo it was forked from.
 sync-upstream = true
 
+# Should Git Town share the lineage of branches with your teammates
+# via the "refs/git-town/lineage" ref at the origin remote?
+share-lineage = false
+
+# Should Git Town work without a network connection by default?
+# This is usually a personal choice. Use "git town offline"
+# to change it only on your machine.
+# offline = false
+
+# The template for the commit message of squash commits
+# that "git town ship" creates.
+# More info at https://www.git-town.com/preferences/ship-message-template.
+# ship-message-template = ""
+
+# A regular expression that matches the IDs of tickets in your issue tracker.
+# More info at https://www.git-town.com/preferences/ticket-regex.
+# ticket-regex = ""
+
 [branches]
 
 # The main branch is the branch from which you cut new feature branches,
@@ -121,6 +139,42 @@ perennials = ["one", "two"]
 # If you are not sure, leave this empty.
 perennial-regex = ""
 
+# All branches whose names match this regular expression
+# are considered contribution branches,
+# unless they are listed explicitly as another branch type.
+# Git Town syncs contribution branches and pushes your commits
+# but never ships or removes them.
+# Example: "release-.*" for release branches of your coworkers.
+#
+# If you are not sure, leave this empty.
+contribution-regex = ""
+
+# All branches whose names match this regular expression
+# are considered observed branches,
+# unless they are listed explicitly as another branch type.
+# Git Town pulls updates into observed branches
+# but never pushes to or ships them.
+# Example: "(renovate|dependabot)/.*" for bot branches.
+#
+# If you are not sure, leave this empty.
+observed-regex = ""
+
+# All branches whose names match this regular expression
+# are considered parked branches,
+# unless they are listed explicitly as another branch type.
+# Git Town syncs parked branches only when they are checked out.
+#
+# If you are not sure, leave this empty.
+parked-regex = ""
+
+# The type of branches that have no configured type.
+# "default-type" applies to branches that you create locally,
+# "default-remote-type" to branches that you check out from origin.
+# Possible values: "feature", "contribution", "observed",
+# "parked", or "prototype".
+# default-type = "feature"
+# default-remote-type = "feature"
+
 [hosting]
 
 # Knowing the type of code hosting platform allows Git Town
@@ -134,6 +188,11 @@ perennial-regex = ""
 # if the auto-detection does not work for you.
 # origin-hostname = ""
 
+# The base URL of the API of your code hosting platform.
+# Set this only for self-hosted instances whose API
+# doesn't run on the hostname of the origin remote.
+# api-url = ""
+
 [sync-strategy]
 
 # How should Git Town synchronize feature branches?
@@ -218,6 +277,24 @@ sync-before-ship = false
 # and you want to keep it in sync with the repo it was forked from.
 sync-upstream = true
 
+# Should Git Town share the lineage of branches with your teammates
+# via the "refs/git-town/lineage" ref at the origin remote?
+share-lineage = false
+
+# Should Git Town work without a network connection by default?
+# This is usually a personal choice. Use "git town offline"
+# to change it only on your machine.
+# offline = false
+
+# The template for the commit message of squash commits
+# that "git town ship" creates.
+# More info at https://www.git-town.com/preferences/ship-message-template.
+# ship-message-template = ""
+
+# A regular expression that matches the IDs of tickets in your issue tracker.
+# More info at https://www.git-town.com/preferences/ticket-regex.
+# ticket-regex = ""
+
 [branches]
 
 # The main branch is the branch from which you cut new feature branches,
@@ -239,6 +316,42 @@ perennials = []
 # If you are not sure, leave this empty.
 perennial-regex = ""
 
+# All branches whose names match this regular expression
+# are considered contribution branches,
+# unless they are listed explicitly as another branch type.
+# Git Town syncs contribution branches and pushes your commits
+# but never ships or removes them.
+# Example: "release-.*" for release branches of your coworkers.
+#
+# If you are not sure, leave this empty.
+contribution-regex = ""
+
+# All branches whose names match this regular expression
+# are considered observed branches,
+# unless they are listed explicitly as another branch type.
+# Git Town pulls updates into observed branches
+# but never pushes to or ships them.
+# Example: "(renovate|dependabot)/.*" for bot branches.
+#
+# If you are not sure, leave this empty.
+observed-regex = ""
+
+# All branches whose names match this regular expression
+# are considered parked branches,
+# unless they are listed explicitly as another branch type.
+# Git Town syncs parked branches only when they are checked out.
+#
+# If you are not sure, leave this empty.
+parked-regex = ""
+
+# The type of branches that have no configured type.
+# "default-type" applies to branches that you create locally,
+# "default-remote-type" to branches that you check out from origin.
+# Possible values: "feature", "contribution", "observed",
+# "parked", or "prototype".
+# default-type = "feature"
+# default-remote-type = "feature"
+
 [hosting]
 
 # Knowing the type of code hosting platform allows Git Town
@@ -252,6 +365,11 @@ perennial-regex = ""
 # if the auto-detection does not work for you.
 # origin-hostname = ""
 
+# The base URL of the API of your code hosting platform.
+# Set this only for self-hosted instances whose API
+# doesn't run on the hostname of the origin remote.
+# api-url = ""
+
 [sync-strategy]
 
 # How should Git Town synchronize feature branches?
@@ -0,0 +1,92 @@
+package configfile
+
+import (
+	"regexp"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+)
+
+// Field describes an entry that the configuration file can contain.
+type Field struct {
+	Check       func(string) error // verifies a textual value in more detail than Enum, nil if Enum or Type are sufficient
+	Description string
+	Enum        []string  // the allowed values, empty if all values of the given type are allowed
+	Path        string    // the dotted path of this entry, like "branches.main"
+	Type        FieldType // the TOML type of the value
+}
+
+// FieldType describes the TOML type of a Field.
+type FieldType string
+
+const (
+	FieldTypeBool       FieldType = "boolean"
+	FieldTypeString     FieldType = "string"
+	FieldTypeStringList FieldType = "string list"
+	FieldTypeStringMap  FieldType = "string map" // a table whose keys are the Enum values and whose values are strings
+)
+
+// Fields provides all entries that the configuration file can contain.
+func Fields() []Field {
+	branchTypes := []string{"feature", "contribution", "observed", "parked", "prototype"}
+	syncStrategies := []string{"merge", "rebase"}
+	return []Field{
+		{Check: nil, Description: `Git aliases that "git town config setup" offers to install`, Enum: configdomain.AllAliasableCommands().Strings(), Path: "aliases", Type: FieldTypeStringMap},
+		{Check: checkRegex, Description: "branches matching this regular expression are contribution branches", Enum: nil, Path: "branches.contribution-regex", Type: FieldTypeString},
+		{Check: checkBranchType, Description: "the type of branches checked out from origin that have no configured type", Enum: branchTypes, Path: "branches.default-remote-type", Type: FieldTypeString},
+		{Check: checkBranchType, Description: "the type of locally created branches that have no configured type", Enum: branchTypes, Path: "branches.default-type", Type: FieldTypeString},
+		{Check: nil, Description: "the main branch", Enum: nil, Path: "branches.main", Type: FieldTypeString},
+		{Check: checkRegex, Description: "branches matching this regular expression are observed branches", Enum: nil, Path: "branches.observed-regex", Type: FieldTypeString},
+		{Check: checkRegex, Description: "branches matching this regular expression are parked branches", Enum: nil, Path: "branches.parked-regex", Type: FieldTypeString},
+		{Check: checkRegex, Description: "branches matching this regular expression are perennial branches", Enum: nil, Path: "branches.perennial-regex", Type: FieldTypeString},
+		{Check: nil, Description: "the perennial branches", Enum: nil, Path: "branches.perennials", Type: FieldTypeStringList},
+		{Check: nil, Description: "the base URL of the API of the code hosting platform, if it doesn't run on the hostname of the origin remote", Enum: nil, Path: "hosting.api-url", Type: FieldTypeString},
+		{Check: nil, Description: "the hostname of the origin remote, if it can't be auto-detected", Enum: nil, Path: "hosting.origin-hostname", Type: FieldTypeString},
+		{Check: checkHostingPlatform, Description: "the code hosting platform, empty to auto-detect", Enum: []string{"", "bitbucket", "gitea", "github", "gitlab"}, Path: "hosting.platform", Type: FieldTypeString},
+		{Check: nil, Description: "whether Git Town works without a network connection", Enum: nil, Path: "offline", Type: FieldTypeBool},
+		{Check: nil, Description: "whether Git Town runs the pre-push hook", Enum: nil, Path: "push-hook", Type: FieldTypeBool},
+		{Check: nil, Description: "whether Git Town pushes new branches to origin right away", Enum: nil, Path: "push-new-branches", Type: FieldTypeBool},
+		{Check: nil, Description: "whether Git Town shares the lineage via the origin remote", Enum: nil, Path: "share-lineage", Type: FieldTypeBool},
+		{Check: nil, Description: `whether "git town ship" deletes the tracking branch`, Enum: nil, Path: "ship-delete-tracking-branch", Type: FieldTypeBool},
+		{Check: nil, Description: `the template for the commit message of squash commits that "git town ship" creates`, Enum: nil, Path: "ship-message-template", Type: FieldTypeString},
+		{Check: nil, Description: `whether "git town ship" syncs branches before shipping them`, Enum: nil, Path: "sync-before-ship", Type: FieldTypeBool},
+		{Check: checkSyncFeatureStrategy, Description: "how Git Town syncs feature branches", Enum: syncStrategies, Path: "sync-strategy.feature-branches", Type: FieldTypeString},
+		{Check: checkSyncPerennialStrategy, Description: "how Git Town syncs perennial branches", Enum: syncStrategies, Path: "sync-strategy.perennial-branches", Type: FieldTypeString},
+		{Check: nil, Description: "whether Git Town also syncs the main branch with the upstream remote", Enum: nil, Path: "sync-upstream", Type: FieldTypeBool},
+		{Check: checkRegex, Description: "a regular expression that matches the IDs of tickets in the issue tracker", Enum: nil, Path: "ticket-regex", Type: FieldTypeString},
+	}
+}
+
+func checkBranchType(value string) error {
+	_, err := configdomain.NewBranchType(value)
+	return err
+}
+
+func checkHostingPlatform(value string) error {
+	_, err := configdomain.NewHostingPlatform(value)
+	return err
+}
+
+func checkRegex(value string) error {
+	_, err := regexp.Compile(value)
+	return err
+}
+
+func checkSyncFeatureStrategy(value string) error {
+	_, err := configdomain.NewSyncFeatureStrategy(value)
+	return err
+}
+
+func checkSyncPerennialStrategy(value string) error {
+	_, err := configdomain.NewSyncPerennialStrategy(value)
+	return err
+}
+
+// LookupField provides the Field with the given path.
+func LookupField(path string) (Field, bool) {
+	for _, field := range Fields() {
+		if field.Path == path {
+			return field, true
+		}
+	}
+	return Field{}, false //nolint:exhaustruct
+}
@@ -0,0 +1,219 @@
+package configfile
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/gohacks"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// LoadData provides the unvalidated content of the configuration file.
+// Returns nil if no configuration file exists.
+func LoadData() (*Data, error) {
+	return loadData(FileName)
+}
+
+func loadData(path string) (*Data, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil //nolint:nilnil
+		}
+		return nil, fmt.Errorf(messages.ConfigFileCannotRead, path, err)
+	}
+	data, err := Decode(string(bytes))
+	if err != nil {
+		return nil, fmt.Errorf(messages.ConfigFileInvalidData, path, err)
+	}
+	return data, nil
+}
+
+// SaveData writes the given data into the configuration file.
+// Only the settings that differ from the current file content change,
+// the rest of the file including its comments stays as it is.
+func SaveData(data *Data) error {
+	text := "# Git Town configuration file\n"
+	oldData := &Data{} //nolint:exhaustruct
+	bytes, err := os.ReadFile(FileName)
+	switch {
+	case err == nil:
+		text = string(bytes)
+		oldData, err = Decode(text)
+		if err != nil {
+			return fmt.Errorf(messages.ConfigFileInvalidData, FileName, err)
+		}
+	case !os.IsNotExist(err):
+		return fmt.Errorf(messages.ConfigFileCannotRead, FileName, err)
+	}
+	return os.WriteFile(FileName, []byte(UpdateTOML(text, oldData, data)), 0o600)
+}
+
+// RemoveValue removes the setting with the given Git configuration key from this data.
+func (self *Data) RemoveValue(key gitconfig.Key) error {
+	if aliasableCommand := gitconfig.AliasableCommandForKey(key); aliasableCommand != nil {
+		delete(self.Aliases, aliasableCommand.String())
+		return nil
+	}
+	if stringField := self.stringField(key); stringField != nil {
+		*stringField = nil
+		return nil
+	}
+	if boolField := self.boolField(key); boolField != nil {
+		*boolField = nil
+		return nil
+	}
+	if listField := self.listField(key); listField != nil {
+		*listField = nil
+		return nil
+	}
+	return fmt.Errorf(messages.ConfigFileSettingUnsupported, key)
+}
+
+// SetValue stores the given value, formatted like in the Git configuration,
+// for the setting with the given Git configuration key.
+func (self *Data) SetValue(key gitconfig.Key, value string) error {
+	if aliasableCommand := gitconfig.AliasableCommandForKey(key); aliasableCommand != nil {
+		if self.Aliases == nil {
+			self.Aliases = map[string]string{}
+		}
+		self.Aliases[aliasableCommand.String()] = value
+		return nil
+	}
+	if stringField := self.stringField(key); stringField != nil {
+		*stringField = &value
+		return nil
+	}
+	if boolField := self.boolField(key); boolField != nil {
+		parsed, err := gohacks.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf(messages.ValueInvalid, key, value)
+		}
+		*boolField = &parsed
+		return nil
+	}
+	if listField := self.listField(key); listField != nil {
+		*listField = strings.Fields(value)
+		return nil
+	}
+	return fmt.Errorf(messages.ConfigFileSettingUnsupported, key)
+}
+
+// Value provides the value of the setting with the given Git configuration key,
+// formatted like in the Git configuration.
+func (self Data) Value(key gitconfig.Key) (string, bool) {
+	if aliasableCommand := gitconfig.AliasableCommandForKey(key); aliasableCommand != nil {
+		value, has := self.Aliases[aliasableCommand.String()]
+		return value, has
+	}
+	if stringField := self.stringField(key); stringField != nil && *stringField != nil {
+		return **stringField, true
+	}
+	if boolField := self.boolField(key); boolField != nil && *boolField != nil {
+		return strconv.FormatBool(**boolField), true
+	}
+	if listField := self.listField(key); listField != nil && *listField != nil {
+		return strings.Join(*listField, " "), true
+	}
+	return "", false
+}
+
+// Supports indicates whether the configuration file can store the setting with the given Git configuration key.
+func Supports(key gitconfig.Key) bool {
+	if gitconfig.AliasableCommandForKey(key) != nil {
+		return true
+	}
+	data := Data{} //nolint:exhaustruct
+	return data.stringField(key) != nil || data.boolField(key) != nil || data.listField(key) != nil
+}
+
+// boolField provides the field that stores the boolean setting with the given key,
+// or nil if the given key isn't a boolean setting that the configuration file supports.
+func (self *Data) boolField(key gitconfig.Key) **bool {
+	switch key { //nolint:exhaustive
+	case gitconfig.KeyOffline:
+		return &self.Offline
+	case gitconfig.KeyPushHook:
+		return &self.PushHook
+	case gitconfig.KeyPushNewBranches:
+		return &self.PushNewbranches
+	case gitconfig.KeyShareLineage:
+		return &self.ShareLineage
+	case gitconfig.KeyShipDeleteTrackingBranch:
+		return &self.ShipDeleteTrackingBranch
+	case gitconfig.KeySyncBeforeShip:
+		return &self.SyncBeforeShip
+	case gitconfig.KeySyncUpstream:
+		return &self.SyncUpstream
+	}
+	return nil
+}
+
+// listField provides the field that stores the list setting with the given key,
+// or nil if the given key isn't a list setting that the configuration file supports.
+func (self *Data) listField(key gitconfig.Key) *[]string {
+	if key == gitconfig.KeyPerennialBranches {
+		return &self.branches().Perennials
+	}
+	return nil
+}
+
+// stringField provides the field that stores the textual setting with the given key,
+// or nil if the given key isn't a textual setting that the configuration file supports.
+func (self *Data) stringField(key gitconfig.Key) **string {
+	switch key { //nolint:exhaustive
+	case gitconfig.KeyContributionRegex:
+		return &self.branches().ContributionRegex
+	case gitconfig.KeyDefaultBranchType:
+		return &self.branches().DefaultType
+	case gitconfig.KeyDefaultRemoteBranchType:
+		return &self.branches().DefaultRemoteType
+	case gitconfig.KeyHostingAPIURL:
+		return &self.hosting().APIURL
+	case gitconfig.KeyHostingOriginHostname:
+		return &self.hosting().OriginHostname
+	case gitconfig.KeyHostingPlatform:
+		return &self.hosting().Platform
+	case gitconfig.KeyMainBranch:
+		return &self.branches().Main
+	case gitconfig.KeyObservedRegex:
+		return &self.branches().ObservedRegex
+	case gitconfig.KeyParkedRegex:
+		return &self.branches().ParkedRegex
+	case gitconfig.KeyPerennialRegex:
+		return &self.branches().PerennialRegex
+	case gitconfig.KeyShipMessageTemplate:
+		return &self.ShipMessageTemplate
+	case gitconfig.KeySyncFeatureStrategy:
+		return &self.syncStrategy().FeatureBranches
+	case gitconfig.KeySyncPerennialStrategy:
+		return &self.syncStrategy().PerennialBranches
+	case gitconfig.KeyTicketRegex:
+		return &self.TicketRegex
+	}
+	return nil
+}
+
+func (self *Data) branches() *Branches {
+	if self.Branches == nil {
+		self.Branches = &Branches{} //nolint:exhaustruct
+	}
+	return self.Branches
+}
+
+func (self *Data) hosting() *Hosting {
+	if self.Hosting == nil {
+		self.Hosting = &Hosting{} //nolint:exhaustruct
+	}
+	return self.Hosting
+}
+
+func (self *Data) syncStrategy() *SyncStrategy {
+	if self.SyncStrategy == nil {
+		self.SyncStrategy = &SyncStrategy{} //nolint:exhaustruct
+	}
+	return self.SyncStrategy
+}
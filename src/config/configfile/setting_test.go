@@ -0,0 +1,91 @@
+package configfile_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/shoenig/test/must"
+)
+
+func TestSetting(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SetValue and Value", func(t *testing.T) {
+		t.Parallel()
+		tests := map[gitconfig.Key]string{
+			gitconfig.KeyMainBranch:          "main",
+			gitconfig.KeyPerennialBranches:   "qa staging",
+			gitconfig.KeyPushHook:            "false",
+			gitconfig.KeySyncFeatureStrategy: "rebase",
+			gitconfig.KeyHostingPlatform:     "github",
+		}
+		for key, value := range tests {
+			data := configfile.Data{} //nolint:exhaustruct
+			err := data.SetValue(key, value)
+			must.NoError(t, err)
+			have, has := data.Value(key)
+			must.True(t, has)
+			must.EqOp(t, value, have)
+		}
+	})
+
+	t.Run("SetValue parses Git booleans", func(t *testing.T) {
+		t.Parallel()
+		data := configfile.Data{} //nolint:exhaustruct
+		must.NoError(t, data.SetValue(gitconfig.KeySyncUpstream, "no"))
+		must.False(t, *data.SyncUpstream)
+		must.Error(t, data.SetValue(gitconfig.KeySyncUpstream, "maybe"))
+	})
+
+	t.Run("unsupported setting", func(t *testing.T) {
+		t.Parallel()
+		data := configfile.Data{} //nolint:exhaustruct
+		must.Error(t, data.SetValue(gitconfig.KeyGithubToken, "secret"))
+		must.Error(t, data.RemoveValue(gitconfig.KeyGithubToken))
+		_, has := data.Value(gitconfig.KeyGithubToken)
+		must.False(t, has)
+	})
+
+	t.Run("RemoveValue", func(t *testing.T) {
+		t.Parallel()
+		main := "main"
+		data := configfile.Data{ //nolint:exhaustruct
+			Branches: &configfile.Branches{ //nolint:exhaustruct
+				Main: &main,
+			},
+		}
+		must.NoError(t, data.RemoveValue(gitconfig.KeyMainBranch))
+		_, has := data.Value(gitconfig.KeyMainBranch)
+		must.False(t, has)
+	})
+
+	t.Run("Value of missing settings", func(t *testing.T) {
+		t.Parallel()
+		data := configfile.Data{} //nolint:exhaustruct
+		_, has := data.Value(gitconfig.KeyMainBranch)
+		must.False(t, has)
+		must.Nil(t, data.Branches)
+	})
+
+	t.Run("aliases", func(t *testing.T) {
+		t.Parallel()
+		data := configfile.Data{} //nolint:exhaustruct
+		must.NoError(t, data.SetValue(gitconfig.KeyAliasSync, "town sync"))
+		must.Eq(t, map[string]string{"sync": "town sync"}, data.Aliases)
+		value, has := data.Value(gitconfig.KeyAliasSync)
+		must.True(t, has)
+		must.EqOp(t, "town sync", value)
+		must.NoError(t, data.RemoveValue(gitconfig.KeyAliasSync))
+		must.MapEmpty(t, data.Aliases)
+	})
+
+	t.Run("Supports", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, configfile.Supports(gitconfig.KeyParkedRegex))
+		must.True(t, configfile.Supports(gitconfig.KeyTicketRegex))
+		must.True(t, configfile.Supports(gitconfig.KeyAliasSync))
+		must.False(t, configfile.Supports(gitconfig.KeyGithubToken))
+		must.False(t, configfile.Supports(gitconfig.KeyParkedBranches))
+	})
+}
@@ -0,0 +1,217 @@
+package configfile
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+)
+
+// tomlEntry is a setting in the configuration file.
+type tomlEntry struct {
+	key     string // the TOML key of the setting
+	section string // the TOML table containing the setting, empty for the top-level table
+	value   string // the setting value in TOML format, empty if the setting isn't set
+}
+
+// UpdateTOML provides the given content of a configuration file with the settings that changed
+// between the given old and new data updated in place.
+// The remaining content of the file, including comments and formatting, stays as it is.
+func UpdateTOML(text string, oldData, newData *Data) string {
+	oldValues := map[string]string{}
+	for _, entry := range oldData.tomlEntries() {
+		oldValues[entry.section+"."+entry.key] = entry.value
+	}
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	for _, entry := range newData.tomlEntries() {
+		if entry.value == oldValues[entry.section+"."+entry.key] {
+			continue
+		}
+		lines = updateTOMLEntry(lines, entry)
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (self *Data) tomlEntries() []tomlEntry {
+	branches := self.Branches
+	if branches == nil {
+		branches = &Branches{} //nolint:exhaustruct
+	}
+	hosting := self.Hosting
+	if hosting == nil {
+		hosting = &Hosting{} //nolint:exhaustruct
+	}
+	syncStrategy := self.SyncStrategy
+	if syncStrategy == nil {
+		syncStrategy = &SyncStrategy{} //nolint:exhaustruct
+	}
+	result := []tomlEntry{
+		{key: "offline", section: "", value: renderTOMLBool(self.Offline)},
+		{key: "push-hook", section: "", value: renderTOMLBool(self.PushHook)},
+		{key: "push-new-branches", section: "", value: renderTOMLBool(self.PushNewbranches)},
+		{key: "share-lineage", section: "", value: renderTOMLBool(self.ShareLineage)},
+		{key: "ship-delete-tracking-branch", section: "", value: renderTOMLBool(self.ShipDeleteTrackingBranch)},
+		{key: "ship-message-template", section: "", value: renderTOMLString(self.ShipMessageTemplate)},
+		{key: "sync-before-ship", section: "", value: renderTOMLBool(self.SyncBeforeShip)},
+		{key: "sync-upstream", section: "", value: renderTOMLBool(self.SyncUpstream)},
+		{key: "ticket-regex", section: "", value: renderTOMLString(self.TicketRegex)},
+		{key: "main", section: "branches", value: renderTOMLString(branches.Main)},
+		{key: "perennials", section: "branches", value: renderTOMLList(branches.Perennials)},
+		{key: "perennial-regex", section: "branches", value: renderTOMLString(branches.PerennialRegex)},
+		{key: "contribution-regex", section: "branches", value: renderTOMLString(branches.ContributionRegex)},
+		{key: "observed-regex", section: "branches", value: renderTOMLString(branches.ObservedRegex)},
+		{key: "parked-regex", section: "branches", value: renderTOMLString(branches.ParkedRegex)},
+		{key: "default-type", section: "branches", value: renderTOMLString(branches.DefaultType)},
+		{key: "default-remote-type", section: "branches", value: renderTOMLString(branches.DefaultRemoteType)},
+		{key: "platform", section: "hosting", value: renderTOMLString(hosting.Platform)},
+		{key: "origin-hostname", section: "hosting", value: renderTOMLString(hosting.OriginHostname)},
+		{key: "api-url", section: "hosting", value: renderTOMLString(hosting.APIURL)},
+		{key: "feature-branches", section: "sync-strategy", value: renderTOMLString(syncStrategy.FeatureBranches)},
+		{key: "perennial-branches", section: "sync-strategy", value: renderTOMLString(syncStrategy.PerennialBranches)},
+	}
+	for _, aliasableCommand := range configdomain.AllAliasableCommands() {
+		value := ""
+		if alias, has := self.Aliases[aliasableCommand.String()]; has {
+			value = fmt.Sprintf("%q", alias)
+		}
+		result = append(result, tomlEntry{key: aliasableCommand.String(), section: "aliases", value: value})
+	}
+	return result
+}
+
+func renderTOMLBool(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*value)
+}
+
+func renderTOMLList(values []string) string {
+	if values == nil {
+		return ""
+	}
+	quoted := make([]string, len(values))
+	for v, value := range values {
+		quoted[v] = fmt.Sprintf("%q", value)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+func renderTOMLString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%q", *value)
+}
+
+var tomlSectionRE = regexp.MustCompile(`^\s*\[\s*([^\[\]\s]+)\s*\]\s*(#.*)?$`)
+
+// tomlSectionRange provides the range of lines that contain the entries of the given TOML table.
+// The start is the index of the first line after the table header, the end is the index after the last line.
+func tomlSectionRange(lines []string, section string) (start, end int, found bool) { //nolint:nonamedreturns
+	start = -1
+	if section == "" {
+		start = 0
+	}
+	for l, line := range lines {
+		match := tomlSectionRE.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		if start >= 0 {
+			return start, l, true
+		}
+		if match[1] == section {
+			start = l + 1
+		}
+	}
+	if start < 0 {
+		return 0, 0, false
+	}
+	return start, len(lines), true
+}
+
+// tomlValueEnd provides the index after the last line of the value that starts in the given line,
+// so that multi-line arrays get replaced completely.
+func tomlValueEnd(lines []string, start int) int {
+	depth := 0
+	for l := start; l < len(lines); l++ {
+		depth += strings.Count(lines[l], "[") - strings.Count(lines[l], "]")
+		if depth <= 0 {
+			return l + 1
+		}
+	}
+	return len(lines)
+}
+
+// updateTOMLEntry provides the given lines with the given entry set to its value, or removed if it has no value.
+func updateTOMLEntry(lines []string, entry tomlEntry) []string {
+	newLine := entry.key + " = " + entry.value
+	start, end, found := tomlSectionRange(lines, entry.section)
+	if !found {
+		if entry.value == "" {
+			return lines
+		}
+		if len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) != "" {
+			lines = append(lines, "")
+		}
+		return append(lines, "["+entry.section+"]", newLine)
+	}
+	activeRE := regexp.MustCompile(`^(\s*)` + regexp.QuoteMeta(entry.key) + `\s*=`)
+	commentedRE := regexp.MustCompile(`^(\s*)#\s*` + regexp.QuoteMeta(entry.key) + `\s*=`)
+	commented := -1
+	for l := start; l < end; l++ {
+		if match := activeRE.FindStringSubmatch(lines[l]); match != nil {
+			valueEnd := tomlValueEnd(lines, l)
+			if entry.value == "" {
+				return replaceLines(lines, l, valueEnd)
+			}
+			return replaceLines(lines, l, valueEnd, match[1]+newLine)
+		}
+		if commented < 0 && commentedRE.MatchString(lines[l]) {
+			commented = l
+		}
+	}
+	if entry.value == "" {
+		return lines
+	}
+	if commented >= 0 {
+		indentation := commentedRE.FindStringSubmatch(lines[commented])[1]
+		return replaceLines(lines, commented, commented+1, indentation+newLine)
+	}
+	// add the entry after the last entry of the table
+	insert := start
+	hasEntries := false
+	for l := end - 1; l >= start; l-- {
+		trimmed := strings.TrimSpace(lines[l])
+		if trimmed != "" {
+			if insert == start {
+				insert = l + 1
+			}
+			if !strings.HasPrefix(trimmed, "#") {
+				hasEntries = true
+			}
+		}
+	}
+	newLines := []string{newLine}
+	if entry.section == "" && !hasEntries {
+		// keep the new entry apart from the comments at the top of the file and from the first table
+		if insert > 0 {
+			newLines = append([]string{""}, newLines...)
+		}
+		if insert < len(lines) && tomlSectionRE.MatchString(lines[insert]) {
+			newLines = append(newLines, "")
+		}
+	}
+	return replaceLines(lines, insert, insert, newLines...)
+}
+
+// replaceLines provides the given lines with the lines from start to end replaced with the given replacement.
+func replaceLines(lines []string, start, end int, replacement ...string) []string {
+	result := make([]string, 0, len(lines)-(end-start)+len(replacement))
+	result = append(result, lines[:start]...)
+	result = append(result, replacement...)
+	return append(result, lines[end:]...)
+}
@@ -0,0 +1,121 @@
+package configfile_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/shoenig/test/must"
+)
+
+func TestUpdateTOML(t *testing.T) {
+	t.Parallel()
+
+	// update applies the given change to the given configuration file content.
+	update := func(t *testing.T, text string, change func(*configfile.Data)) string {
+		t.Helper()
+		oldData, err := configfile.Decode(text)
+		must.NoError(t, err)
+		newData, err := configfile.Decode(text)
+		must.NoError(t, err)
+		change(newData)
+		return configfile.UpdateTOML(text, oldData, newData)
+	}
+
+	t.Run("changes an existing entry and keeps the comments", func(t *testing.T) {
+		t.Parallel()
+		give := `# Git Town configuration file
+
+# Should Git Town push the new branches it creates?
+push-new-branches = false
+
+[branches]
+
+# The main branch
+main = "main"
+`
+		have := update(t, give, func(data *configfile.Data) {
+			must.NoError(t, data.SetValue(gitconfig.KeyMainBranch, "dev"))
+			must.NoError(t, data.SetValue(gitconfig.KeyPushNewBranches, "true"))
+		})
+		want := `# Git Town configuration file
+
+# Should Git Town push the new branches it creates?
+push-new-branches = true
+
+[branches]
+
+# The main branch
+main = "dev"
+`
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("uncomments a commented-out entry", func(t *testing.T) {
+		t.Parallel()
+		give := `[hosting]
+
+# The code hosting platform
+# platform = ""
+`
+		have := update(t, give, func(data *configfile.Data) {
+			must.NoError(t, data.SetValue(gitconfig.KeyHostingPlatform, "github"))
+		})
+		want := `[hosting]
+
+# The code hosting platform
+platform = "github"
+`
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("adds new entries to existing and new tables", func(t *testing.T) {
+		t.Parallel()
+		give := `# Git Town configuration file
+
+[branches]
+perennials = [
+  "staging",
+]
+`
+		have := update(t, give, func(data *configfile.Data) {
+			must.NoError(t, data.SetValue(gitconfig.KeyMainBranch, "main"))
+			must.NoError(t, data.SetValue(gitconfig.KeyPerennialBranches, "staging qa"))
+			must.NoError(t, data.SetValue(gitconfig.KeyShipMessageTemplate, "{{proposal-title}}"))
+			must.NoError(t, data.SetValue(gitconfig.KeySyncFeatureStrategy, "rebase"))
+		})
+		want := `# Git Town configuration file
+
+ship-message-template = "{{proposal-title}}"
+
+[branches]
+perennials = ["staging", "qa"]
+main = "main"
+
+[sync-strategy]
+feature-branches = "rebase"
+`
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("removes entries", func(t *testing.T) {
+		t.Parallel()
+		give := `# Should Git Town run the pre-push hook?
+push-hook = false
+sync-upstream = false
+
+[aliases]
+sync = "town sync"
+`
+		have := update(t, give, func(data *configfile.Data) {
+			must.NoError(t, data.RemoveValue(gitconfig.KeyPushHook))
+			must.NoError(t, data.RemoveValue(gitconfig.KeyAliasSync))
+		})
+		want := `# Should Git Town run the pre-push hook?
+sync-upstream = false
+
+[aliases]
+`
+		must.EqOp(t, want, have)
+	})
+}
@@ -0,0 +1,63 @@
+package envconfig
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// Prefix is the beginning of the names of all environment variables that override Git Town settings.
+const Prefix = "GIT_TOWN_"
+
+// Load provides the Git Town settings that environment variables override.
+// These environment variables are named like the setting in uppercase with underscores,
+// for example GIT_TOWN_SYNC_FEATURE_STRATEGY overrides "git-town.sync-feature-strategy".
+func Load() (gitconfig.SingleSnapshot, configdomain.PartialConfig, error) {
+	return Parse(os.Environ())
+}
+
+// VarName provides the name of the environment variable that overrides the Git Town setting with the given key.
+func VarName(key gitconfig.Key) string {
+	name := strings.TrimPrefix(key.String(), "git-town.")
+	return Prefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// Parse provides the Git Town settings that the given environment variables, formatted as "NAME=value", override.
+func Parse(environment []string) (gitconfig.SingleSnapshot, configdomain.PartialConfig, error) {
+	snapshot := gitconfig.SingleSnapshot{}
+	config := configdomain.EmptyPartialConfig()
+	for _, envVar := range environment {
+		name, value, _ := strings.Cut(envVar, "=")
+		if value == "" || !strings.HasPrefix(name, Prefix) {
+			continue
+		}
+		key := settingKey(name)
+		if key == nil {
+			continue
+		}
+		if err := gitconfig.AddKeyToPartialConfig(*key, value, &config); err != nil {
+			return snapshot, config, fmt.Errorf(messages.EnvVarInvalid, name, err)
+		}
+		snapshot[*key] = value
+	}
+	return snapshot, config, nil
+}
+
+// settingKey provides the key of the Git Town setting that the environment variable with the given name overrides.
+// Returns nil if the environment variable doesn't override a setting.
+// Aliases have no environment variables because Git reads them itself.
+func settingKey(name string) *gitconfig.Key {
+	setting := strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(name, Prefix), "_", "-"))
+	key := gitconfig.ParseKey("git-town." + setting)
+	if key == nil || VarName(*key) != name {
+		return nil
+	}
+	if _, isDeprecated := gitconfig.DeprecatedKeys[*key]; isDeprecated {
+		return nil
+	}
+	return key
+}
@@ -0,0 +1,63 @@
+package envconfig_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/envconfig"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/shoenig/test/must"
+)
+
+func TestSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Parse", func(t *testing.T) {
+		t.Parallel()
+		t.Run("settings", func(t *testing.T) {
+			t.Parallel()
+			give := []string{
+				"GIT_TOWN_OFFLINE=1",
+				"GIT_TOWN_SYNC_FEATURE_STRATEGY=rebase",
+				"GIT_TOWN_PERENNIAL_BRANCHES=qa staging",
+				"HOME=/home/user",
+			}
+			snapshot, config, err := envconfig.Parse(give)
+			must.NoError(t, err)
+			wantSnapshot := gitconfig.SingleSnapshot{
+				gitconfig.KeyOffline:             "1",
+				gitconfig.KeyPerennialBranches:   "qa staging",
+				gitconfig.KeySyncFeatureStrategy: "rebase",
+			}
+			must.Eq(t, wantSnapshot, snapshot)
+			must.True(t, config.Offline.Bool())
+			must.EqOp(t, configdomain.SyncFeatureStrategyRebase, *config.SyncFeatureStrategy)
+			must.Eq(t, "qa, staging", config.PerennialBranches.Join(", "))
+		})
+		t.Run("ignores other Git Town environment variables", func(t *testing.T) {
+			t.Parallel()
+			give := []string{
+				"GIT_TOWN_REMOTE=https://github.com/git-town/git-town.git",
+				"GIT_TOWN_SKIP_HOOKS=1",
+				"GIT_TOWN_PUSH_VERIFY=true",
+				"GIT_TOWN_OFFLINE=",
+				"GIT_TOWN_ALIAS_SYNC=town sync",
+				"GIT_TOWN_sync_upstream=false",
+			}
+			snapshot, _, err := envconfig.Parse(give)
+			must.NoError(t, err)
+			must.MapEmpty(t, snapshot)
+		})
+		t.Run("invalid value", func(t *testing.T) {
+			t.Parallel()
+			_, _, err := envconfig.Parse([]string{"GIT_TOWN_SYNC_FEATURE_STRATEGY=zonk"})
+			must.ErrorContains(t, err, `environment variable GIT_TOWN_SYNC_FEATURE_STRATEGY contains an invalid value: unknown sync-feature strategy: "zonk"`)
+		})
+	})
+
+	t.Run("VarName", func(t *testing.T) {
+		t.Parallel()
+		must.EqOp(t, "GIT_TOWN_SYNC_FEATURE_STRATEGY", envconfig.VarName(gitconfig.KeySyncFeatureStrategy))
+		must.EqOp(t, "GIT_TOWN_GITHUB_TOKEN", envconfig.VarName(gitconfig.KeyGithubToken))
+	})
+}
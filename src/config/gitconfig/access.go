@@ -21,17 +21,40 @@ type Access struct {
 	Runner
 }
 
-// LoadLocal reads the global Git Town configuration that applies to the entire machine.
-func (self *Access) LoadGlobal() (SingleSnapshot, configdomain.PartialConfig, error) {
+// LoadGlobal reads the global Git Town configuration that applies to the entire machine.
+// It also provides the settings with invalid values that it ignored.
+func (self *Access) LoadGlobal() (SingleSnapshot, configdomain.PartialConfig, []IgnoredValueError, error) {
 	return self.load(true)
 }
 
 // LoadLocal reads the Git Town configuration from the local Git's metadata for the current repository.
-func (self *Access) LoadLocal() (SingleSnapshot, configdomain.PartialConfig, error) {
+// It also provides the settings with invalid values that it ignored.
+func (self *Access) LoadLocal() (SingleSnapshot, configdomain.PartialConfig, []IgnoredValueError, error) {
 	return self.load(false)
 }
 
 func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialConfig) error {
+	config.Values[key.String()] = value
+	if strings.HasPrefix(key.String(), "branch.") && strings.HasSuffix(key.String(), ".pushremote") {
+		if config.BranchPushRemotes == nil {
+			config.BranchPushRemotes = &configdomain.BranchPushRemotes{}
+		}
+		branch := gitdomain.NewLocalBranchName(strings.TrimSuffix(strings.TrimPrefix(key.String(), "branch."), ".pushremote"))
+		(*config.BranchPushRemotes)[branch] = gitdomain.NewRemote(value)
+		return nil
+	}
+	if strings.HasPrefix(key.String(), "git-town-branch.") && strings.HasSuffix(key.String(), ".sync-strategy") {
+		if config.BranchSyncStrategies == nil {
+			config.BranchSyncStrategies = &configdomain.BranchSyncStrategies{}
+		}
+		branch := gitdomain.NewLocalBranchName(strings.TrimSuffix(strings.TrimPrefix(key.String(), "git-town-branch."), ".sync-strategy"))
+		strategy, err := configdomain.NewSyncFeatureStrategy(value)
+		if err != nil {
+			return IgnoredValueError{Key: key, Value: value}
+		}
+		(*config.BranchSyncStrategies)[branch] = strategy
+		return nil
+	}
 	if strings.HasPrefix(key.String(), "git-town-branch.") {
 		if config.Lineage == nil {
 			config.Lineage = &configdomain.Lineage{}
@@ -73,6 +96,14 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 		config.Aliases[configdomain.AliasableCommandSync] = value
 	case KeyContributionBranches:
 		config.ContributionBranches = gitdomain.ParseLocalBranchNamesRef(value)
+	case KeyContributionRegex:
+		config.ContributionRegex = configdomain.NewContributionRegexRef(value)
+	case KeyDefaultBranchType:
+		config.DefaultBranchType, err = configdomain.NewBranchTypeRef(value)
+	case KeyDefaultRemoteBranchType:
+		config.DefaultRemoteBranchType, err = configdomain.NewBranchTypeRef(value)
+	case KeyHostingAPIURL:
+		config.HostingAPIURL = configdomain.NewHostingAPIURLRef(value)
 	case KeyHostingOriginHostname:
 		config.HostingOriginHostname = configdomain.NewHostingOriginHostnameRef(value)
 	case KeyHostingPlatform:
@@ -83,6 +114,11 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 		config.GitHubToken = configdomain.NewGitHubTokenRef(value)
 	case KeyGitlabToken:
 		config.GitLabToken = configdomain.NewGitLabTokenRef(value)
+	case KeyGitPushDefault:
+		remote := gitdomain.NewRemote(value)
+		config.PushDefault = &remote
+	case KeyGitUpstreamURL:
+		config.UpstreamURL = &value
 	case KeyGitUserEmail:
 		config.GitUserEmail = &value
 	case KeyGitUserName:
@@ -91,20 +127,30 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 		config.MainBranch = gitdomain.NewLocalBranchNameRefAllowEmpty(value)
 	case KeyObservedBranches:
 		config.ObservedBranches = gitdomain.ParseLocalBranchNamesRef(value)
+	case KeyObservedRegex:
+		config.ObservedRegex = configdomain.NewObservedRegexRef(value)
 	case KeyOffline:
 		config.Offline, err = configdomain.NewOfflineRef(value, KeyOffline.String())
 	case KeyParkedBranches:
 		config.ParkedBranches = gitdomain.ParseLocalBranchNamesRef(value)
+	case KeyParkedRegex:
+		config.ParkedRegex = configdomain.NewParkedRegexRef(value)
 	case KeyPerennialBranches:
 		config.PerennialBranches = gitdomain.ParseLocalBranchNamesRef(value)
 	case KeyPerennialRegex:
 		config.PerennialRegex = configdomain.NewPerennialRegexRef(value)
+	case KeyPrototypeBranches:
+		config.PrototypeBranches = gitdomain.ParseLocalBranchNamesRef(value)
 	case KeyPushHook:
 		config.PushHook, err = configdomain.NewPushHookRef(value, KeyPushHook.String())
 	case KeyPushNewBranches:
 		config.PushNewBranches, err = configdomain.ParsePushNewBranchesRef(value, KeyPushNewBranches.String())
+	case KeyShareLineage:
+		config.ShareLineage, err = configdomain.ParseShareLineageRef(value, KeyShareLineage.String())
 	case KeyShipDeleteTrackingBranch:
 		config.ShipDeleteTrackingBranch, err = configdomain.ParseShipDeleteTrackingBranchRef(value, KeyShipDeleteTrackingBranch.String())
+	case KeyShipMessageTemplate:
+		config.ShipMessageTemplate = configdomain.NewShipMessageTemplateRef(value)
 	case KeySyncBeforeShip:
 		config.SyncBeforeShip, err = configdomain.ParseSyncBeforeShipRef(value, KeySyncBeforeShip.String())
 	case KeySyncFeatureStrategy:
@@ -113,6 +159,8 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 		config.SyncPerennialStrategy, err = configdomain.NewSyncPerennialStrategyRef(value)
 	case KeySyncUpstream:
 		config.SyncUpstream, err = configdomain.ParseSyncUpstreamRef(value, KeySyncUpstream.String())
+	case KeyTicketRegex:
+		config.TicketRegex = configdomain.NewTicketRegexRef(value)
 	case KeyDeprecatedCodeHostingDriver,
 		KeyDeprecatedCodeHostingOriginHostname,
 		KeyDeprecatedCodeHostingPlatform,
@@ -129,6 +177,36 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 	return err
 }
 
+// DeprecatedSettings provides the deprecated Git Town settings that remain in any scope of the Git configuration.
+// Git Town migrates deprecated settings in the local and global Git configuration when loading them,
+// so this finds those in places Git Town doesn't migrate, like the system configuration or included files.
+func (self *Access) DeprecatedSettings() []DeprecatedSetting {
+	result := []DeprecatedSetting{}
+	output, err := self.Runner.Query("git", "config", "-lz", "--show-origin")
+	if err != nil {
+		return result
+	}
+	entries := strings.Split(output, "\x00")
+	for i := 0; i+1 < len(entries); i += 2 {
+		origin := entries[i]
+		key, _, _ := strings.Cut(entries[i+1], "\n")
+		configKey := ParseKey(key)
+		if configKey == nil {
+			continue
+		}
+		newKey, isDeprecated := DeprecatedKeys[*configKey]
+		if !isDeprecated {
+			continue
+		}
+		result = append(result, DeprecatedSetting{
+			Key:    *configKey,
+			NewKey: newKey,
+			Origin: origin,
+		})
+	}
+	return result
+}
+
 func (self *Access) OriginRemote() string {
 	output, _ := self.Query("git", "remote", "get-url", gitdomain.OriginRemote.String())
 	return strings.TrimSpace(output)
@@ -144,7 +222,7 @@ func (self *Access) RemoveLocalConfigValue(key Key) error {
 }
 
 // RemoveLocalGitConfiguration removes all Git Town configuration.
-func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage) error {
+func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage, branchSyncStrategies configdomain.BranchSyncStrategies) error {
 	err := self.Run("git", "config", "--remove-section", "git-town")
 	if err != nil {
 		var exitErr *exec.ExitError
@@ -164,6 +242,12 @@ func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage) er
 			return fmt.Errorf(messages.ConfigRemoveError, err)
 		}
 	}
+	for branch := range branchSyncStrategies {
+		err = self.RemoveLocalConfigValue(NewSyncStrategyKey(branch))
+		if err != nil {
+			return fmt.Errorf(messages.ConfigRemoveError, err)
+		}
+	}
 	return nil
 }
 
@@ -209,9 +293,10 @@ func (self *Access) UpdateDeprecatedSetting(oldKey, newKey Key, value string, gl
 	}
 }
 
-func (self *Access) load(global bool) (SingleSnapshot, configdomain.PartialConfig, error) {
+func (self *Access) load(global bool) (SingleSnapshot, configdomain.PartialConfig, []IgnoredValueError, error) {
 	snapshot := SingleSnapshot{}
 	config := configdomain.EmptyPartialConfig()
+	ignoredValues := []IgnoredValueError{}
 	cmdArgs := []string{"config", "-lz"}
 	if global {
 		cmdArgs = append(cmdArgs, "--global")
@@ -220,10 +305,10 @@ func (self *Access) load(global bool) (SingleSnapshot, configdomain.PartialConfi
 	}
 	output, err := self.Runner.Query("git", cmdArgs...)
 	if err != nil {
-		return snapshot, config, nil //nolint:nilerr
+		return snapshot, config, ignoredValues, nil //nolint:nilerr
 	}
 	if output == "" {
-		return snapshot, config, nil
+		return snapshot, config, ignoredValues, nil
 	}
 	for _, line := range strings.Split(output, "\x00") {
 		if len(line) == 0 {
@@ -247,8 +332,13 @@ func (self *Access) load(global bool) (SingleSnapshot, configdomain.PartialConfi
 		snapshot[*configKey] = value
 		err := AddKeyToPartialConfig(*configKey, value, &config)
 		if err != nil {
-			return snapshot, config, err
+			var ignoredValue IgnoredValueError
+			if errors.As(err, &ignoredValue) {
+				ignoredValues = append(ignoredValues, ignoredValue)
+				continue
+			}
+			return snapshot, config, ignoredValues, err
 		}
 	}
-	return snapshot, config, nil
+	return snapshot, config, ignoredValues, nil
 }
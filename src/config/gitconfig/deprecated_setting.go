@@ -0,0 +1,8 @@
+package gitconfig
+
+// DeprecatedSetting describes a deprecated Git Town setting that still exists in the Git configuration.
+type DeprecatedSetting struct {
+	Key    Key    // the deprecated key
+	NewKey Key    // the up-to-date counterpart of Key
+	Origin string // where Git found this setting, for example "file:/etc/gitconfig"
+}
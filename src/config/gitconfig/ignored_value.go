@@ -0,0 +1,17 @@
+package gitconfig
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// IgnoredValueError describes a setting in the Git configuration whose invalid value Git Town ignores.
+type IgnoredValueError struct {
+	Key   Key
+	Value string
+}
+
+func (self IgnoredValueError) Error() string {
+	return fmt.Sprintf(messages.SettingSyncStrategyIgnoreInvalid, self.Value, self.Key)
+}
@@ -43,6 +43,9 @@ const (
 	KeyAliasShip                           = Key("alias.ship")
 	KeyAliasSync                           = Key("alias.sync")
 	KeyContributionBranches                = Key("git-town.contribution-branches")
+	KeyContributionRegex                   = Key("git-town.contribution-regex")
+	KeyDefaultBranchType                   = Key("git-town.default-branch-type")
+	KeyDefaultRemoteBranchType             = Key("git-town.default-remote-branch-type")
 	KeyDeprecatedCodeHostingDriver         = Key("git-town.code-hosting-driver")
 	KeyDeprecatedCodeHostingOriginHostname = Key("git-town.code-hosting-origin-hostname")
 	KeyDeprecatedCodeHostingPlatform       = Key("git-town.code-hosting-platform")
@@ -56,30 +59,43 @@ const (
 	KeyGiteaToken                          = Key("git-town.gitea-token")
 	KeyGithubToken                         = Key("git-town.github-token")
 	KeyGitlabToken                         = Key("git-town.gitlab-token")
+	KeyHostingAPIURL                       = Key("git-town.hosting-api-url")
 	KeyHostingOriginHostname               = Key("git-town.hosting-origin-hostname")
 	KeyHostingPlatform                     = Key("git-town.hosting-platform")
 	KeyMainBranch                          = Key("git-town.main-branch")
 	KeyObservedBranches                    = Key("git-town.observed-branches")
+	KeyObservedRegex                       = Key("git-town.observed-regex")
 	KeyOffline                             = Key("git-town.offline")
 	KeyParkedBranches                      = Key("git-town.parked-branches")
+	KeyParkedRegex                         = Key("git-town.parked-regex")
 	KeyPerennialBranches                   = Key("git-town.perennial-branches")
 	KeyPerennialRegex                      = Key("git-town.perennial-regex")
+	KeyPrototypeBranches                   = Key("git-town.prototype-branches")
 	KeyPushHook                            = Key("git-town.push-hook")
 	KeyPushNewBranches                     = Key("git-town.push-new-branches")
+	KeyShareLineage                        = Key("git-town.share-lineage")
 	KeyShipDeleteTrackingBranch            = Key("git-town.ship-delete-tracking-branch")
+	KeyShipMessageTemplate                 = Key("git-town.ship-message-template")
 	KeySyncBeforeShip                      = Key("git-town.sync-before-ship")
 	KeySyncFeatureStrategy                 = Key("git-town.sync-feature-strategy")
 	KeySyncPerennialStrategy               = Key("git-town.sync-perennial-strategy")
 	KeySyncStrategy                        = Key("git-town.sync-strategy")
 	KeySyncUpstream                        = Key("git-town.sync-upstream")
+	KeyTicketRegex                         = Key("git-town.ticket-regex")
+	KeyGitPushDefault                      = Key("remote.pushdefault")
+	KeyGitUpstreamURL                      = Key("remote.upstream.url")
 	KeyGitUserEmail                        = Key("user.email")
 	KeyGitUserName                         = Key("user.name")
 )
 
 var keys = []Key{ //nolint:gochecknoglobals
+	KeyHostingAPIURL,
 	KeyHostingOriginHostname,
 	KeyHostingPlatform,
 	KeyContributionBranches,
+	KeyContributionRegex,
+	KeyDefaultBranchType,
+	KeyDefaultRemoteBranchType,
 	KeyDeprecatedCodeHostingDriver,
 	KeyDeprecatedCodeHostingOriginHostname,
 	KeyDeprecatedCodeHostingPlatform,
@@ -93,22 +109,35 @@ var keys = []Key{ //nolint:gochecknoglobals
 	KeyGiteaToken,
 	KeyGithubToken,
 	KeyGitlabToken,
+	KeyGitPushDefault,
+	KeyGitUpstreamURL,
 	KeyGitUserEmail,
 	KeyGitUserName,
 	KeyMainBranch,
 	KeyObservedBranches,
+	KeyObservedRegex,
 	KeyOffline,
 	KeyParkedBranches,
+	KeyParkedRegex,
 	KeyPerennialBranches,
 	KeyPerennialRegex,
+	KeyPrototypeBranches,
 	KeyPushHook,
 	KeyPushNewBranches,
+	KeyShareLineage,
 	KeyShipDeleteTrackingBranch,
+	KeyShipMessageTemplate,
 	KeySyncBeforeShip,
 	KeySyncFeatureStrategy,
 	KeySyncPerennialStrategy,
 	KeySyncStrategy,
 	KeySyncUpstream,
+	KeyTicketRegex,
+}
+
+// Keys provides the keys of all settings that aren't aliases or specific to a branch.
+func Keys() []Key {
+	return keys
 }
 
 func AliasableCommandForKey(key Key) *configdomain.AliasableCommand {
@@ -158,6 +187,20 @@ func NewParentKey(branch gitdomain.LocalBranchName) Key {
 	return Key(fmt.Sprintf("git-town-branch.%s.parent", branch))
 }
 
+func NewPushRemoteKey(branch gitdomain.LocalBranchName) Key {
+	return Key(fmt.Sprintf("branch.%s.pushremote", branch))
+}
+
+func NewSyncStrategyKey(branch gitdomain.LocalBranchName) Key {
+	return Key(fmt.Sprintf("git-town-branch.%s.sync-strategy", branch))
+}
+
+// IsBranchKey indicates whether the given Git configuration key belongs to the lineage
+// or to a setting for a specific branch.
+func IsBranchKey(name string) bool {
+	return parseLineageKey(name) != nil || parsePushRemoteKey(name) != nil || parseSyncStrategyKey(name) != nil
+}
+
 func ParseKey(name string) *Key {
 	for _, configKey := range keys {
 		if configKey.String() == name {
@@ -168,6 +211,14 @@ func ParseKey(name string) *Key {
 	if lineageKey != nil {
 		return lineageKey
 	}
+	pushRemoteKey := parsePushRemoteKey(name)
+	if pushRemoteKey != nil {
+		return pushRemoteKey
+	}
+	syncStrategyKey := parseSyncStrategyKey(name)
+	if syncStrategyKey != nil {
+		return syncStrategyKey
+	}
 	for _, aliasableCommand := range configdomain.AllAliasableCommands() {
 		key := KeyForAliasableCommand(aliasableCommand)
 		if key.String() == name {
@@ -185,6 +236,24 @@ func parseLineageKey(key string) *Key {
 	return &result
 }
 
+// parsePushRemoteKey recognizes Git's "branch.<name>.pushRemote" setting.
+// Git provides the names of config settings in lowercase.
+func parsePushRemoteKey(key string) *Key {
+	if !strings.HasPrefix(key, "branch.") || !strings.HasSuffix(key, ".pushremote") {
+		return nil
+	}
+	result := Key(key)
+	return &result
+}
+
+func parseSyncStrategyKey(key string) *Key {
+	if !strings.HasPrefix(key, "git-town-branch.") || !strings.HasSuffix(key, ".sync-strategy") {
+		return nil
+	}
+	result := Key(key)
+	return &result
+}
+
 // DeprecatedKeys defines the up-to-date counterparts to deprecated configuration settings.
 var DeprecatedKeys = map[Key]Key{ //nolint:gochecknoglobals
 	KeyDeprecatedCodeHostingDriver:         KeyHostingPlatform,
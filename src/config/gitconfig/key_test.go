@@ -38,6 +38,47 @@ func TestKey(t *testing.T) {
 				must.Nil(t, have)
 			})
 		})
+		t.Run("sync strategy keys", func(t *testing.T) {
+			t.Parallel()
+			t.Run("valid sync strategy key", func(t *testing.T) {
+				t.Parallel()
+				give := "git-town-branch.branch-1.sync-strategy"
+				have := gitconfig.ParseKey(give)
+				want := gitconfig.NewSyncStrategyKey("branch-1")
+				must.EqOp(t, want, *have)
+			})
+			t.Run("sync strategy key without prefix", func(t *testing.T) {
+				t.Parallel()
+				have := gitconfig.ParseKey("git-town.branch-1.sync-strategy")
+				must.Nil(t, have)
+			})
+		})
+		t.Run("remote keys", func(t *testing.T) {
+			t.Parallel()
+			t.Run("branch push remote", func(t *testing.T) {
+				t.Parallel()
+				have := gitconfig.ParseKey("branch.branch-1.pushremote")
+				must.NotNil(t, have)
+				must.EqOp(t, gitconfig.NewPushRemoteKey("branch-1"), *have)
+			})
+			t.Run("push default", func(t *testing.T) {
+				t.Parallel()
+				have := gitconfig.ParseKey("remote.pushdefault")
+				must.NotNil(t, have)
+				must.EqOp(t, gitconfig.KeyGitPushDefault, *have)
+			})
+			t.Run("upstream URL", func(t *testing.T) {
+				t.Parallel()
+				have := gitconfig.ParseKey("remote.upstream.url")
+				must.NotNil(t, have)
+				must.EqOp(t, gitconfig.KeyGitUpstreamURL, *have)
+			})
+			t.Run("other branch setting", func(t *testing.T) {
+				t.Parallel()
+				have := gitconfig.ParseKey("branch.branch-1.remote")
+				must.Nil(t, have)
+			})
+		})
 		t.Run("alias key", func(t *testing.T) {
 			t.Parallel()
 			t.Run("valid alias", func(t *testing.T) {
@@ -0,0 +1,41 @@
+package settings
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+)
+
+// All provides all settings, sorted alphabetically by name.
+func All() []Setting {
+	return []Setting{
+		{Key: gitconfig.KeyContributionBranches, List: true, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.ContributionBranches.Join(" ") }},
+		{Key: gitconfig.KeyContributionRegex, List: false, Regex: true, Value: func(config *configdomain.FullConfig) string { return config.ContributionRegex.String() }},
+		{Key: gitconfig.KeyDefaultBranchType, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.DefaultBranchType.String() }},
+		{Key: gitconfig.KeyDefaultRemoteBranchType, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.DefaultRemoteBranchType.String() }},
+		{Key: gitconfig.KeyGiteaToken, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.GiteaToken.String() }},
+		{Key: gitconfig.KeyGithubToken, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.GitHubToken.String() }},
+		{Key: gitconfig.KeyGitlabToken, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.GitLabToken.String() }},
+		{Key: gitconfig.KeyHostingAPIURL, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.HostingAPIURL.String() }},
+		{Key: gitconfig.KeyHostingOriginHostname, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.HostingOriginHostname.String() }},
+		{Key: gitconfig.KeyHostingPlatform, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.HostingPlatform.String() }},
+		{Key: gitconfig.KeyMainBranch, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.MainBranch.String() }},
+		{Key: gitconfig.KeyObservedBranches, List: true, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.ObservedBranches.Join(" ") }},
+		{Key: gitconfig.KeyObservedRegex, List: false, Regex: true, Value: func(config *configdomain.FullConfig) string { return config.ObservedRegex.String() }},
+		{Key: gitconfig.KeyOffline, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.Offline.String() }},
+		{Key: gitconfig.KeyParkedBranches, List: true, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.ParkedBranches.Join(" ") }},
+		{Key: gitconfig.KeyParkedRegex, List: false, Regex: true, Value: func(config *configdomain.FullConfig) string { return config.ParkedRegex.String() }},
+		{Key: gitconfig.KeyPerennialBranches, List: true, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.PerennialBranches.Join(" ") }},
+		{Key: gitconfig.KeyPerennialRegex, List: false, Regex: true, Value: func(config *configdomain.FullConfig) string { return config.PerennialRegex.String() }},
+		{Key: gitconfig.KeyPrototypeBranches, List: true, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.PrototypeBranches.Join(" ") }},
+		{Key: gitconfig.KeyPushHook, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.PushHook.String() }},
+		{Key: gitconfig.KeyPushNewBranches, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.PushNewBranches.String() }},
+		{Key: gitconfig.KeyShareLineage, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.ShareLineage.String() }},
+		{Key: gitconfig.KeyShipDeleteTrackingBranch, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.ShipDeleteTrackingBranch.String() }},
+		{Key: gitconfig.KeyShipMessageTemplate, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.ShipMessageTemplate.String() }},
+		{Key: gitconfig.KeySyncBeforeShip, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.SyncBeforeShip.String() }},
+		{Key: gitconfig.KeySyncFeatureStrategy, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.SyncFeatureStrategy.String() }},
+		{Key: gitconfig.KeySyncPerennialStrategy, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.SyncPerennialStrategy.String() }},
+		{Key: gitconfig.KeySyncUpstream, List: false, Regex: false, Value: func(config *configdomain.FullConfig) string { return config.SyncUpstream.String() }},
+		{Key: gitconfig.KeyTicketRegex, List: false, Regex: true, Value: func(config *configdomain.FullConfig) string { return config.TicketRegex.String() }},
+	}
+}
@@ -0,0 +1,102 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// Answers contains predefined answers for the setup assistant, keyed by the name of the setting they configure.
+// Values are formatted like in the Git configuration, lists are space-separated.
+type Answers map[string]string
+
+// ParseAnswers provides the answers in the given content of an answers file.
+// Files with the extension ".json" contain JSON, all other files contain TOML.
+// Both contain a flat map from setting name to a string, boolean, number, or list of strings.
+func ParseAnswers(fileName string, content []byte) (Answers, error) {
+	raw := map[string]any{}
+	var err error
+	if filepath.Ext(fileName) == ".json" {
+		err = json.Unmarshal(content, &raw)
+	} else {
+		_, err = toml.Decode(string(content), &raw)
+	}
+	if err != nil {
+		return Answers{}, fmt.Errorf(messages.SetupAnswersCannotParse, fileName, err)
+	}
+	result := Answers{}
+	for name, value := range raw {
+		text, isValid := answerText(value)
+		if !isValid {
+			return result, fmt.Errorf(messages.SetupAnswerValueInvalid, name, value)
+		}
+		result[name] = text
+	}
+	return result, nil
+}
+
+// Add registers the given answer in the format "name=value".
+func (self Answers) Add(assignment string) error {
+	name, value, hasValue := strings.Cut(assignment, "=")
+	if !hasValue || name == "" {
+		return fmt.Errorf(messages.SetupAnswerInvalid, assignment)
+	}
+	self[name] = value
+	return nil
+}
+
+// PartialConfig provides the settings that the given answers configure.
+// The given keys must contain the settings that the answers may configure.
+func (self Answers) PartialConfig(keys []gitconfig.Key) (configdomain.PartialConfig, error) {
+	result := configdomain.EmptyPartialConfig()
+	for name, value := range self {
+		setting, err := Lookup(name)
+		if err != nil {
+			return result, err
+		}
+		if !slices.Contains(keys, setting.Key) {
+			return result, fmt.Errorf(messages.SetupAnswerUnsupported, setting.Name())
+		}
+		if err := setting.Validate(value); err != nil {
+			return result, err
+		}
+		if err := gitconfig.AddKeyToPartialConfig(setting.Key, value, &result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// answerText provides the given decoded value of an answers file formatted like in the Git configuration.
+// Indicates whether the value has a supported type.
+func answerText(value any) (string, bool) {
+	switch typed := value.(type) {
+	case string:
+		return typed, true
+	case bool:
+		return strconv.FormatBool(typed), true
+	case int64:
+		return strconv.FormatInt(typed, 10), true
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), true
+	case []any:
+		elements := make([]string, len(typed))
+		for e, element := range typed {
+			text, isText := element.(string)
+			if !isText {
+				return "", false
+			}
+			elements[e] = text
+		}
+		return strings.Join(elements, " "), true
+	}
+	return "", false
+}
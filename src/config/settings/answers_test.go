@@ -0,0 +1,95 @@
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/config/settings"
+	"github.com/shoenig/test/must"
+)
+
+func TestAnswers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ParseAnswers", func(t *testing.T) {
+		t.Parallel()
+		t.Run("TOML", func(t *testing.T) {
+			t.Parallel()
+			give := `
+main-branch = "main"
+perennial-branches = ["qa", "staging"]
+push-hook = false
+`[1:]
+			have, err := settings.ParseAnswers("answers.toml", []byte(give))
+			must.NoError(t, err)
+			want := settings.Answers{
+				"main-branch":        "main",
+				"perennial-branches": "qa staging",
+				"push-hook":          "false",
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("JSON", func(t *testing.T) {
+			t.Parallel()
+			give := `{"main-branch": "main", "perennial-branches": ["qa"], "sync-upstream": true}`
+			have, err := settings.ParseAnswers("answers.json", []byte(give))
+			must.NoError(t, err)
+			want := settings.Answers{
+				"main-branch":        "main",
+				"perennial-branches": "qa",
+				"sync-upstream":      "true",
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("unsupported value", func(t *testing.T) {
+			t.Parallel()
+			_, err := settings.ParseAnswers("answers.toml", []byte("[branches]\nmain = \"main\"\n"))
+			must.ErrorContains(t, err, `unsupported value for answer "branches"`)
+		})
+		t.Run("invalid content", func(t *testing.T) {
+			t.Parallel()
+			_, err := settings.ParseAnswers("answers.json", []byte("{"))
+			must.ErrorContains(t, err, `cannot parse the answers file "answers.json"`)
+		})
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		t.Parallel()
+		answers := settings.Answers{}
+		must.NoError(t, answers.Add("ship-message-template={{proposal-title}} = done"))
+		must.Eq(t, settings.Answers{"ship-message-template": "{{proposal-title}} = done"}, answers)
+		must.ErrorContains(t, answers.Add("main-branch"), `invalid answer "main-branch"`)
+	})
+
+	t.Run("PartialConfig", func(t *testing.T) {
+		t.Parallel()
+		keys := []gitconfig.Key{gitconfig.KeyMainBranch, gitconfig.KeySyncFeatureStrategy}
+		t.Run("valid answers", func(t *testing.T) {
+			t.Parallel()
+			answers := settings.Answers{"main-branch": "trunk", "sync-feature-strategy": "rebase"}
+			have, err := answers.PartialConfig(keys)
+			must.NoError(t, err)
+			must.EqOp(t, "trunk", have.MainBranch.String())
+			must.EqOp(t, configdomain.SyncFeatureStrategyRebase, *have.SyncFeatureStrategy)
+		})
+		t.Run("invalid value", func(t *testing.T) {
+			t.Parallel()
+			answers := settings.Answers{"sync-feature-strategy": "zonk"}
+			_, err := answers.PartialConfig(keys)
+			must.ErrorContains(t, err, `unknown sync-feature strategy: "zonk"`)
+		})
+		t.Run("unsupported setting", func(t *testing.T) {
+			t.Parallel()
+			answers := settings.Answers{"offline": "true"}
+			_, err := answers.PartialConfig(keys)
+			must.ErrorContains(t, err, `the setup assistant doesn't configure setting "offline"`)
+		})
+		t.Run("unknown setting", func(t *testing.T) {
+			t.Parallel()
+			answers := settings.Answers{"zonk": "true"}
+			_, err := answers.PartialConfig(keys)
+			must.ErrorContains(t, err, `unknown setting "zonk"`)
+		})
+	})
+}
@@ -0,0 +1,3 @@
+// Package settings describes the Git Town settings that users can read and change
+// with "git town config get/set/unset", and where their effective values come from.
+package settings
@@ -0,0 +1,50 @@
+package settings
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+)
+
+// Dump contains the effective Git Town configuration in a machine-readable format.
+type Dump struct {
+	Branches   map[string][]Origin  `json:"branches,omitempty"` // all values of the lineage and branch-specific settings, only populated when displaying origins
+	Deprecated []Deprecated         `json:"deprecated,omitempty"`
+	Lineage    configdomain.Lineage `json:"lineage"`
+	Settings   map[string]Entry     `json:"settings"`
+}
+
+// AddOrigins adds the values of all settings in all configuration layers and the given deprecated settings to this Dump.
+func (self *Dump) AddOrigins(configOrigins configdomain.ConfigOrigins, deprecatedSettings []gitconfig.DeprecatedSetting) {
+	for _, setting := range All() {
+		entry := self.Settings[setting.Name()]
+		entry.Origins = Origins(setting, configOrigins)
+		self.Settings[setting.Name()] = entry
+	}
+	self.Branches = BranchOrigins(configOrigins)
+	self.Deprecated = NewDeprecated(deprecatedSettings)
+}
+
+// Entry contains the effective value of a setting and where it comes from.
+type Entry struct {
+	Origins []Origin `json:"origins,omitempty"` // all values of this setting, only populated when displaying origins
+	Source  Source   `json:"source"`
+	Value   string   `json:"value"`
+}
+
+// NewDump provides the effective values of all settings in the given config and where they come from.
+func NewDump(config *configdomain.FullConfig, configOrigins configdomain.ConfigOrigins) Dump {
+	result := Dump{
+		Branches:   nil,
+		Deprecated: nil,
+		Lineage:    config.Lineage,
+		Settings:   make(map[string]Entry, len(All())),
+	}
+	for _, setting := range All() {
+		result.Settings[setting.Name()] = Entry{
+			Origins: nil,
+			Source:  SourceOf(setting, configOrigins),
+			Value:   setting.Value(config),
+		}
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+package settings
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+)
+
+// Origin describes a value of a setting in one configuration layer.
+type Origin struct {
+	Active bool   `json:"active"` // whether this value contributes to the effective value of the setting
+	Source Source `json:"source"`
+	Value  string `json:"value"`
+}
+
+// Deprecated describes a deprecated setting that still exists somewhere in the Git configuration.
+type Deprecated struct {
+	Key    string `json:"key"`
+	NewKey string `json:"newKey"`
+	Origin string `json:"origin"`
+}
+
+// NewDeprecated provides the given deprecated Git settings in a machine-readable format.
+func NewDeprecated(deprecatedSettings []gitconfig.DeprecatedSetting) []Deprecated {
+	result := make([]Deprecated, len(deprecatedSettings))
+	for d, deprecatedSetting := range deprecatedSettings {
+		result[d] = Deprecated{
+			Key:    deprecatedSetting.Key.String(),
+			NewKey: deprecatedSetting.NewKey.String(),
+			Origin: deprecatedSetting.Origin,
+		}
+	}
+	return result
+}
+
+// BranchOrigins provides all values of the lineage and of the settings for specific branches,
+// keyed by Git configuration key and ordered from highest to lowest precedence.
+func BranchOrigins(configOrigins configdomain.ConfigOrigins) map[string][]Origin {
+	result := map[string][]Origin{}
+	for key, origins := range configOrigins {
+		if !gitconfig.IsBranchKey(key) {
+			continue
+		}
+		for o, origin := range origins {
+			result[key] = append(result[key], Origin{
+				Active: o == 0,
+				Source: origin.Source,
+				Value:  origin.Value,
+			})
+		}
+	}
+	return result
+}
+
+// Origins provides all values of the given setting, ordered from highest to lowest precedence.
+// Values that the effective value overrides are marked as inactive.
+// If no configuration layer contains the setting, this provides the default value.
+func Origins(setting Setting, configOrigins configdomain.ConfigOrigins) []Origin {
+	result := []Origin{}
+	for _, configOrigin := range configOrigins[setting.Key.String()] {
+		result = append(result, Origin{
+			Active: len(result) == 0 || setting.List,
+			Source: configOrigin.Source,
+			Value:  configOrigin.Value,
+		})
+	}
+	if len(result) == 0 {
+		defaultConfig := configdomain.DefaultConfig()
+		result = append(result, Origin{
+			Active: true,
+			Source: SourceDefault,
+			Value:  setting.Value(&defaultConfig),
+		})
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package settings
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// Setting describes a single Git Town setting.
+type Setting struct {
+	Key   gitconfig.Key                                // key of this setting in the Git configuration
+	List  bool                                         // whether Git Town combines the values of this setting from all configuration layers instead of using only the one with the highest precedence
+	Regex bool                                         // whether the value of this setting is a regular expression
+	Value func(config *configdomain.FullConfig) string // effective value of this setting, formatted like in the Git configuration
+}
+
+// Name provides the name under which users refer to this setting.
+func (self Setting) Name() string {
+	return strings.TrimPrefix(self.Key.String(), "git-town.")
+}
+
+// Validate indicates whether the given value is valid for this setting.
+func (self Setting) Validate(value string) error {
+	partialConfig := configdomain.EmptyPartialConfig()
+	if err := gitconfig.AddKeyToPartialConfig(self.Key, value, &partialConfig); err != nil {
+		return err
+	}
+	if self.Regex {
+		if _, err := regexp.Compile(value); err != nil {
+			return fmt.Errorf(messages.SettingRegexInvalid, self.Name(), value, err)
+		}
+	}
+	return nil
+}
+
+// Lookup provides the setting with the given name.
+// The name can also be the full Git configuration key, like "git-town.main-branch".
+func Lookup(name string) (Setting, error) {
+	for _, setting := range All() {
+		if setting.Name() == name || setting.Key.String() == name {
+			return setting, nil
+		}
+	}
+	return Setting{}, fmt.Errorf(messages.SettingUnknown, name, strings.Join(Names(), ", ")) //nolint:exhaustruct
+}
+
+// Names provides the names of all settings.
+func Names() []string {
+	all := All()
+	result := make([]string, len(all))
+	for s, setting := range all {
+		result[s] = setting.Name()
+	}
+	return result
+}
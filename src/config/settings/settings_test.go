@@ -0,0 +1,154 @@
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/config/settings"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("All", func(t *testing.T) {
+		t.Parallel()
+		t.Run("sorted by name", func(t *testing.T) {
+			t.Parallel()
+			names := settings.Names()
+			for n := 1; n < len(names); n++ {
+				must.Less(t, names[n], names[n-1])
+			}
+		})
+		t.Run("effective value of every setting can be stored again", func(t *testing.T) {
+			t.Parallel()
+			config := configdomain.DefaultConfig()
+			for _, setting := range settings.All() {
+				must.NoError(t, setting.Validate(setting.Value(&config)), must.Sprint(setting.Name()))
+			}
+		})
+	})
+
+	t.Run("Lookup", func(t *testing.T) {
+		t.Parallel()
+		t.Run("by name", func(t *testing.T) {
+			t.Parallel()
+			have, err := settings.Lookup("sync-feature-strategy")
+			must.NoError(t, err)
+			must.EqOp(t, gitconfig.KeySyncFeatureStrategy, have.Key)
+		})
+		t.Run("by Git configuration key", func(t *testing.T) {
+			t.Parallel()
+			have, err := settings.Lookup("git-town.main-branch")
+			must.NoError(t, err)
+			must.EqOp(t, gitconfig.KeyMainBranch, have.Key)
+		})
+		t.Run("unknown setting", func(t *testing.T) {
+			t.Parallel()
+			_, err := settings.Lookup("zonk")
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		t.Parallel()
+		syncStrategy, err := settings.Lookup("sync-feature-strategy")
+		must.NoError(t, err)
+		must.NoError(t, syncStrategy.Validate("rebase"))
+		must.Error(t, syncStrategy.Validate("zonk"))
+		offline, err := settings.Lookup("offline")
+		must.NoError(t, err)
+		must.NoError(t, offline.Validate("yes"))
+		must.Error(t, offline.Validate("zonk"))
+		perennialRegex, err := settings.Lookup("perennial-regex")
+		must.NoError(t, err)
+		must.NoError(t, perennialRegex.Validate("^release-"))
+		must.Error(t, perennialRegex.Validate("("))
+	})
+
+	t.Run("SourceOf", func(t *testing.T) {
+		t.Parallel()
+		mainBranch, err := settings.Lookup("main-branch")
+		must.NoError(t, err)
+		configOrigins := configdomain.ConfigOrigins{}
+		configOrigins.Add(settings.SourceFile, partialConfig(gitconfig.KeyMainBranch, "main"))
+		must.EqOp(t, settings.SourceFile, settings.SourceOf(mainBranch, configOrigins))
+		configOrigins.Add(settings.SourceUser, partialConfig(gitconfig.KeyMainBranch, "trunk"))
+		must.EqOp(t, settings.SourceUser, settings.SourceOf(mainBranch, configOrigins))
+		configOrigins.Add(settings.SourceGlobal, partialConfig(gitconfig.KeyMainBranch, "master"))
+		must.EqOp(t, settings.SourceGlobal, settings.SourceOf(mainBranch, configOrigins))
+		value, has := settings.ValueIn(mainBranch, settings.SourceFile, configOrigins)
+		must.True(t, has)
+		must.EqOp(t, "main", value)
+		_, has = settings.ValueIn(mainBranch, settings.SourceLocal, configOrigins)
+		must.False(t, has)
+		offline, err := settings.Lookup("offline")
+		must.NoError(t, err)
+		must.EqOp(t, settings.SourceDefault, settings.SourceOf(offline, configOrigins))
+	})
+
+	t.Run("Origins", func(t *testing.T) {
+		t.Parallel()
+		configOrigins := configdomain.ConfigOrigins{}
+		fileConfig := partialConfig(gitconfig.KeyMainBranch, "main")
+		fileConfig.Values[gitconfig.KeyPerennialBranches.String()] = "qa"
+		configOrigins.Add(settings.SourceFile, fileConfig)
+		configOrigins.Add(settings.SourceUser, partialConfig(gitconfig.KeyMainBranch, "trunk"))
+		parentKey := gitconfig.NewParentKey(gitdomain.NewLocalBranchName("feature"))
+		globalConfig := partialConfig(gitconfig.KeyMainBranch, "master")
+		globalConfig.Values[parentKey.String()] = "qa"
+		configOrigins.Add(settings.SourceGlobal, globalConfig)
+		localConfig := partialConfig(gitconfig.KeyPerennialBranches, "staging")
+		localConfig.Values[parentKey.String()] = "main"
+		configOrigins.Add(settings.SourceLocal, localConfig)
+		t.Run("shadowed values", func(t *testing.T) {
+			t.Parallel()
+			mainBranch, err := settings.Lookup("main-branch")
+			must.NoError(t, err)
+			want := []settings.Origin{
+				{Active: true, Source: settings.SourceGlobal, Value: "master"},
+				{Active: false, Source: settings.SourceUser, Value: "trunk"},
+				{Active: false, Source: settings.SourceFile, Value: "main"},
+			}
+			must.Eq(t, want, settings.Origins(mainBranch, configOrigins))
+		})
+		t.Run("list setting combines all values", func(t *testing.T) {
+			t.Parallel()
+			perennialBranches, err := settings.Lookup("perennial-branches")
+			must.NoError(t, err)
+			want := []settings.Origin{
+				{Active: true, Source: settings.SourceLocal, Value: "staging"},
+				{Active: true, Source: settings.SourceFile, Value: "qa"},
+			}
+			must.Eq(t, want, settings.Origins(perennialBranches, configOrigins))
+		})
+		t.Run("default value", func(t *testing.T) {
+			t.Parallel()
+			syncUpstream, err := settings.Lookup("sync-upstream")
+			must.NoError(t, err)
+			want := []settings.Origin{
+				{Active: true, Source: settings.SourceDefault, Value: "true"},
+			}
+			must.Eq(t, want, settings.Origins(syncUpstream, configOrigins))
+		})
+		t.Run("lineage", func(t *testing.T) {
+			t.Parallel()
+			want := map[string][]settings.Origin{
+				"git-town-branch.feature.parent": {
+					{Active: true, Source: settings.SourceLocal, Value: "main"},
+					{Active: false, Source: settings.SourceGlobal, Value: "qa"},
+				},
+			}
+			must.Eq(t, want, settings.BranchOrigins(configOrigins))
+		})
+	})
+}
+
+// partialConfig provides a configuration layer that contains the given raw value.
+func partialConfig(key gitconfig.Key, value string) configdomain.PartialConfig {
+	result := configdomain.EmptyPartialConfig()
+	result.Values[key.String()] = value
+	return result
+}
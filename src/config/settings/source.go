@@ -0,0 +1,36 @@
+package settings
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+)
+
+// Source describes a configuration layer that can contain the value of a setting.
+type Source = configdomain.ConfigSource
+
+const (
+	SourceDefault = configdomain.ConfigSourceDefault // the built-in default value of Git Town
+	SourceEnv     = configdomain.ConfigSourceEnv     // the GIT_TOWN_* environment variables
+	SourceFile    = configdomain.ConfigSourceFile    // the configuration file in the repository
+	SourceGlobal  = configdomain.ConfigSourceGlobal  // the global Git configuration
+	SourceLocal   = configdomain.ConfigSourceLocal   // the local Git configuration of the repository
+	SourceUser    = configdomain.ConfigSourceUser    // the user-level configuration file
+)
+
+// SourceOf provides the configuration layer that the effective value of the given setting comes from.
+func SourceOf(setting Setting, configOrigins configdomain.ConfigOrigins) Source {
+	origins := configOrigins[setting.Key.String()]
+	if len(origins) == 0 {
+		return SourceDefault
+	}
+	return origins[0].Source
+}
+
+// ValueIn provides the raw value of the given setting in the given configuration layer.
+func ValueIn(setting Setting, source Source, configOrigins configdomain.ConfigOrigins) (string, bool) {
+	for _, origin := range configOrigins[setting.Key.String()] {
+		if origin.Source == source {
+			return origin.Value, true
+		}
+	}
+	return "", false
+}
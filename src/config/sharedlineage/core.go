@@ -0,0 +1,11 @@
+// Package sharedlineage stores the lineage and the perennial branches in a dedicated Git ref
+// that gets pushed to and fetched from the origin remote, so that teammates can share this information.
+package sharedlineage
+
+const (
+	// Ref is the Git ref that contains the shared lineage.
+	Ref = "refs/git-town/lineage"
+
+	// TrackingRef is the local Git ref that contains the shared lineage as last fetched from the origin remote.
+	TrackingRef = "refs/git-town/origin-lineage"
+)
@@ -0,0 +1,115 @@
+package sharedlineage
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// Data is the information shared via the lineage ref.
+type Data struct {
+	Contributions gitdomain.LocalBranchNames
+	Lineage       configdomain.Lineage
+	Observed      gitdomain.LocalBranchNames
+	Parked        gitdomain.LocalBranchNames
+	Perennials    gitdomain.LocalBranchNames
+}
+
+// Decode parses the given content of the lineage ref.
+func Decode(text string) (Data, error) {
+	var file fileData
+	_, err := toml.Decode(text, &file)
+	if err != nil {
+		return EmptyData(), err
+	}
+	result := EmptyData()
+	for child, parent := range file.Lineage {
+		result.Lineage[gitdomain.NewLocalBranchName(child)] = gitdomain.NewLocalBranchName(parent)
+	}
+	result.Contributions = gitdomain.NewLocalBranchNames(file.Contributions...)
+	result.Observed = gitdomain.NewLocalBranchNames(file.Observed...)
+	result.Parked = gitdomain.NewLocalBranchNames(file.Parked...)
+	result.Perennials = gitdomain.NewLocalBranchNames(file.Perennials...)
+	return result, nil
+}
+
+// EmptyData provides Data that contains no entries.
+func EmptyData() Data {
+	return Data{
+		Contributions: gitdomain.LocalBranchNames{},
+		Lineage:       configdomain.Lineage{},
+		Observed:      gitdomain.LocalBranchNames{},
+		Parked:        gitdomain.LocalBranchNames{},
+		Perennials:    gitdomain.LocalBranchNames{},
+	}
+}
+
+// FromConfig provides the Data that the given configuration would share.
+// Prototype branches are local by definition and therefore not shared.
+func FromConfig(config *configdomain.FullConfig) Data {
+	return Data{
+		Contributions: config.ContributionBranches,
+		Lineage:       config.Lineage,
+		Observed:      config.ObservedBranches,
+		Parked:        config.ParkedBranches,
+		Perennials:    config.PerennialBranches,
+	}
+}
+
+// Encode serializes this Data into the content of the lineage ref.
+// The output is deterministic, so that unchanged data results in the same Git object.
+func (self Data) Encode() string {
+	result := strings.Builder{}
+	result.WriteString("# Git Town lineage shared via " + Ref + "\n")
+	writeList(&result, "contributions", self.Contributions)
+	writeList(&result, "observed", self.Observed)
+	writeList(&result, "parked", self.Parked)
+	writeList(&result, "perennials", self.Perennials)
+	result.WriteString("\n[lineage]\n")
+	children := self.Lineage.BranchNames()
+	for _, child := range children {
+		result.WriteString(quote(child.String()) + " = " + quote(self.Lineage.Parent(child).String()) + "\n")
+	}
+	return result.String()
+}
+
+// HasBranchType indicates whether this Data assigns a branch type to the given branch.
+func (self Data) HasBranchType(branch gitdomain.LocalBranchName) bool {
+	return self.Contributions.Contains(branch) || self.Observed.Contains(branch) || self.Parked.Contains(branch) || self.Perennials.Contains(branch)
+}
+
+// branchLists provides the lists of branches with a particular branch type, in the order in which they get merged.
+func (self *Data) branchLists() []*gitdomain.LocalBranchNames {
+	return []*gitdomain.LocalBranchNames{&self.Perennials, &self.Contributions, &self.Observed, &self.Parked}
+}
+
+// fileData defines the TOML structure of the lineage ref content.
+type fileData struct {
+	Contributions []string          `toml:"contributions"`
+	Lineage       map[string]string `toml:"lineage"`
+	Observed      []string          `toml:"observed"`
+	Parked        []string          `toml:"parked"`
+	Perennials    []string          `toml:"perennials"`
+}
+
+func quote(text string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(text, `\`, `\\`), `"`, `\"`) + `"`
+}
+
+// writeList writes the given branches as a TOML array with the given name, sorted and without duplicates.
+func writeList(result *strings.Builder, name string, branches gitdomain.LocalBranchNames) {
+	names := branches.Strings()
+	slices.Sort(names)
+	names = slices.Compact(names)
+	result.WriteString(name + " = [")
+	for n, branchName := range names {
+		if n > 0 {
+			result.WriteString(", ")
+		}
+		result.WriteString(quote(branchName))
+	}
+	result.WriteString("]\n")
+}
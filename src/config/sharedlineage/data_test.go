@@ -0,0 +1,86 @@
+package sharedlineage_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/sharedlineage"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Encode", func(t *testing.T) {
+		t.Parallel()
+		t.Run("lineage and perennial branches", func(t *testing.T) {
+			t.Parallel()
+			data := sharedlineage.Data{
+				Contributions: gitdomain.NewLocalBranchNames("coworker"),
+				Lineage: configdomain.Lineage{
+					gitdomain.NewLocalBranchName("beta"):  gitdomain.NewLocalBranchName("alpha"),
+					gitdomain.NewLocalBranchName("alpha"): gitdomain.NewLocalBranchName("main"),
+				},
+				Observed:   gitdomain.NewLocalBranchNames("upstream-fix"),
+				Parked:     gitdomain.NewLocalBranchNames("old", "older"),
+				Perennials: gitdomain.NewLocalBranchNames("staging", "qa"),
+			}
+			have := data.Encode()
+			want := `# Git Town lineage shared via refs/git-town/lineage
+contributions = ["coworker"]
+observed = ["upstream-fix"]
+parked = ["old", "older"]
+perennials = ["qa", "staging"]
+
+[lineage]
+"alpha" = "main"
+"beta" = "alpha"
+`
+			must.EqOp(t, want, have)
+		})
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			have := sharedlineage.EmptyData().Encode()
+			want := `# Git Town lineage shared via refs/git-town/lineage
+contributions = []
+observed = []
+parked = []
+perennials = []
+
+[lineage]
+`
+			must.EqOp(t, want, have)
+		})
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		t.Parallel()
+		t.Run("round-trips encoded data", func(t *testing.T) {
+			t.Parallel()
+			data := sharedlineage.Data{
+				Contributions: gitdomain.NewLocalBranchNames("coworker"),
+				Lineage: configdomain.Lineage{
+					gitdomain.NewLocalBranchName("feature/one"): gitdomain.NewLocalBranchName("main"),
+				},
+				Observed:   gitdomain.NewLocalBranchNames("upstream-fix"),
+				Parked:     gitdomain.NewLocalBranchNames("old"),
+				Perennials: gitdomain.NewLocalBranchNames("qa"),
+			}
+			have, err := sharedlineage.Decode(data.Encode())
+			must.NoError(t, err)
+			must.Eq(t, data, have)
+		})
+		t.Run("ignores prototype branches shared by older versions", func(t *testing.T) {
+			t.Parallel()
+			have, err := sharedlineage.Decode("prototypes = [\"spike\"]\n")
+			must.NoError(t, err)
+			must.Eq(t, sharedlineage.EmptyData(), have)
+		})
+		t.Run("invalid content", func(t *testing.T) {
+			t.Parallel()
+			_, err := sharedlineage.Decode("lineage = [")
+			must.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,107 @@
+package sharedlineage
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// Conflict describes a branch for which the local and the shared lineage define different parents.
+type Conflict struct {
+	Branch       gitdomain.LocalBranchName
+	LocalParent  gitdomain.LocalBranchName
+	SharedParent gitdomain.LocalBranchName
+}
+
+// MergeResult describes how the shared lineage changes the local configuration.
+type MergeResult struct {
+	Conflicts     []Conflict
+	Contributions gitdomain.LocalBranchNames
+	NewParents    configdomain.Lineage // parents that exist only in the shared lineage
+	Observed      gitdomain.LocalBranchNames
+	Parked        gitdomain.LocalBranchNames
+	Perennials    gitdomain.LocalBranchNames
+}
+
+// HasChanges indicates whether this MergeResult changes the local configuration.
+func (self MergeResult) HasChanges() bool {
+	return len(self.Conflicts) > 0 || len(self.Contributions) > 0 || len(self.NewParents) > 0 || len(self.Observed) > 0 || len(self.Parked) > 0 || len(self.Perennials) > 0
+}
+
+// Merge determines the changes that the given shared data makes to the given local configuration.
+// Only branches in the given list of local branches are considered.
+// The shared branch type of a branch applies only if the local configuration doesn't define a type for it.
+func Merge(shared, local Data, localBranches gitdomain.LocalBranchNames) MergeResult {
+	added := EmptyData()
+	sharedLists := shared.branchLists()
+	addedLists := added.branchLists()
+	for l, sharedList := range sharedLists {
+		for _, branch := range *sharedList {
+			if localBranches.Contains(branch) && !local.HasBranchType(branch) && !added.HasBranchType(branch) {
+				*addedLists[l] = append(*addedLists[l], branch)
+			}
+		}
+	}
+	result := MergeResult{
+		Conflicts:     []Conflict{},
+		Contributions: added.Contributions,
+		NewParents:    configdomain.Lineage{},
+		Observed:      added.Observed,
+		Parked:        added.Parked,
+		Perennials:    added.Perennials,
+	}
+	for _, child := range shared.Lineage.BranchNames() {
+		if !localBranches.Contains(child) || local.Perennials.Contains(child) || added.Perennials.Contains(child) {
+			continue
+		}
+		sharedParent := shared.Lineage.Parent(child)
+		localParent, hasLocalParent := local.Lineage[child]
+		switch {
+		case !hasLocalParent:
+			result.NewParents[child] = sharedParent
+		case localParent != sharedParent:
+			result.Conflicts = append(result.Conflicts, Conflict{
+				Branch:       child,
+				LocalParent:  localParent,
+				SharedParent: sharedParent,
+			})
+		}
+	}
+	return result
+}
+
+// Overlay provides the data to share after overlaying the given local configuration over the given shared data.
+// Only entries for the given branches that exist at the origin remote are shared,
+// so that shared entries for branches that the local repo doesn't know about remain
+// and entries for branches that were deleted at the origin remote are removed.
+func Overlay(shared, local Data, originBranches gitdomain.LocalBranchNames) Data {
+	result := EmptyData()
+	for child, parent := range shared.Lineage {
+		if originBranches.Contains(child) {
+			result.Lineage[child] = parent
+		}
+	}
+	for child, parent := range local.Lineage {
+		if originBranches.Contains(child) {
+			result.Lineage[child] = parent
+		}
+	}
+	resultLists := result.branchLists()
+	for l, localList := range local.branchLists() {
+		for _, branch := range *localList {
+			if originBranches.Contains(branch) && !result.HasBranchType(branch) {
+				*resultLists[l] = append(*resultLists[l], branch)
+			}
+		}
+	}
+	for l, sharedList := range shared.branchLists() {
+		for _, branch := range *sharedList {
+			if originBranches.Contains(branch) && !local.HasBranchType(branch) && !result.HasBranchType(branch) {
+				*resultLists[l] = append(*resultLists[l], branch)
+			}
+		}
+	}
+	for _, resultList := range resultLists {
+		resultList.Sort()
+	}
+	return result
+}
@@ -0,0 +1,148 @@
+package sharedlineage_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/sharedlineage"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+	main := gitdomain.NewLocalBranchName("main")
+	one := gitdomain.NewLocalBranchName("one")
+	two := gitdomain.NewLocalBranchName("two")
+	three := gitdomain.NewLocalBranchName("three")
+	qa := gitdomain.NewLocalBranchName("qa")
+
+	t.Run("Merge", func(t *testing.T) {
+		t.Parallel()
+		t.Run("adds missing parents of local branches", func(t *testing.T) {
+			t.Parallel()
+			shared := sharedlineage.EmptyData()
+			shared.Lineage = configdomain.Lineage{one: main, two: one, three: two}
+			local := sharedlineage.EmptyData()
+			local.Lineage = configdomain.Lineage{one: main}
+			have := sharedlineage.Merge(shared, local, gitdomain.LocalBranchNames{main, one, two})
+			want := sharedlineage.MergeResult{
+				Conflicts:     []sharedlineage.Conflict{},
+				Contributions: gitdomain.LocalBranchNames{},
+				NewParents:    configdomain.Lineage{two: one},
+				Observed:      gitdomain.LocalBranchNames{},
+				Parked:        gitdomain.LocalBranchNames{},
+				Perennials:    gitdomain.LocalBranchNames{},
+			}
+			must.Eq(t, want, have)
+			must.True(t, have.HasChanges())
+		})
+		t.Run("reports conflicting parents", func(t *testing.T) {
+			t.Parallel()
+			shared := sharedlineage.EmptyData()
+			shared.Lineage = configdomain.Lineage{two: one}
+			local := sharedlineage.EmptyData()
+			local.Lineage = configdomain.Lineage{one: main, two: main}
+			have := sharedlineage.Merge(shared, local, gitdomain.LocalBranchNames{main, one, two})
+			want := sharedlineage.MergeResult{
+				Conflicts: []sharedlineage.Conflict{
+					{Branch: two, LocalParent: main, SharedParent: one},
+				},
+				Contributions: gitdomain.LocalBranchNames{},
+				NewParents:    configdomain.Lineage{},
+				Observed:      gitdomain.LocalBranchNames{},
+				Parked:        gitdomain.LocalBranchNames{},
+				Perennials:    gitdomain.LocalBranchNames{},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("adds perennial branches", func(t *testing.T) {
+			t.Parallel()
+			shared := sharedlineage.EmptyData()
+			shared.Lineage = configdomain.Lineage{qa: main}
+			shared.Perennials = gitdomain.LocalBranchNames{qa}
+			have := sharedlineage.Merge(shared, sharedlineage.EmptyData(), gitdomain.LocalBranchNames{main, qa})
+			want := sharedlineage.MergeResult{
+				Conflicts:     []sharedlineage.Conflict{},
+				Contributions: gitdomain.LocalBranchNames{},
+				NewParents:    configdomain.Lineage{},
+				Observed:      gitdomain.LocalBranchNames{},
+				Parked:        gitdomain.LocalBranchNames{},
+				Perennials:    gitdomain.LocalBranchNames{qa},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("adds branch types of local branches without a local branch type", func(t *testing.T) {
+			t.Parallel()
+			shared := sharedlineage.EmptyData()
+			shared.Contributions = gitdomain.LocalBranchNames{one}
+			shared.Observed = gitdomain.LocalBranchNames{two}
+			shared.Parked = gitdomain.LocalBranchNames{three}
+			local := sharedlineage.EmptyData()
+			local.Observed = gitdomain.LocalBranchNames{one}
+			have := sharedlineage.Merge(shared, local, gitdomain.LocalBranchNames{main, one, two})
+			want := sharedlineage.MergeResult{
+				Conflicts:     []sharedlineage.Conflict{},
+				Contributions: gitdomain.LocalBranchNames{},
+				NewParents:    configdomain.Lineage{},
+				Observed:      gitdomain.LocalBranchNames{two},
+				Parked:        gitdomain.LocalBranchNames{},
+				Perennials:    gitdomain.LocalBranchNames{},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("no changes", func(t *testing.T) {
+			t.Parallel()
+			shared := sharedlineage.EmptyData()
+			shared.Lineage = configdomain.Lineage{one: main}
+			shared.Parked = gitdomain.LocalBranchNames{one}
+			local := sharedlineage.EmptyData()
+			local.Lineage = configdomain.Lineage{one: main}
+			local.Parked = gitdomain.LocalBranchNames{one}
+			have := sharedlineage.Merge(shared, local, gitdomain.LocalBranchNames{main, one})
+			must.False(t, have.HasChanges())
+		})
+	})
+
+	t.Run("Overlay", func(t *testing.T) {
+		t.Parallel()
+		t.Run("local entries win and branches deleted at the origin remote get removed", func(t *testing.T) {
+			t.Parallel()
+			shared := sharedlineage.EmptyData()
+			shared.Lineage = configdomain.Lineage{one: main, two: main, three: two}
+			shared.Perennials = gitdomain.LocalBranchNames{qa}
+			local := sharedlineage.EmptyData()
+			local.Lineage = configdomain.Lineage{two: one}
+			have := sharedlineage.Overlay(shared, local, gitdomain.LocalBranchNames{main, one, two})
+			want := sharedlineage.EmptyData()
+			want.Lineage = configdomain.Lineage{one: main, two: one}
+			must.Eq(t, want, have)
+		})
+		t.Run("keeps shared entries for branches that only exist at the origin remote", func(t *testing.T) {
+			t.Parallel()
+			shared := sharedlineage.EmptyData()
+			shared.Lineage = configdomain.Lineage{one: main, two: one}
+			shared.Parked = gitdomain.LocalBranchNames{two}
+			local := sharedlineage.EmptyData()
+			local.Lineage = configdomain.Lineage{three: main}
+			have := sharedlineage.Overlay(shared, local, gitdomain.LocalBranchNames{main, one, two, three})
+			want := sharedlineage.EmptyData()
+			want.Lineage = configdomain.Lineage{one: main, two: one, three: main}
+			want.Parked = gitdomain.LocalBranchNames{two}
+			must.Eq(t, want, have)
+		})
+		t.Run("local branch types replace shared branch types", func(t *testing.T) {
+			t.Parallel()
+			shared := sharedlineage.EmptyData()
+			shared.Contributions = gitdomain.LocalBranchNames{one}
+			shared.Parked = gitdomain.LocalBranchNames{two}
+			local := sharedlineage.EmptyData()
+			local.Observed = gitdomain.LocalBranchNames{one}
+			have := sharedlineage.Overlay(shared, local, gitdomain.LocalBranchNames{main, one, two, three})
+			want := sharedlineage.EmptyData()
+			want.Observed = gitdomain.LocalBranchNames{one}
+			want.Parked = gitdomain.LocalBranchNames{two}
+			must.Eq(t, want, have)
+		})
+	})
+}
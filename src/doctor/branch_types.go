@@ -0,0 +1,93 @@
+package doctor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// checkBranchTypes finds branches that are configured with more than one branch type.
+// The fix keeps the type that Git Town uses for the branch and removes the others.
+func checkBranchTypes(args Args) Problems {
+	result := Problems{}
+	for _, branch := range typedBranches(args.Config) {
+		types := configuredBranchTypes(args.Config, branch)
+		if len(types) < 2 {
+			continue
+		}
+		fix := program.Program{}
+		for _, branchType := range types[1:] {
+			fix.Add(removeBranchTypeOpcode(branch, branchType))
+		}
+		typeNames := make([]string, len(types))
+		for t, branchType := range types {
+			typeNames[t] = branchType.String()
+		}
+		result = append(result, Problem{
+			Fix:      fix,
+			Fixable:  true,
+			Message:  fmt.Sprintf(messages.DoctorBranchTypesConflicting, branch, strings.Join(typeNames, " and "), types[0]),
+			Severity: SeverityError,
+		})
+	}
+	return result
+}
+
+// configuredBranchTypes provides the branch types that are explicitly configured for the given branch,
+// in the order of precedence that FullConfig.BranchType uses.
+func configuredBranchTypes(config *configdomain.FullConfig, branch gitdomain.LocalBranchName) []configdomain.BranchType {
+	result := []configdomain.BranchType{}
+	if config.IsMainBranch(branch) {
+		result = append(result, configdomain.BranchTypeMainBranch)
+	}
+	if slice.Contains(config.PerennialBranches, branch) {
+		result = append(result, configdomain.BranchTypePerennialBranch)
+	}
+	if slice.Contains(config.ContributionBranches, branch) {
+		result = append(result, configdomain.BranchTypeContributionBranch)
+	}
+	if slice.Contains(config.ObservedBranches, branch) {
+		result = append(result, configdomain.BranchTypeObservedBranch)
+	}
+	if slice.Contains(config.ParkedBranches, branch) {
+		result = append(result, configdomain.BranchTypeParkedBranch)
+	}
+	if slice.Contains(config.PrototypeBranches, branch) {
+		result = append(result, configdomain.BranchTypePrototypeBranch)
+	}
+	return result
+}
+
+func removeBranchTypeOpcode(branch gitdomain.LocalBranchName, branchType configdomain.BranchType) shared.Opcode { //nolint:ireturn
+	switch branchType {
+	case configdomain.BranchTypePerennialBranch:
+		return &opcodes.RemoveFromPerennialBranches{Branch: branch}
+	case configdomain.BranchTypeContributionBranch:
+		return &opcodes.RemoveFromContributionBranches{Branch: branch}
+	case configdomain.BranchTypeObservedBranch:
+		return &opcodes.RemoveFromObservedBranches{Branch: branch}
+	case configdomain.BranchTypeParkedBranch:
+		return &opcodes.RemoveFromParkedBranches{Branch: branch}
+	case configdomain.BranchTypePrototypeBranch:
+		return &opcodes.RemoveFromPrototypeBranches{Branch: branch}
+	case configdomain.BranchTypeMainBranch, configdomain.BranchTypeFeatureBranch:
+	}
+	panic(fmt.Sprintf("cannot remove branch type %q", branchType))
+}
+
+// typedBranches provides all branches that have an explicitly configured branch type, sorted alphabetically.
+func typedBranches(config *configdomain.FullConfig) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, branches := range []gitdomain.LocalBranchNames{config.PerennialBranches, config.ContributionBranches, config.ObservedBranches, config.ParkedBranches, config.PrototypeBranches} {
+		result = result.AppendAllMissing(branches...)
+	}
+	result.Sort()
+	return result
+}
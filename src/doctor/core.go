@@ -0,0 +1,34 @@
+// Package doctor finds and repairs broken Git Town state in a repository.
+package doctor
+
+import (
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+)
+
+// Diagnose runs all checks against the given repository state.
+func Diagnose(args Args) Problems {
+	result := Problems{}
+	result = append(result, checkLineageCycles(args)...)
+	result = append(result, checkLineageMissingBranches(args)...)
+	result = append(result, checkPerennialParents(args)...)
+	result = append(result, checkBranchTypes(args)...)
+	result = append(result, checkDeprecatedSettings(args)...)
+	result = append(result, checkRunState(args)...)
+	return result
+}
+
+type Args struct {
+	Branches           gitdomain.BranchInfos
+	Config             *configdomain.FullConfig
+	DeprecatedSettings []gitconfig.DeprecatedSetting
+	RunState           *runstate.RunState // nil if there is no persisted runstate
+	RunStatePath       string             // path of the file that persists the runstate
+}
+
+// branchExists indicates whether the given branch exists locally or at origin.
+func (self Args) branchExists(branch gitdomain.LocalBranchName) bool {
+	return self.Branches.HasLocalBranch(branch) || self.Branches.HasMatchingTrackingBranchFor(branch)
+}
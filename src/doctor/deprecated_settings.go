@@ -0,0 +1,22 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// checkDeprecatedSettings reports deprecated settings that Git Town cannot migrate automatically.
+// These need to be renamed manually because they live in files that Git Town doesn't manage.
+func checkDeprecatedSettings(args Args) Problems {
+	result := Problems{}
+	for _, setting := range args.DeprecatedSettings {
+		result = append(result, Problem{
+			Fix:      nil,
+			Fixable:  false,
+			Message:  fmt.Sprintf(messages.DoctorDeprecatedSetting, setting.Key, setting.Origin, setting.NewKey),
+			Severity: SeverityWarning,
+		})
+	}
+	return result
+}
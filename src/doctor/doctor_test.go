@@ -0,0 +1,230 @@
+package doctor_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/config/gitconfig"
+	"github.com/git-town/git-town/v12/src/doctor"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+	"github.com/git-town/git-town/v12/src/vm/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestDiagnose(t *testing.T) {
+	t.Parallel()
+	main := gitdomain.NewLocalBranchName("main")
+	one := gitdomain.NewLocalBranchName("one")
+	two := gitdomain.NewLocalBranchName("two")
+	qa := gitdomain.NewLocalBranchName("qa")
+
+	branches := func(names ...gitdomain.LocalBranchName) gitdomain.BranchInfos {
+		result := gitdomain.BranchInfos{}
+		for _, name := range names {
+			branch := gitdomain.EmptyBranchInfo()
+			branch.LocalName = name
+			result = append(result, branch)
+		}
+		return result
+	}
+	newArgs := func(config configdomain.FullConfig, branches gitdomain.BranchInfos) doctor.Args {
+		return doctor.Args{
+			Branches:           branches,
+			Config:             &config,
+			DeprecatedSettings: []gitconfig.DeprecatedSetting{},
+			RunState:           nil,
+			RunStatePath:       "/tmp/runstate.json",
+		}
+	}
+
+	t.Run("healthy repo", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.MainBranch = main
+		config.Lineage = configdomain.Lineage{one: main, two: one}
+		have := doctor.Diagnose(newArgs(config, branches(main, one, two)))
+		must.Eq(t, doctor.Problems{}, have)
+	})
+
+	t.Run("lineage cycle", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.MainBranch = main
+		config.Lineage = configdomain.Lineage{one: two, two: one}
+		have := doctor.Diagnose(newArgs(config, branches(main, one, two)))
+		want := doctor.Problems{
+			{
+				Fix:      program.Program{&opcodes.DeleteParentBranch{Branch: one}},
+				Fixable:  true,
+				Message:  `the lineage contains a cycle: one -> two -> one`,
+				Severity: doctor.SeverityError,
+			},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("lineage entries for missing branches", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.MainBranch = main
+		config.Lineage = configdomain.Lineage{one: main, two: one}
+		have := doctor.Diagnose(newArgs(config, branches(main, two)))
+		want := doctor.Problems{
+			{
+				Fix:      program.Program{&opcodes.RemoveBranchFromLineage{Branch: one}},
+				Fixable:  true,
+				Message:  `branch "one" doesn't exist but is the parent of two`,
+				Severity: doctor.SeverityError,
+			},
+		}
+		must.Eq(t, want, have)
+		have = doctor.Diagnose(newArgs(config, branches(main, one)))
+		want = doctor.Problems{
+			{
+				Fix:      program.Program{&opcodes.RemoveBranchFromLineage{Branch: two}},
+				Fixable:  true,
+				Message:  `the lineage contains branch "two" which doesn't exist`,
+				Severity: doctor.SeverityWarning,
+			},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("branches that exist only at origin are not missing", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.MainBranch = main
+		config.Lineage = configdomain.Lineage{one: main}
+		remoteOne := gitdomain.EmptyBranchInfo()
+		remoteOne.RemoteName = gitdomain.NewRemoteBranchName("origin/one")
+		have := doctor.Diagnose(newArgs(config, append(branches(main), remoteOne)))
+		must.Eq(t, doctor.Problems{}, have)
+	})
+
+	t.Run("perennial branch with parent", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.MainBranch = main
+		config.PerennialBranches = gitdomain.LocalBranchNames{qa}
+		config.Lineage = configdomain.Lineage{qa: main}
+		have := doctor.Diagnose(newArgs(config, branches(main, qa)))
+		want := doctor.Problems{
+			{
+				Fix:      program.Program{&opcodes.DeleteParentBranch{Branch: qa}},
+				Fixable:  true,
+				Message:  `perennial branch "qa" has parent "main"`,
+				Severity: doctor.SeverityWarning,
+			},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("branch with several types", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.MainBranch = main
+		config.ObservedBranches = gitdomain.LocalBranchNames{one}
+		config.ParkedBranches = gitdomain.LocalBranchNames{one}
+		config.PrototypeBranches = gitdomain.LocalBranchNames{one}
+		have := doctor.Diagnose(newArgs(config, branches(main, one)))
+		want := doctor.Problems{
+			{
+				Fix: program.Program{
+					&opcodes.RemoveFromParkedBranches{Branch: one},
+					&opcodes.RemoveFromPrototypeBranches{Branch: one},
+				},
+				Fixable:  true,
+				Message:  `branch "one" is configured as observed branch and parked branch and prototype branch, Git Town treats it as observed branch`,
+				Severity: doctor.SeverityError,
+			},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("deprecated settings", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.MainBranch = main
+		args := newArgs(config, branches(main))
+		args.DeprecatedSettings = []gitconfig.DeprecatedSetting{
+			{Key: gitconfig.KeyDeprecatedMainBranchName, NewKey: gitconfig.KeyMainBranch, Origin: "file:/etc/gitconfig"},
+		}
+		have := doctor.Diagnose(args)
+		want := doctor.Problems{
+			{
+				Fix:      nil,
+				Fixable:  false,
+				Message:  `deprecated setting "git-town.main-branch-name" in file:/etc/gitconfig, please rename it to "git-town.main-branch"`,
+				Severity: doctor.SeverityWarning,
+			},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("unfinished runstate", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.DefaultConfig()
+		config.MainBranch = main
+		newRunState := func(endBranch gitdomain.LocalBranchName) *runstate.RunState {
+			runState := runstate.EmptyRunState()
+			runState.Command = "sync"
+			runState.UnfinishedDetails = &runstate.UnfinishedRunStateDetails{
+				CanSkip:   false,
+				EndBranch: endBranch,
+			}
+			return &runState
+		}
+		t.Run("end branch exists", func(t *testing.T) {
+			t.Parallel()
+			args := newArgs(config, branches(main, one))
+			args.RunState = newRunState(one)
+			have := doctor.Diagnose(args)
+			must.EqOp(t, 1, len(have))
+			must.EqOp(t, doctor.SeverityWarning, have[0].Severity)
+			must.False(t, have[0].Fixable)
+			must.True(t, args.HasResumableRunState())
+		})
+		t.Run("end branch was deleted", func(t *testing.T) {
+			t.Parallel()
+			args := newArgs(config, branches(main))
+			args.RunState = newRunState(one)
+			have := doctor.Diagnose(args)
+			want := doctor.Problems{
+				{
+					Fix:      program.Program{&opcodes.DeleteRunStateFile{Path: "/tmp/runstate.json"}},
+					Fixable:  true,
+					Message:  `the unfinished "sync" command ended on branch "one" which doesn't exist anymore`,
+					Severity: doctor.SeverityError,
+				},
+			}
+			must.Eq(t, want, have)
+			must.False(t, args.HasResumableRunState())
+		})
+		t.Run("finished runstate", func(t *testing.T) {
+			t.Parallel()
+			args := newArgs(config, branches(main))
+			runState := runstate.EmptyRunState()
+			args.RunState = &runState
+			must.Eq(t, doctor.Problems{}, doctor.Diagnose(args))
+		})
+	})
+}
+
+func TestProblems(t *testing.T) {
+	t.Parallel()
+	one := gitdomain.NewLocalBranchName("one")
+	two := gitdomain.NewLocalBranchName("two")
+	problems := doctor.Problems{
+		{Fix: program.Program{&opcodes.DeleteParentBranch{Branch: one}}, Fixable: true, Message: "", Severity: doctor.SeverityError},
+		{Fix: nil, Fixable: false, Message: "", Severity: doctor.SeverityWarning},
+		{Fix: program.Program{&opcodes.DeleteParentBranch{Branch: two}}, Fixable: true, Message: "", Severity: doctor.SeverityWarning},
+	}
+	must.EqOp(t, 2, problems.CountFixable())
+	want := program.Program{
+		&opcodes.DeleteParentBranch{Branch: one},
+		&opcodes.DeleteParentBranch{Branch: two},
+	}
+	must.Eq(t, want, problems.FixProgram())
+}
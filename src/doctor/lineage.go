@@ -0,0 +1,119 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+)
+
+// checkLineageCycles finds branches that are their own ancestors.
+// The fix removes the parent entry of the alphabetically first branch in the cycle.
+func checkLineageCycles(args Args) Problems {
+	result := Problems{}
+	reported := gitdomain.LocalBranchNames{}
+	for _, branch := range args.Config.Lineage.BranchNames() {
+		if reported.Contains(branch) {
+			continue
+		}
+		cycle := lineageCycle(args.Config.Lineage, branch)
+		if len(cycle) == 0 {
+			continue
+		}
+		reported = append(reported, cycle...)
+		result = append(result, Problem{
+			Fix:      program.Program{&opcodes.DeleteParentBranch{Branch: branch}},
+			Fixable:  true,
+			Message:  fmt.Sprintf(messages.DoctorLineageCycle, append(cycle, branch).Join(" -> ")),
+			Severity: SeverityError,
+		})
+	}
+	return result
+}
+
+// checkLineageMissingBranches finds lineage entries for branches that exist neither locally nor at origin.
+func checkLineageMissingBranches(args Args) Problems {
+	result := Problems{}
+	for _, branch := range lineageBranches(args.Config.Lineage) {
+		if branch == args.Config.MainBranch || args.branchExists(branch) {
+			continue
+		}
+		fix := program.Program{&opcodes.RemoveBranchFromLineage{Branch: branch}}
+		children := args.Config.Lineage.Children(branch)
+		if len(children) == 0 {
+			result = append(result, Problem{
+				Fix:      fix,
+				Fixable:  true,
+				Message:  fmt.Sprintf(messages.DoctorLineageMissingBranch, branch),
+				Severity: SeverityWarning,
+			})
+			continue
+		}
+		result = append(result, Problem{
+			Fix:      fix,
+			Fixable:  true,
+			Message:  fmt.Sprintf(messages.DoctorLineageMissingParent, branch, children.Join(", ")),
+			Severity: SeverityError,
+		})
+	}
+	return result
+}
+
+// checkPerennialParents finds main and perennial branches that have a parent.
+func checkPerennialParents(args Args) Problems {
+	result := Problems{}
+	for _, branch := range args.Config.Lineage.BranchNames() {
+		var message string
+		switch {
+		case args.Config.IsMainBranch(branch):
+			message = messages.DoctorMainBranchHasParent
+		case args.Config.IsPerennialBranch(branch):
+			message = messages.DoctorPerennialBranchHasParent
+		default:
+			continue
+		}
+		result = append(result, Problem{
+			Fix:      program.Program{&opcodes.DeleteParentBranch{Branch: branch}},
+			Fixable:  true,
+			Message:  fmt.Sprintf(message, branch, args.Config.Lineage.Parent(branch)),
+			Severity: SeverityWarning,
+		})
+	}
+	return result
+}
+
+// lineageBranches provides all branches that the given lineage mentions, as child or parent, sorted alphabetically.
+func lineageBranches(lineage configdomain.Lineage) gitdomain.LocalBranchNames {
+	result := lineage.BranchNames()
+	for _, parent := range lineage {
+		result = result.AppendAllMissing(parent)
+	}
+	result.Sort()
+	return result
+}
+
+// lineageCycle provides the branches that form a cycle starting at the given branch,
+// or nothing if the given branch isn't part of a cycle.
+// This doesn't use Lineage.Ancestors because that doesn't terminate on cycles.
+func lineageCycle(lineage configdomain.Lineage, branch gitdomain.LocalBranchName) gitdomain.LocalBranchNames {
+	cycle := gitdomain.LocalBranchNames{branch}
+	current := branch
+	for {
+		parent, hasParent := lineage[current]
+		if !hasParent {
+			return gitdomain.LocalBranchNames{}
+		}
+		if parent == branch {
+			return cycle
+		}
+		if cycle.Contains(parent) {
+			// the given branch leads into a cycle but isn't part of it
+			return gitdomain.LocalBranchNames{}
+		}
+		cycle = append(cycle, parent)
+		current = parent
+	}
+}
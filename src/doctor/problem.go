@@ -0,0 +1,37 @@
+package doctor
+
+import (
+	"github.com/git-town/git-town/v12/src/vm/program"
+)
+
+// Problem is a single issue that the doctor found.
+type Problem struct {
+	Fix      program.Program // opcodes that repair this problem
+	Fixable  bool            // whether "git town doctor --fix" repairs this problem
+	Message  string
+	Severity Severity
+}
+
+type Problems []Problem
+
+// CountFixable provides the number of problems that "git town doctor --fix" repairs.
+func (self Problems) CountFixable() int {
+	result := 0
+	for _, problem := range self {
+		if problem.Fixable {
+			result++
+		}
+	}
+	return result
+}
+
+// FixProgram provides the program that repairs all fixable problems.
+func (self Problems) FixProgram() program.Program {
+	result := program.Program{}
+	for _, problem := range self {
+		if problem.Fixable {
+			result.AddProgram(problem.Fix)
+		}
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+)
+
+// HasResumableRunState indicates whether there is an unfinished Git Town command that can still be continued.
+func (self Args) HasResumableRunState() bool {
+	return self.hasUnfinishedRunState() && !self.hasStaleRunState()
+}
+
+// hasStaleRunState indicates whether there is an unfinished Git Town command
+// that cannot be continued because the branch it ended on no longer exists.
+func (self Args) hasStaleRunState() bool {
+	return self.hasUnfinishedRunState() && !self.Branches.HasLocalBranch(self.RunState.UnfinishedDetails.EndBranch)
+}
+
+func (self Args) hasUnfinishedRunState() bool {
+	return self.RunState != nil && !self.RunState.IsFinished()
+}
+
+// checkRunState finds unfinished Git Town commands.
+func checkRunState(args Args) Problems {
+	switch {
+	case args.hasStaleRunState():
+		return Problems{{
+			Fix:      program.Program{&opcodes.DeleteRunStateFile{Path: args.RunStatePath}},
+			Fixable:  true,
+			Message:  fmt.Sprintf(messages.DoctorRunStateStale, args.RunState.Command, args.RunState.UnfinishedDetails.EndBranch),
+			Severity: SeverityError,
+		}}
+	case args.hasUnfinishedRunState():
+		return Problems{{
+			Fix:      nil,
+			Fixable:  false,
+			Message:  fmt.Sprintf(messages.DoctorRunStateUnfinished, args.RunState.Command),
+			Severity: SeverityWarning,
+		}}
+	}
+	return Problems{}
+}
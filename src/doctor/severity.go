@@ -0,0 +1,19 @@
+package doctor
+
+// Severity describes how urgently a problem needs attention.
+type Severity int
+
+const (
+	SeverityWarning Severity = iota // Git Town works but the configuration contains useless or outdated data
+	SeverityError                   // Git Town might not work correctly
+)
+
+func (self Severity) String() string {
+	switch self {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	}
+	panic("unhandled severity")
+}
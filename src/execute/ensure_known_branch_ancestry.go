@@ -16,10 +16,12 @@ import (
 // It ensures that all information derived from lineage gets updated when the lineage is updated.
 func EnsureKnownBranchAncestry(branch gitdomain.LocalBranchName, args EnsureKnownBranchAncestryArgs) error {
 	updated, err := validate.KnowsBranchAncestors(branch, validate.KnowsBranchAncestorsArgs{
+		AllBranches:      args.AllBranches,
 		LocalBranches:    args.AllBranches.Names(),
 		Backend:          &args.Runner.Backend,
 		Config:           args.Config,
 		DialogTestInputs: args.DialogTestInputs,
+		InferParents:     args.InferParents,
 		MainBranch:       args.DefaultBranch,
 	})
 	if err != nil {
@@ -37,5 +39,6 @@ type EnsureKnownBranchAncestryArgs struct {
 	Config           *configdomain.FullConfig
 	DefaultBranch    gitdomain.LocalBranchName
 	DialogTestInputs *components.TestInputs
+	InferParents     bool
 	Runner           *git.ProdRunner
 }
@@ -19,6 +19,7 @@ func EnsureKnownBranchesAncestry(args EnsureKnownBranchesAncestryArgs) error {
 		Backend:          &args.Runner.Backend,
 		Config:           args.Config,
 		DialogTestInputs: args.DialogTestInputs,
+		InferParents:     args.InferParents,
 		LocalBranches:    args.LocalBranches,
 	})
 	if err != nil {
@@ -33,6 +34,7 @@ func EnsureKnownBranchesAncestry(args EnsureKnownBranchesAncestryArgs) error {
 type EnsureKnownBranchesAncestryArgs struct {
 	Config           *configdomain.FullConfig
 	DialogTestInputs *components.TestInputs
+	InferParents     bool
 	LocalBranches    gitdomain.BranchInfos
 	Runner           *git.ProdRunner
 }
@@ -0,0 +1,94 @@
+package execute
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/cli/dialog/components"
+	"github.com/git-town/git-town/v12/src/config/sharedlineage"
+	"github.com/git-town/git-town/v12/src/git"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// LoadSharedLineage merges the lineage that teammates shared via the origin remote into the local Git configuration.
+// It asks the user to resolve conflicting parents.
+func LoadSharedLineage(args LoadSharedLineageArgs) (exit bool, err error) { //nolint:nonamedreturns
+	config := &args.Runner.Config.FullConfig
+	if !config.ShareLineage.Bool() || config.Offline.Bool() || !args.Remotes.HasOrigin() {
+		return false, nil
+	}
+	// the shared lineage ref doesn't exist at the remote until somebody shares their lineage
+	if args.Runner.Backend.FetchRef(gitdomain.OriginRemote, sharedlineage.Ref, sharedlineage.TrackingRef) != nil {
+		// forget a previously fetched version so that sharing the lineage later doesn't expect it at the remote
+		_ = args.Runner.Backend.DeleteRef(sharedlineage.TrackingRef)
+		return false, nil
+	}
+	content, exists := args.Runner.Backend.ReadBlobRef(sharedlineage.TrackingRef)
+	if !exists {
+		return false, nil
+	}
+	shared, err := sharedlineage.Decode(content)
+	if err != nil {
+		fmt.Printf(messages.SharedLineageInvalid, sharedlineage.Ref, err)
+		return false, nil
+	}
+	merge := sharedlineage.Merge(shared, sharedlineage.FromConfig(config), args.LocalBranches)
+	if len(merge.Perennials) > 0 {
+		err = args.Runner.Config.AddToPerennialBranches(merge.Perennials...)
+		if err != nil {
+			return false, err
+		}
+	}
+	if len(merge.Contributions) > 0 {
+		err = args.Runner.Config.AddToContributionBranches(merge.Contributions...)
+		if err != nil {
+			return false, err
+		}
+	}
+	if len(merge.Observed) > 0 {
+		err = args.Runner.Config.AddToObservedBranches(merge.Observed...)
+		if err != nil {
+			return false, err
+		}
+	}
+	if len(merge.Parked) > 0 {
+		err = args.Runner.Config.AddToParkedBranches(merge.Parked...)
+		if err != nil {
+			return false, err
+		}
+	}
+	for _, child := range merge.NewParents.BranchNames() {
+		parent := merge.NewParents.Parent(child)
+		err = args.Runner.Config.SetParent(child, parent)
+		if err != nil {
+			return false, err
+		}
+		fmt.Printf(messages.SharedLineageParentAdded, child, parent)
+	}
+	for _, conflict := range merge.Conflicts {
+		var parent gitdomain.LocalBranchName
+		var aborted bool
+		parent, aborted, err = dialog.ConflictingParent(conflict.Branch, conflict.LocalParent, conflict.SharedParent, args.DialogTestInputs.Next())
+		if err != nil || aborted {
+			return aborted, err
+		}
+		if parent != conflict.LocalParent {
+			err = args.Runner.Config.SetParent(conflict.Branch, parent)
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+	if merge.HasChanges() {
+		args.Runner.Config.Reload()
+	}
+	return false, nil
+}
+
+type LoadSharedLineageArgs struct {
+	DialogTestInputs *components.TestInputs
+	LocalBranches    gitdomain.LocalBranchNames
+	Remotes          gitdomain.Remotes
+	Runner           *git.ProdRunner
+}
@@ -2,11 +2,13 @@ package execute
 
 import (
 	"errors"
+	"fmt"
 	"os"
 
 	"github.com/git-town/git-town/v12/src/config"
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/config/configfile"
+	"github.com/git-town/git-town/v12/src/config/envconfig"
 	"github.com/git-town/git-town/v12/src/config/gitconfig"
 	"github.com/git-town/git-town/v12/src/git"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
@@ -42,28 +44,46 @@ func OpenRepo(args OpenRepoArgs) (*OpenRepoResult, error) {
 		return nil, err
 	}
 	configGitAccess := gitconfig.Access{Runner: backendRunner}
-	globalSnapshot, globalConfig, err := configGitAccess.LoadGlobal()
+	globalSnapshot, globalConfig, globalIgnoredValues, err := configGitAccess.LoadGlobal()
 	if err != nil {
 		return nil, err
 	}
-	localSnapshot, localConfig, err := configGitAccess.LoadLocal()
+	localSnapshot, localConfig, localIgnoredValues, err := configGitAccess.LoadLocal()
 	if err != nil {
 		return nil, err
 	}
+	for _, ignoredValue := range append(globalIgnoredValues, localIgnoredValues...) {
+		fmt.Println(ignoredValue.Error())
+	}
 	configSnapshot := undoconfig.ConfigSnapshot{
 		Global: globalSnapshot,
 		Local:  localSnapshot,
 	}
-	configFile, err := configfile.Load()
+	configFile, unknownKeys, err := configfile.Load()
+	if err != nil {
+		return nil, err
+	}
+	userConfigFile, userUnknownKeys, err := configfile.LoadUser()
+	if err != nil {
+		return nil, err
+	}
+	for _, problems := range []configfile.Problems{unknownKeys, userUnknownKeys} {
+		if len(problems) > 0 {
+			fmt.Printf(messages.ConfigFileKeysUnknown, problems[0].File, len(problems), problems.List())
+		}
+	}
+	_, envConfig, err := envconfig.Load()
 	if err != nil {
 		return nil, err
 	}
 	config, err := config.NewConfig(config.NewConfigArgs{
-		ConfigFile:   configFile,
-		DryRun:       args.DryRun,
-		GlobalConfig: globalConfig,
-		LocalConfig:  localConfig,
-		Runner:       backendRunner,
+		ConfigFile:     configFile,
+		DryRun:         args.DryRun,
+		EnvConfig:      envConfig,
+		GlobalConfig:   globalConfig,
+		LocalConfig:    localConfig,
+		Runner:         backendRunner,
+		UserConfigFile: userConfigFile,
 	})
 	if err != nil {
 		return nil, err
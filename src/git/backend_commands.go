@@ -34,6 +34,18 @@ type BackendCommands struct {
 	Runner             BackendRunner  // executes shell commands in the directory of the Git repo
 }
 
+// AppendToSquashCommitMessage adds the given text uncommented to the end of the squash commit message
+// that Git pre-populates the commit message editor with.
+func (self *BackendCommands) AppendToSquashCommitMessage(text string) error {
+	squashMessageFile := ".git/SQUASH_MSG"
+	contentBytes, err := os.ReadFile(squashMessageFile)
+	if err != nil {
+		return fmt.Errorf(messages.SquashCannotReadFile, squashMessageFile, err)
+	}
+	content := strings.TrimRight(string(contentBytes), "\n") + "\n\n" + text + "\n"
+	return os.WriteFile(squashMessageFile, []byte(content), 0o600)
+}
+
 // Author provides the locally Git configured user.
 func (self *BackendCommands) Author() (string, error) {
 	email := self.Config.FullConfig.GitUserEmail
@@ -63,6 +75,21 @@ func (self *BackendCommands) BranchAuthors(branch, parent gitdomain.LocalBranchN
 	return result, nil
 }
 
+// BranchCreationSource provides the ref that the given local branch was created from,
+// as recorded in the reflog. Provides an empty string if the reflog doesn't contain this information.
+func (self *BackendCommands) BranchCreationSource(branch gitdomain.LocalBranchName) string {
+	output, err := self.Runner.QueryTrim("git", "reflog", "show", "--format=%gs", "refs/heads/"+branch.String())
+	if err != nil || output == "" {
+		return ""
+	}
+	lines := stringslice.Lines(output)
+	source, isCreation := strings.CutPrefix(lines[len(lines)-1], "branch: Created from ")
+	if !isCreation {
+		return ""
+	}
+	return source
+}
+
 func (self *BackendCommands) BranchExists(branch gitdomain.LocalBranchName) bool {
 	err := self.Runner.Run("git", "show-ref", "--verify", "--quiet", "refs/heads/"+branch.String())
 	return err == nil
@@ -186,6 +213,15 @@ func (self *BackendCommands) CommentOutSquashCommitMessage(prefix string) error
 	return os.WriteFile(squashMessageFile, []byte(content), 0o600)
 }
 
+// CommitsAheadCount provides the number of commits in the given branch that aren't in the given base.
+func (self *BackendCommands) CommitsAheadCount(branch, base gitdomain.BranchName) (int, error) {
+	output, err := self.Runner.QueryTrim("git", "rev-list", "--count", base.String()+".."+branch.String())
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(output)
+}
+
 func (self *BackendCommands) CommitsInBranch(branch, parent gitdomain.LocalBranchName) (gitdomain.SHAs, error) {
 	if parent.IsEmpty() {
 		return self.CommitsInPerennialBranch()
@@ -221,6 +257,28 @@ func (self *BackendCommands) CommitsInPerennialBranch() (gitdomain.SHAs, error)
 	return result, nil
 }
 
+// CreatedFromRemoteBranch indicates whether the given local branch was created from a remote-tracking branch,
+// for example by checking out a branch that existed only at a remote.
+func (self *BackendCommands) CreatedFromRemoteBranch(branch gitdomain.LocalBranchName) bool {
+	source := self.BranchCreationSource(branch)
+	if source == "" {
+		return false
+	}
+	if strings.HasPrefix(source, "refs/remotes/") {
+		return true
+	}
+	remotes, err := self.Remotes()
+	if err != nil {
+		return false
+	}
+	for _, remote := range remotes {
+		if strings.HasPrefix(source, remote.String()+"/") {
+			return true
+		}
+	}
+	return false
+}
+
 // CurrentBranch provides the name of the currently checked out branch.
 func (self *BackendCommands) CurrentBranch() (gitdomain.LocalBranchName, error) {
 	if !self.CurrentBranchCache.Initialized() {
@@ -263,6 +321,17 @@ func (self *BackendCommands) DefaultBranch() gitdomain.LocalBranchName {
 	return gitdomain.LocalBranchName(name)
 }
 
+// DeleteRef removes the given ref from the local repository.
+func (self *BackendCommands) DeleteRef(ref string) error {
+	return self.Runner.Run("git", "update-ref", "-d", ref)
+}
+
+// FetchRef fetches the given ref from the given remote into the given local ref,
+// overwriting the local ref.
+func (self *BackendCommands) FetchRef(remote gitdomain.Remote, source, target string) error {
+	return self.Runner.Run("git", "fetch", "--no-tags", remote.String(), "+"+source+":"+target)
+}
+
 func (self *BackendCommands) FirstExistingBranch(branches gitdomain.LocalBranchNames, mainBranch gitdomain.LocalBranchName) gitdomain.LocalBranchName {
 	for _, branch := range branches {
 		if self.BranchExists(branch) {
@@ -283,6 +352,11 @@ func (self *BackendCommands) HasMergeInProgress() bool {
 	return err == nil
 }
 
+// HasRemoteBranch indicates whether this repo knows the given remote branch.
+func (self *BackendCommands) HasRemoteBranch(name gitdomain.RemoteBranchName) bool {
+	return self.Runner.Run("git", "show-ref", "--quiet", "refs/remotes/"+name.String()) == nil
+}
+
 // HasShippableChanges indicates whether the given branch has changes
 // not currently in the main branch.
 func (self *BackendCommands) HasShippableChanges(branch, mainBranch gitdomain.LocalBranchName) (bool, error) {
@@ -293,6 +367,77 @@ func (self *BackendCommands) HasShippableChanges(branch, mainBranch gitdomain.Lo
 	return out != "", nil
 }
 
+// HasMergeCommits indicates whether the given branch contains merge commits that aren't in the given base branch.
+func (self *BackendCommands) HasMergeCommits(branch, base gitdomain.BranchName) (bool, error) {
+	output, err := self.Runner.QueryTrim("git", "rev-list", "--merges", base.String()+".."+branch.String())
+	if err != nil {
+		return false, err
+	}
+	return output != "", nil
+}
+
+// HooksDir provides the absolute path of the directory that contains the Git hooks of this repository.
+// This respects the "core.hooksPath" setting.
+func (self *BackendCommands) HooksDir() (string, error) {
+	output, err := self.Runner.QueryTrim("git", "rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+	return filepath.Abs(output)
+}
+
+// IsAncestor indicates whether the given ancestor branch is fully contained in the given branch.
+func (self *BackendCommands) IsAncestor(ancestor, branch gitdomain.BranchName) bool {
+	err := self.Runner.Run("git", "merge-base", "--is-ancestor", ancestor.String(), branch.String())
+	return err == nil
+}
+
+// IsNewBranch indicates whether the given local branch was just created
+// and hasn't been updated since.
+func (self *BackendCommands) IsNewBranch(branch gitdomain.LocalBranchName) bool {
+	output, err := self.Runner.QueryTrim("git", "reflog", "show", "--format=%gs", "refs/heads/"+branch.String())
+	if err != nil || output == "" {
+		return false
+	}
+	lines := stringslice.Lines(output)
+	return len(lines) == 1 && strings.HasPrefix(lines[0], "branch: Created from ")
+}
+
+// IsSquashMergedInto indicates whether the given target branch contains a commit
+// that makes the same changes as all commits of the given branch since the given base commit squashed together.
+// This is the case when a proposal for the branch was squash-merged on the code hosting platform.
+// An empty base stands for the merge base of the branch and the target branch.
+func (self *BackendCommands) IsSquashMergedInto(branch, target gitdomain.BranchName, base gitdomain.SHA) (bool, error) {
+	if self.Runner.Run("git", "diff", "--quiet", target.String()+"..."+branch.String()) == nil {
+		// the branch makes no changes
+		return false, nil
+	}
+	mergeBase := base.String()
+	if base.IsEmpty() {
+		var err error
+		mergeBase, err = self.Runner.QueryTrim("git", "merge-base", target.String(), branch.String())
+		if err != nil {
+			return false, err
+		}
+	}
+	branchTree, err := self.Runner.QueryTrim("git", "rev-parse", branch.String()+"^{tree}")
+	if err != nil {
+		return false, err
+	}
+	// create a dangling commit that contains all changes of the branch squashed together
+	// and ask Git whether the target branch contains a commit with the same patch id
+	squashCommit, err := self.Runner.QueryTrim("git", "commit-tree", branchTree, "-p", mergeBase, "-m", "squashed "+branch.String())
+	if err != nil {
+		return false, err
+	}
+	output, err := self.Runner.QueryTrim("git", "cherry", target.String(), squashCommit, mergeBase)
+	if err != nil {
+		return false, err
+	}
+	// no output means the target branch already contains the squash commit itself
+	return output == "" || strings.HasPrefix(output, "-"), nil
+}
+
 // LastCommitMessage provides the commit message for the last commit.
 func (self *BackendCommands) LastCommitMessage() (string, error) {
 	out, err := self.Runner.QueryTrim("git", "log", "-1", "--format=%B")
@@ -302,6 +447,18 @@ func (self *BackendCommands) LastCommitMessage() (string, error) {
 	return out, nil
 }
 
+// PrependToSquashCommitMessage adds the given text uncommented to the beginning of the squash commit message
+// that Git pre-populates the commit message editor with.
+func (self *BackendCommands) PrependToSquashCommitMessage(text string) error {
+	squashMessageFile := ".git/SQUASH_MSG"
+	contentBytes, err := os.ReadFile(squashMessageFile)
+	if err != nil {
+		return fmt.Errorf(messages.SquashCannotReadFile, squashMessageFile, err)
+	}
+	content := text + "\n\n" + string(contentBytes)
+	return os.WriteFile(squashMessageFile, []byte(content), 0o600)
+}
+
 // PreviouslyCheckedOutBranch provides the name of the branch that was previously checked out in this repo.
 func (self *BackendCommands) PreviouslyCheckedOutBranch() gitdomain.LocalBranchName {
 	output, err := self.Runner.QueryTrim("git", "rev-parse", "--verify", "--abbrev-ref", "@{-1}")
@@ -314,6 +471,32 @@ func (self *BackendCommands) PreviouslyCheckedOutBranch() gitdomain.LocalBranchN
 	return gitdomain.NewLocalBranchName(output)
 }
 
+// ReadBlobRef provides the content of the blob that the given ref points to.
+// The boolean return value indicates whether the ref exists.
+func (self *BackendCommands) ReadBlobRef(ref string) (string, bool) {
+	output, err := self.Runner.Query("git", "cat-file", "-p", ref)
+	if err != nil {
+		return "", false
+	}
+	return output, true
+}
+
+// RemoteBranchNames provides the names of the branches that currently exist at the given remote.
+func (self *BackendCommands) RemoteBranchNames(remote gitdomain.Remote) (gitdomain.LocalBranchNames, error) {
+	out, err := self.Runner.QueryTrim("git", "ls-remote", "--heads", remote.String())
+	if err != nil {
+		return gitdomain.LocalBranchNames{}, fmt.Errorf(messages.RemoteBranchesProblem, remote, err)
+	}
+	result := gitdomain.LocalBranchNames{}
+	for _, line := range stringslice.Lines(out) {
+		_, ref, found := strings.Cut(line, "\t")
+		if found {
+			result = append(result, gitdomain.NewLocalBranchName(strings.TrimPrefix(ref, "refs/heads/")))
+		}
+	}
+	return result, nil
+}
+
 // Remotes provides the names of all Git remotes in this repository.
 func (self *BackendCommands) Remotes() (gitdomain.Remotes, error) {
 	if !self.RemotesCache.Initialized() {
@@ -347,6 +530,11 @@ func (self *BackendCommands) RemoveOutdatedConfiguration(localBranches gitdomain
 			self.Config.RemoveParent(child)
 		}
 	}
+	for _, branch := range self.Config.FullConfig.BranchSyncStrategies.BranchNames() {
+		if !localBranches.Contains(branch) {
+			self.Config.RemoveBranchSyncStrategy(branch)
+		}
+	}
 	return nil
 }
 
@@ -392,6 +580,10 @@ func (self *BackendCommands) SHAForBranch(name gitdomain.BranchName) (gitdomain.
 func (self *BackendCommands) ShouldPushBranch(branch gitdomain.LocalBranchName, trackingBranch gitdomain.RemoteBranchName) (bool, error) {
 	out, err := self.Runner.QueryTrim("git", "rev-list", "--left-right", branch.String()+"..."+trackingBranch.String())
 	if err != nil {
+		if !self.HasRemoteBranch(trackingBranch) {
+			// the branch doesn't exist at the remote it pushes to yet
+			return true, nil
+		}
 		return false, fmt.Errorf(messages.DiffProblem, branch, branch, err)
 	}
 	return out != "", nil
@@ -425,6 +617,37 @@ func (self *BackendCommands) Version() (major int, minor int, err error) {
 	return majorVersion, minorVersion, nil
 }
 
+// Worktrees provides the directories of the worktrees in which the local branches are checked out.
+func (self *BackendCommands) Worktrees() (gitdomain.Worktrees, error) {
+	output, err := self.Runner.Query("git", "worktree", "list", "--porcelain")
+	if err != nil {
+		return gitdomain.Worktrees{}, err
+	}
+	return ParseWorktreeListOutput(output), nil
+}
+
+// WriteBlobRef stores the given content as a Git blob and points the given ref to it.
+func (self *BackendCommands) WriteBlobRef(ref, content string) error {
+	file, err := os.CreateTemp("", "git-town-blob-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(content)
+	if err != nil {
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+	sha, err := self.Runner.QueryTrim("git", "hash-object", "-w", file.Name())
+	if err != nil {
+		return err
+	}
+	return self.Runner.Run("git", "update-ref", ref, sha)
+}
+
 func (self *BackendCommands) currentBranchDuringRebase() (gitdomain.LocalBranchName, error) {
 	rootDir := self.RootDirectory()
 	rawContent, err := os.ReadFile(fmt.Sprintf("%s/.git/rebase-apply/head-name", rootDir))
@@ -507,6 +730,22 @@ func ParseVerboseBranchesOutput(output string) (gitdomain.BranchInfos, gitdomain
 	return result, checkedoutBranch
 }
 
+// ParseWorktreeListOutput provides the worktrees in the given output of "git worktree list --porcelain".
+func ParseWorktreeListOutput(output string) gitdomain.Worktrees {
+	result := gitdomain.Worktrees{}
+	directory := ""
+	for _, line := range stringslice.Lines(output) {
+		if path, isWorktree := strings.CutPrefix(line, "worktree "); isWorktree {
+			directory = path
+			continue
+		}
+		if branch, isBranch := strings.CutPrefix(line, "branch refs/heads/"); isBranch {
+			result[gitdomain.NewLocalBranchName(branch)] = directory
+		}
+	}
+	return result
+}
+
 func determineSyncStatus(branchName, remoteText string) (syncStatus gitdomain.SyncStatus, trackingBranchName gitdomain.RemoteBranchName) {
 	isInSync, trackingBranchName := IsInSync(branchName, remoteText)
 	if isInSync {
@@ -1,6 +1,7 @@
 package git_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/git-town/git-town/v12/src/git"
@@ -39,6 +40,22 @@ func TestBackendCommands(t *testing.T) {
 		must.Eq(t, []string{"user <email@example.com>"}, authors)
 	})
 
+	t.Run("BranchCreationSource", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch created from another branch", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			must.EqOp(t, "initial", runtime.Backend.BranchCreationSource(branch))
+		})
+		t.Run("branch without reflog", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			must.EqOp(t, "", runtime.Backend.BranchCreationSource(gitdomain.NewLocalBranchName("zonk")))
+		})
+	})
+
 	t.Run("BranchHasUnmergedChanges", func(t *testing.T) {
 		t.Parallel()
 		t.Run("branch without commits", func(t *testing.T) {
@@ -96,6 +113,31 @@ func TestBackendCommands(t *testing.T) {
 		must.EqOp(t, initial, currentBranch)
 	})
 
+	t.Run("CommitsAheadCount", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		branch := gitdomain.NewLocalBranchName("branch")
+		runtime.CreateBranch(branch, initial)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      branch,
+			FileContent: "file1",
+			FileName:    "file1",
+			Message:     "first commit",
+		})
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      branch,
+			FileContent: "file2",
+			FileName:    "file2",
+			Message:     "second commit",
+		})
+		have, err := runtime.Backend.CommitsAheadCount(branch.BranchName(), initial.BranchName())
+		must.NoError(t, err)
+		must.EqOp(t, 2, have)
+		have, err = runtime.Backend.CommitsAheadCount(initial.BranchName(), branch.BranchName())
+		must.NoError(t, err)
+		must.EqOp(t, 0, have)
+	})
+
 	t.Run("CommitsInBranch", func(t *testing.T) {
 		t.Parallel()
 		t.Run("feature branch contains commits", func(t *testing.T) {
@@ -224,6 +266,121 @@ func TestBackendCommands(t *testing.T) {
 		must.False(t, runner.Backend.HasLocalBranch(gitdomain.NewLocalBranchName("b3")))
 	})
 
+	t.Run("HasMergeCommits", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch contains a merge commit", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			other := gitdomain.NewLocalBranchName("other")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateBranch(other, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      other,
+				FileContent: "file1",
+				FileName:    "file1",
+				Message:     "other commit",
+			})
+			runtime.CheckoutBranch(branch)
+			runtime.MustRun("git", "merge", "--no-ff", "--no-edit", other.String())
+			have, err := runtime.Backend.HasMergeCommits(branch.BranchName(), initial.BranchName())
+			must.NoError(t, err)
+			must.True(t, have)
+		})
+		t.Run("branch contains no merge commits", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "file1",
+				FileName:    "file1",
+				Message:     "branch commit",
+			})
+			have, err := runtime.Backend.HasMergeCommits(branch.BranchName(), initial.BranchName())
+			must.NoError(t, err)
+			must.False(t, have)
+		})
+	})
+
+	t.Run("HasRemoteBranch", func(t *testing.T) {
+		t.Parallel()
+		origin := testruntime.Create(t)
+		origin.CreateBranch(gitdomain.NewLocalBranchName("b1"), initial)
+		repoDir := t.TempDir()
+		runner := testruntime.Clone(origin.TestRunner, repoDir)
+		must.True(t, runner.Backend.HasRemoteBranch(gitdomain.NewRemoteBranchName("origin/b1")))
+		must.False(t, runner.Backend.HasRemoteBranch(gitdomain.NewRemoteBranchName("origin/b2")))
+	})
+
+	t.Run("IsAncestor", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		branch := gitdomain.NewLocalBranchName("branch")
+		runtime.CreateBranch(branch, initial)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      branch,
+			FileContent: "file1",
+			FileName:    "file1",
+			Message:     "branch commit",
+		})
+		must.True(t, runtime.Backend.IsAncestor(initial.BranchName(), branch.BranchName()))
+		must.False(t, runtime.Backend.IsAncestor(branch.BranchName(), initial.BranchName()))
+	})
+
+	t.Run("IsSquashMergedInto", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch squash-merged into the target", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "file1",
+				FileName:    "file1",
+				Message:     "first commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "file2",
+				FileName:    "file2",
+				Message:     "second commit",
+			})
+			runtime.CheckoutBranch(initial)
+			runtime.MustRun("git", "merge", "--squash", branch.String())
+			runtime.MustRun("git", "commit", "-m", "squashed")
+			have, err := runtime.Backend.IsSquashMergedInto(branch.BranchName(), initial.BranchName(), gitdomain.EmptySHA())
+			must.NoError(t, err)
+			must.True(t, have)
+		})
+		t.Run("branch not merged into the target", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "file1",
+				FileName:    "file1",
+				Message:     "first commit",
+			})
+			have, err := runtime.Backend.IsSquashMergedInto(branch.BranchName(), initial.BranchName(), gitdomain.EmptySHA())
+			must.NoError(t, err)
+			must.False(t, have)
+		})
+		t.Run("branch without changes", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial)
+			have, err := runtime.Backend.IsSquashMergedInto(branch.BranchName(), initial.BranchName(), gitdomain.EmptySHA())
+			must.NoError(t, err)
+			must.False(t, have)
+		})
+	})
+
 	t.Run("RepoStatus", func(t *testing.T) {
 		t.Run("HasOpenChanges", func(t *testing.T) {
 			t.Parallel()
@@ -689,6 +846,29 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("ParseWorktreeListOutput", func(t *testing.T) {
+		t.Parallel()
+		give := `
+worktree /home/user/repo
+HEAD 01a7eded2a4c8d23e0c6b3c7fd3c7b1e7b0c1e4a
+branch refs/heads/main
+
+worktree /home/user/repo-feature
+HEAD da796a69ec8d23e0c6b3c7fd3c7b1e7b0c1e4a01
+branch refs/heads/feature/one
+
+worktree /home/user/repo-detached
+HEAD f4ebec0a2a4c8d23e0c6b3c7fd3c7b1e7b0c1e4a
+detached
+`[1:]
+		have := git.ParseWorktreeListOutput(give)
+		want := gitdomain.Worktrees{
+			gitdomain.NewLocalBranchName("main"):        "/home/user/repo",
+			gitdomain.NewLocalBranchName("feature/one"): "/home/user/repo-feature",
+		}
+		must.Eq(t, want, have)
+	})
+
 	t.Run("PreviouslyCheckedOutBranch", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -700,6 +880,17 @@ func TestBackendCommands(t *testing.T) {
 		must.EqOp(t, gitdomain.NewLocalBranchName("feature1"), have)
 	})
 
+	t.Run("RemoteBranchNames", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		origin := testruntime.Create(t)
+		origin.CreateBranch(gitdomain.NewLocalBranchName("feature"), initial)
+		runtime.AddRemote(gitdomain.OriginRemote, origin.WorkingDir)
+		have, err := runtime.Backend.RemoteBranchNames(gitdomain.OriginRemote)
+		must.NoError(t, err)
+		must.Eq(t, gitdomain.NewLocalBranchNames("feature", "initial"), have)
+	})
+
 	t.Run("Remotes", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -762,4 +953,17 @@ func TestBackendCommands(t *testing.T) {
 			must.EqOp(t, want, have)
 		})
 	})
+
+	t.Run("Worktrees", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		branch := gitdomain.NewLocalBranchName("feature")
+		runtime.CreateBranch(branch, initial)
+		worktreeDir := filepath.Join(t.TempDir(), "feature")
+		runtime.AddWorktree(worktreeDir, branch)
+		have, err := runtime.Backend.Worktrees()
+		must.NoError(t, err)
+		must.EqOp(t, worktreeDir, have[branch])
+		must.EqOp(t, runtime.WorkingDir, have[initial])
+	})
 }
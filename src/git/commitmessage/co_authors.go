@@ -0,0 +1,65 @@
+package commitmessage
+
+import (
+	"regexp"
+	"strings"
+)
+
+// CoAuthorTrailer is the Git trailer that GitHub, GitLab, and Gitea use to attribute additional authors to a commit.
+const CoAuthorTrailer = "Co-authored-by"
+
+var trailerRE = regexp.MustCompile(`^[A-Za-z0-9-]+: `)
+
+// AddCoAuthors appends "Co-authored-by" trailers for the given authors to the given commit message.
+// If the message already ends in a block of trailers, the new trailers get added to that block.
+func AddCoAuthors(message string, coAuthors []string) string {
+	if len(coAuthors) == 0 {
+		return message
+	}
+	message = strings.TrimRight(message, "\n ")
+	lines := make([]string, 0, len(coAuthors))
+	for _, coAuthor := range coAuthors {
+		trailer := CoAuthorTrailer + ": " + coAuthor
+		if strings.Contains(message, trailer) {
+			continue
+		}
+		lines = append(lines, trailer)
+	}
+	if len(lines) == 0 {
+		return message
+	}
+	trailers := strings.Join(lines, "\n")
+	switch {
+	case message == "":
+		return trailers
+	case endsWithTrailers(message):
+		return message + "\n" + trailers
+	default:
+		return message + "\n\n" + trailers
+	}
+}
+
+// CoAuthors provides the given branch authors except the given commit author.
+func CoAuthors(branchAuthors []string, author string) []string {
+	result := make([]string, 0, len(branchAuthors))
+	for _, branchAuthor := range branchAuthors {
+		if branchAuthor != author {
+			result = append(result, branchAuthor)
+		}
+	}
+	return result
+}
+
+// endsWithTrailers indicates whether the last paragraph of the given multi-paragraph commit message consists only of Git trailers.
+func endsWithTrailers(message string) bool {
+	paragraphs := strings.Split(message, "\n\n")
+	if len(paragraphs) < 2 {
+		return false
+	}
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		if !trailerRE.MatchString(line) {
+			return false
+		}
+	}
+	return true
+}
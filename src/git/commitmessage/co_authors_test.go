@@ -0,0 +1,42 @@
+package commitmessage_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/git/commitmessage"
+	"github.com/shoenig/test/must"
+)
+
+func TestCoAuthors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("AddCoAuthors", func(t *testing.T) {
+		t.Parallel()
+		coAuthors := []string{"one <one@acme.com>", "two <two@acme.com>"}
+		tests := map[string]string{
+			"title":               "title\n\nCo-authored-by: one <one@acme.com>\nCo-authored-by: two <two@acme.com>",
+			"title\n\nbody\n":     "title\n\nbody\n\nCo-authored-by: one <one@acme.com>\nCo-authored-by: two <two@acme.com>",
+			"title\n\nRefs: #123": "title\n\nRefs: #123\nCo-authored-by: one <one@acme.com>\nCo-authored-by: two <two@acme.com>",
+			"":                    "Co-authored-by: one <one@acme.com>\nCo-authored-by: two <two@acme.com>",
+			"Refs: #123":          "Refs: #123\n\nCo-authored-by: one <one@acme.com>\nCo-authored-by: two <two@acme.com>",
+			"title\n\nCo-authored-by: one <one@acme.com>": "title\n\nCo-authored-by: one <one@acme.com>\nCo-authored-by: two <two@acme.com>",
+		}
+		for give, want := range tests {
+			have := commitmessage.AddCoAuthors(give, coAuthors)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("AddCoAuthors without co-authors", func(t *testing.T) {
+		t.Parallel()
+		have := commitmessage.AddCoAuthors("title\n\nbody\n", []string{})
+		must.EqOp(t, "title\n\nbody\n", have)
+	})
+
+	t.Run("CoAuthors", func(t *testing.T) {
+		t.Parallel()
+		have := commitmessage.CoAuthors([]string{"one <one@acme.com>", "two <two@acme.com>", "three <three@acme.com>"}, "two <two@acme.com>")
+		want := []string{"one <one@acme.com>", "three <three@acme.com>"}
+		must.Eq(t, want, have)
+	})
+}
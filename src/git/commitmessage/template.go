@@ -0,0 +1,100 @@
+package commitmessage
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TemplateData contains the values for the placeholders in commit message templates.
+type TemplateData struct {
+	Authors        []string
+	Branch         string
+	Parent         string
+	ProposalBody   string
+	ProposalNumber int // 0 if there is no proposal
+	ProposalTitle  string
+	Tickets        []string
+}
+
+var (
+	// placeholderRE matches placeholders together with the space before them and brackets around them,
+	// so that "Title (#{{proposal-number}})" renders as "Title" if there is no proposal
+	placeholderRE = regexp.MustCompile(`( ?)([(\[]?#?)\{\{\s*([a-z-]+)\s*\}\}([)\]]?)`)
+	blankLinesRE  = regexp.MustCompile(`\n{3,}`)
+)
+
+// RenderTemplate provides the commit message defined by the given template, filled in with the given data.
+//
+// Supported placeholders:
+//   - {{branch}}: name of the shipped branch
+//   - {{parent}}: name of the branch that receives the squash commit
+//   - {{proposal-number}}, {{proposal-title}}, {{proposal-body}}: details of the proposal for the shipped branch
+//   - {{authors}}: comma-separated list of the people who committed to the shipped branch
+//   - {{tickets}}: comma-separated list of the ticket IDs found via the ticket regex
+//
+// Empty placeholders get removed together with the brackets around them.
+// Lines whose placeholders all render empty get removed, for example a "Refs: {{tickets}}" trailer when no tickets exist.
+// If the first line renders empty, the commit message starts with the branch name.
+func RenderTemplate(template string, data TemplateData) string {
+	values := data.values()
+	lines := []string{}
+	titleDone := false
+	for _, line := range strings.Split(strings.ReplaceAll(template, "\r\n", "\n"), "\n") {
+		isTitle := !titleDone && strings.TrimSpace(line) != ""
+		if isTitle {
+			titleDone = true
+		}
+		placeholders := placeholderRE.FindAllStringSubmatch(line, -1)
+		if !isTitle && len(placeholders) > 0 && allEmpty(placeholders, values) {
+			continue
+		}
+		rendered := placeholderRE.ReplaceAllStringFunc(line, func(placeholder string) string {
+			parts := placeholderRE.FindStringSubmatch(placeholder)
+			value, known := values[parts[3]]
+			if !known {
+				return placeholder
+			}
+			if value == "" {
+				return ""
+			}
+			return parts[1] + parts[2] + value + parts[4]
+		})
+		if isTitle && strings.TrimSpace(rendered) == "" {
+			rendered = data.Branch
+		}
+		lines = append(lines, rendered)
+	}
+	message := Split(strings.TrimSpace(strings.Join(lines, "\n")))
+	body := strings.TrimSpace(blankLinesRE.ReplaceAllString(message.Body, "\n\n"))
+	if body == "" {
+		return message.Title
+	}
+	return message.Title + "\n\n" + body
+}
+
+func allEmpty(placeholders [][]string, values map[string]string) bool {
+	for _, placeholder := range placeholders {
+		value, known := values[placeholder[3]]
+		if !known || value != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (self TemplateData) values() map[string]string {
+	proposalNumber := ""
+	if self.ProposalNumber > 0 {
+		proposalNumber = strconv.Itoa(self.ProposalNumber)
+	}
+	return map[string]string{
+		"authors":         strings.Join(self.Authors, ", "),
+		"branch":          self.Branch,
+		"parent":          self.Parent,
+		"proposal-body":   strings.TrimSpace(self.ProposalBody),
+		"proposal-number": proposalNumber,
+		"proposal-title":  self.ProposalTitle,
+		"tickets":         strings.Join(self.Tickets, ", "),
+	}
+}
@@ -0,0 +1,68 @@
+package commitmessage_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/git/commitmessage"
+	"github.com/shoenig/test/must"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all placeholders", func(t *testing.T) {
+		t.Parallel()
+		template := "{{proposal-title}} (#{{proposal-number}})\n\n{{proposal-body}}\n\nbranch: {{branch}} into {{ parent }}\nauthors: {{authors}}\nRefs: {{tickets}}"
+		data := commitmessage.TemplateData{
+			Authors:        []string{"one <one@acme.com>", "two <two@acme.com>"},
+			Branch:         "ABC-123-fix-login",
+			Parent:         "main",
+			ProposalBody:   "Fixes the login.\n",
+			ProposalNumber: 42,
+			ProposalTitle:  "Fix login",
+			Tickets:        []string{"ABC-123"},
+		}
+		have := commitmessage.RenderTemplate(template, data)
+		want := "Fix login (#42)\n\nFixes the login.\n\nbranch: ABC-123-fix-login into main\nauthors: one <one@acme.com>, two <two@acme.com>\nRefs: ABC-123"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("removes lines whose placeholders are all empty", func(t *testing.T) {
+		t.Parallel()
+		template := "{{proposal-title}} (#{{proposal-number}})\n\n{{proposal-body}}\n\nRefs: {{tickets}}"
+		data := commitmessage.TemplateData{ //nolint:exhaustruct
+			ProposalNumber: 42,
+			ProposalTitle:  "Fix login",
+		}
+		have := commitmessage.RenderTemplate(template, data)
+		must.EqOp(t, "Fix login (#42)", have)
+	})
+
+	t.Run("removes empty placeholders together with their brackets", func(t *testing.T) {
+		t.Parallel()
+		template := "{{proposal-title}} (#{{proposal-number}}) [{{tickets}}]\n\nbranch: {{branch}}"
+		data := commitmessage.TemplateData{ //nolint:exhaustruct
+			Branch:        "fix-login",
+			ProposalTitle: "Fix login",
+		}
+		have := commitmessage.RenderTemplate(template, data)
+		must.EqOp(t, "Fix login\n\nbranch: fix-login", have)
+	})
+
+	t.Run("uses the branch name if the first line renders empty", func(t *testing.T) {
+		t.Parallel()
+		template := "{{proposal-title}} (#{{proposal-number}})\n\n{{proposal-body}}\n\nauthors: {{authors}}"
+		data := commitmessage.TemplateData{ //nolint:exhaustruct
+			Authors: []string{"one <one@acme.com>"},
+			Branch:  "fix-login",
+		}
+		have := commitmessage.RenderTemplate(template, data)
+		must.EqOp(t, "fix-login\n\nauthors: one <one@acme.com>", have)
+	})
+
+	t.Run("keeps unknown placeholders", func(t *testing.T) {
+		t.Parallel()
+		have := commitmessage.RenderTemplate("{{branch}}: {{unknown}}", commitmessage.TemplateData{Branch: "feature"}) //nolint:exhaustruct
+		must.EqOp(t, "feature: {{unknown}}", have)
+	})
+}
@@ -45,6 +45,17 @@ func (self *FrontendCommands) CheckoutBranch(name gitdomain.LocalBranchName) err
 	return nil
 }
 
+// CheckoutRemoteBranch creates a local branch that tracks the given remote branch and checks it out.
+func (self *FrontendCommands) CheckoutRemoteBranch(remoteBranch gitdomain.RemoteBranchName) error {
+	localBranch := remoteBranch.LocalBranchName()
+	err := self.Runner.Run("git", "checkout", "--track", remoteBranch.String())
+	self.SetCachedCurrentBranch(localBranch)
+	if err != nil {
+		return fmt.Errorf(messages.BranchCheckoutProblem, localBranch, err)
+	}
+	return nil
+}
+
 // Commit performs a commit of the staged changes with an optional custom message and author.
 func (self *FrontendCommands) Commit(message, author string) error {
 	gitArgs := []string{"commit"}
@@ -158,6 +169,16 @@ func (self *FrontendCommands) ForcePushBranch(noPushHook configdomain.NoPushHook
 	return self.Runner.Run("git", args...)
 }
 
+// ForcePushLocalBranch force-pushes the given local branch to the given remote.
+func (self *FrontendCommands) ForcePushLocalBranch(branch gitdomain.LocalBranchName, remote gitdomain.Remote, noPushHook configdomain.NoPushHook) error {
+	args := []string{"push", "--force-with-lease"}
+	if noPushHook {
+		args = append(args, "--no-verify")
+	}
+	args = append(args, remote.String(), branch.String())
+	return self.Runner.Run("git", args...)
+}
+
 // MergeBranchNoEdit merges the given branch into the current branch,
 // using the default commit message.
 func (self *FrontendCommands) MergeBranchNoEdit(branch gitdomain.BranchName) error {
@@ -188,6 +209,23 @@ func (self *FrontendCommands) PushCurrentBranch(noPushHook configdomain.NoPushHo
 	return self.Runner.Run("git", args...)
 }
 
+// PushLocalBranch pushes the given local branch to the given remote.
+func (self *FrontendCommands) PushLocalBranch(branch gitdomain.LocalBranchName, remote gitdomain.Remote, noPushHook configdomain.NoPushHook) error {
+	args := []string{"push"}
+	if noPushHook {
+		args = append(args, "--no-verify")
+	}
+	args = append(args, remote.String(), branch.String())
+	return self.Runner.Run("git", args...)
+}
+
+// PushRef pushes the given ref to the ref with the same name at the given remote.
+// It overwrites the remote ref only if it still points to the object that the given expected ref points to,
+// or doesn't exist if the given expected ref is empty.
+func (self *FrontendCommands) PushRef(remote gitdomain.Remote, ref, expected string) error {
+	return self.Runner.Run("git", "push", "--force-with-lease="+ref+":"+expected, remote.String(), ref+":"+ref)
+}
+
 // PushTags pushes new the Git tags to origin.
 func (self *FrontendCommands) PushTags() error {
 	return self.Runner.Run("git", "push", "--tags")
@@ -198,12 +236,32 @@ func (self *FrontendCommands) Rebase(target gitdomain.BranchName) error {
 	return self.Runner.Run("git", "rebase", target.String())
 }
 
+// RebaseOnto moves the commits of the current branch that aren't in the given upstream
+// to the top of the given branch, removing the commits reachable from upstream.
+func (self *FrontendCommands) RebaseOnto(branch gitdomain.BranchName, upstream gitdomain.Location) error {
+	return self.Runner.Run("git", "rebase", "--onto", branch.String(), upstream.String())
+}
+
+// RebaseUpdateRefs rebases the current branch against the given branch
+// and updates all local branches that point to commits being rebased.
+func (self *FrontendCommands) RebaseUpdateRefs(target gitdomain.BranchName) error {
+	return self.Runner.Run("git", "rebase", "--update-refs", target.String())
+}
+
 // RemoveGitAlias removes the given Git alias.
 func (self *FrontendCommands) RemoveGitAlias(aliasableCommand configdomain.AliasableCommand) error {
 	aliasKey := gitconfig.KeyForAliasableCommand(aliasableCommand)
 	return self.Runner.Run("git", "config", "--global", "--unset", aliasKey.String())
 }
 
+// RemoveGitConfig removes the given setting from the local or global Git configuration.
+func (self *FrontendCommands) RemoveGitConfig(key gitconfig.Key, global bool) error {
+	if global {
+		return self.Runner.Run("git", "config", "--global", "--unset", key.String())
+	}
+	return self.Runner.Run("git", "config", "--unset", key.String())
+}
+
 // ResetCurrentBranchToSHA undoes all commits on the current branch all the way until the given SHA.
 func (self *FrontendCommands) ResetCurrentBranchToSHA(sha gitdomain.SHA, hard bool) error {
 	args := []string{"reset"}
@@ -216,7 +274,7 @@ func (self *FrontendCommands) ResetCurrentBranchToSHA(sha gitdomain.SHA, hard bo
 
 // ResetRemoteBranchToSHA sets the given remote branch to the given SHA.
 func (self *FrontendCommands) ResetRemoteBranchToSHA(branch gitdomain.RemoteBranchName, sha gitdomain.SHA) error {
-	return self.Runner.Run("git", "push", "--force-with-lease", gitdomain.OriginRemote.String(), sha.String()+":"+branch.LocalBranchName().String())
+	return self.Runner.Run("git", "push", "--force-with-lease", branch.Remote().String(), sha.String()+":"+branch.LocalBranchName().String())
 }
 
 // RevertCommit reverts the commit with the given SHA.
@@ -229,6 +287,14 @@ func (self *FrontendCommands) SetGitAlias(aliasableCommand configdomain.Aliasabl
 	return self.Runner.Run("git", "config", "--global", gitconfig.KeyForAliasableCommand(aliasableCommand).String(), "town "+aliasableCommand.String())
 }
 
+// SetGitConfig sets the given setting in the local or global Git configuration.
+func (self *FrontendCommands) SetGitConfig(key gitconfig.Key, value string, global bool) error {
+	if global {
+		return self.Runner.Run("git", "config", "--global", key.String(), value)
+	}
+	return self.Runner.Run("git", "config", key.String(), value)
+}
+
 // SetGitHubToken sets the given API token for the GitHub API.
 func (self *FrontendCommands) SetGitHubToken(value configdomain.GitHubToken) error {
 	return self.Runner.Run("git", "config", "git-town.github-token", value.String())
@@ -0,0 +1,4 @@
+package gitdomain
+
+// Worktrees provides the directories of the worktrees in which local branches are checked out.
+type Worktrees map[LocalBranchName]string
@@ -0,0 +1,15 @@
+// Package githooks installs and uninstalls the Git hooks that Git Town uses.
+package githooks
+
+// PostCheckout is the name of the Git hook that runs after "git checkout" and "git switch".
+const PostCheckout = "post-checkout"
+
+// chainedSuffix is the file name suffix for pre-existing hooks that the Git Town hook calls before it runs.
+const chainedSuffix = ".git-town-chained"
+
+// marker identifies hook scripts that were installed by Git Town.
+const marker = "# installed by Git Town"
+
+// SkipEnvVar is the name of the environment variable that makes the Git Town hooks do nothing.
+// Git Town sets it for the Git commands it runs itself because it maintains the lineage of the branches it creates.
+const SkipEnvVar = "GIT_TOWN_SKIP_HOOKS"
@@ -0,0 +1,92 @@
+package githooks_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/githooks"
+	"github.com/shoenig/test/must"
+)
+
+func TestGitHooks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Install", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no existing hook", func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			chained, err := githooks.Install(dir)
+			must.NoError(t, err)
+			must.False(t, chained)
+			content, err := os.ReadFile(filepath.Join(dir, githooks.PostCheckout))
+			must.NoError(t, err)
+			must.True(t, githooks.IsGitTownHook(string(content)))
+			info, err := os.Stat(filepath.Join(dir, githooks.PostCheckout))
+			must.NoError(t, err)
+			must.NonZero(t, info.Mode()&0o100)
+		})
+		t.Run("existing hook", func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			existing := "#!/bin/sh\necho existing hook\n"
+			must.NoError(t, os.WriteFile(filepath.Join(dir, githooks.PostCheckout), []byte(existing), 0o700))
+			chained, err := githooks.Install(dir)
+			must.NoError(t, err)
+			must.True(t, chained)
+			content, err := os.ReadFile(filepath.Join(dir, githooks.PostCheckout+".git-town-chained"))
+			must.NoError(t, err)
+			must.EqOp(t, existing, string(content))
+		})
+		t.Run("already installed", func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			_, err := githooks.Install(dir)
+			must.NoError(t, err)
+			chained, err := githooks.Install(dir)
+			must.NoError(t, err)
+			must.False(t, chained)
+			_, err = os.Stat(filepath.Join(dir, githooks.PostCheckout+".git-town-chained"))
+			must.True(t, os.IsNotExist(err))
+		})
+	})
+
+	t.Run("Uninstall", func(t *testing.T) {
+		t.Parallel()
+		t.Run("restores the chained hook", func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			existing := "#!/bin/sh\necho existing hook\n"
+			must.NoError(t, os.WriteFile(filepath.Join(dir, githooks.PostCheckout), []byte(existing), 0o700))
+			_, err := githooks.Install(dir)
+			must.NoError(t, err)
+			uninstalled, err := githooks.Uninstall(dir)
+			must.NoError(t, err)
+			must.True(t, uninstalled)
+			content, err := os.ReadFile(filepath.Join(dir, githooks.PostCheckout))
+			must.NoError(t, err)
+			must.EqOp(t, existing, string(content))
+			_, err = os.Stat(filepath.Join(dir, githooks.PostCheckout+".git-town-chained"))
+			must.True(t, os.IsNotExist(err))
+		})
+		t.Run("leaves foreign hooks alone", func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			existing := "#!/bin/sh\necho existing hook\n"
+			must.NoError(t, os.WriteFile(filepath.Join(dir, githooks.PostCheckout), []byte(existing), 0o700))
+			uninstalled, err := githooks.Uninstall(dir)
+			must.NoError(t, err)
+			must.False(t, uninstalled)
+			content, err := os.ReadFile(filepath.Join(dir, githooks.PostCheckout))
+			must.NoError(t, err)
+			must.EqOp(t, existing, string(content))
+		})
+		t.Run("not installed", func(t *testing.T) {
+			t.Parallel()
+			uninstalled, err := githooks.Uninstall(t.TempDir())
+			must.NoError(t, err)
+			must.False(t, uninstalled)
+		})
+	})
+}
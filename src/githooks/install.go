@@ -0,0 +1,50 @@
+package githooks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// Install installs the Git Town post-checkout hook into the given hooks directory.
+// An existing hook that wasn't installed by Git Town gets chained, i.e. the Git Town hook calls it.
+// Indicates whether it chained an existing hook.
+func Install(hooksDir string) (chained bool, err error) { //nolint:nonamedreturns
+	err = os.MkdirAll(hooksDir, 0o755)
+	if err != nil {
+		return false, fmt.Errorf(messages.HooksCannotInstall, hooksDir, err)
+	}
+	hookPath := filepath.Join(hooksDir, PostCheckout)
+	existing, err := os.ReadFile(hookPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return false, fmt.Errorf(messages.HooksCannotInstall, hookPath, err)
+	case IsGitTownHook(string(existing)):
+		// already installed, update it in place
+	default:
+		chainedPath := hookPath + chainedSuffix
+		if _, statErr := os.Stat(chainedPath); statErr == nil {
+			return false, fmt.Errorf(messages.HooksChainedExists, chainedPath)
+		}
+		err = os.Rename(hookPath, chainedPath)
+		if err != nil {
+			return false, fmt.Errorf(messages.HooksCannotInstall, hookPath, err)
+		}
+		chained = true
+	}
+	err = os.WriteFile(hookPath, []byte(postCheckoutScript), 0o755) //nolint:gosec // hooks must be executable
+	if err != nil {
+		return chained, fmt.Errorf(messages.HooksCannotInstall, hookPath, err)
+	}
+	return chained, nil
+}
+
+// IsGitTownHook indicates whether the given hook script content was installed by Git Town.
+func IsGitTownHook(content string) bool {
+	return strings.Contains(content, marker)
+}
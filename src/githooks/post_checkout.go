@@ -0,0 +1,18 @@
+package githooks
+
+// postCheckoutScript is the content of the post-checkout hook.
+// It calls the hook that existed before Git Town installed its hook,
+// then lets Git Town record the lineage of newly created branches.
+const postCheckoutScript = `#!/bin/sh
+` + marker + `, remove via "git town hooks uninstall"
+chained="$(dirname "$0")/` + PostCheckout + chainedSuffix + `"
+status=0
+if [ -x "$chained" ]; then
+	"$chained" "$@"
+	status=$?
+fi
+if command -v git-town > /dev/null 2>&1; then
+	git-town hooks post-checkout "$@"
+fi
+exit $status
+`
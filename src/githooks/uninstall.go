@@ -0,0 +1,39 @@
+package githooks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v12/src/messages"
+)
+
+// Uninstall removes the Git Town post-checkout hook from the given hooks directory
+// and restores the hook that it chained.
+// Indicates whether a Git Town hook was installed.
+func Uninstall(hooksDir string) (bool, error) {
+	hookPath := filepath.Join(hooksDir, PostCheckout)
+	content, err := os.ReadFile(hookPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf(messages.HooksCannotUninstall, hookPath, err)
+	}
+	if !IsGitTownHook(string(content)) {
+		return false, nil
+	}
+	err = os.Remove(hookPath)
+	if err != nil {
+		return false, fmt.Errorf(messages.HooksCannotUninstall, hookPath, err)
+	}
+	chainedPath := hookPath + chainedSuffix
+	if _, statErr := os.Stat(chainedPath); statErr == nil {
+		err = os.Rename(chainedPath, hookPath)
+		if err != nil {
+			return true, fmt.Errorf(messages.HooksCannotUninstall, chainedPath, err)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,12 @@
+package slice
+
+import "fmt"
+
+// Strings provides the string representations of the given elements.
+func Strings[C fmt.Stringer](elements []C) []string {
+	result := make([]string, len(elements))
+	for e, element := range elements {
+		result[e] = element.String()
+	}
+	return result
+}
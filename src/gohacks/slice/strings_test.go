@@ -0,0 +1,17 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
+	"github.com/shoenig/test/must"
+)
+
+func TestStrings(t *testing.T) {
+	t.Parallel()
+	give := gitdomain.NewLocalBranchNames("main", "feature")
+	have := slice.Strings(give)
+	want := []string{"main", "feature"}
+	must.Eq(t, want, have)
+}
@@ -63,3 +63,8 @@ func (self *Connector) SquashMergeProposal(_ int, _ string) error {
 func (self *Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
 	return errors.New(messages.HostingBitBucketNotImplemented)
 }
+
+func (self *Connector) UpstreamRepo() *hostingdomain.Config {
+	// this connector finds and creates proposals only in the origin repository, also for forks
+	return nil
+}
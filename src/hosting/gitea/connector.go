@@ -20,6 +20,7 @@ import (
 type Connector struct {
 	hostingdomain.Config
 	APIToken configdomain.GiteaToken
+	Upstream *hostingdomain.Config // the repository that the current repository is a fork of, nil if it isn't a fork
 	client   *gitea.Client
 	log      print.Logger
 }
@@ -29,7 +30,8 @@ func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) s
 }
 
 func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
-	openPullRequests, _, err := self.client.ListRepoPullRequests(self.Organization, self.Repository, gitea.ListPullRequestsOptions{
+	proposalRepo := self.proposalRepo()
+	openPullRequests, _, err := self.client.ListRepoPullRequests(proposalRepo.Organization, proposalRepo.Repository, gitea.ListPullRequestsOptions{
 		ListOptions: gitea.ListOptions{
 			PageSize: 50,
 		},
@@ -47,6 +49,8 @@ func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*
 	}
 	pullRequest := pullRequests[0]
 	return &hostingdomain.Proposal{
+		Body:         pullRequest.Body,
+		Draft:        false,
 		MergeWithAPI: pullRequest.Mergeable,
 		Number:       int(pullRequest.Index),
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
@@ -55,12 +59,16 @@ func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*
 }
 
 func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
+	if self.Upstream != nil {
+		toCompare := parentBranch.String() + "..." + self.Organization + ":" + branch.String()
+		return fmt.Sprintf("%s/compare/%s", repositoryURL(*self.Upstream), url.PathEscape(toCompare)), nil
+	}
 	toCompare := parentBranch.String() + "..." + branch.String()
 	return fmt.Sprintf("%s/compare/%s", self.RepositoryURL(), url.PathEscape(toCompare)), nil
 }
 
 func (self *Connector) RepositoryURL() string {
-	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
+	return repositoryURL(self.Config)
 }
 
 func (self *Connector) SquashMergeProposal(number int, message string) error {
@@ -68,7 +76,8 @@ func (self *Connector) SquashMergeProposal(number int, message string) error {
 		return errors.New(messages.ProposalNoNumberGiven)
 	}
 	commitMessageParts := commitmessage.Split(message)
-	_, _, err := self.client.MergePullRequest(self.Organization, self.Repository, int64(number), gitea.MergePullRequestOption{
+	proposalRepo := self.proposalRepo()
+	_, _, err := self.client.MergePullRequest(proposalRepo.Organization, proposalRepo.Repository, int64(number), gitea.MergePullRequestOption{
 		Style:   gitea.MergeStyleSquash,
 		Title:   commitMessageParts.Title,
 		Message: commitMessageParts.Body,
@@ -76,7 +85,7 @@ func (self *Connector) SquashMergeProposal(number int, message string) error {
 	if err != nil {
 		return err
 	}
-	_, _, err = self.client.GetPullRequest(self.Organization, self.Repository, int64(number))
+	_, _, err = self.client.GetPullRequest(proposalRepo.Organization, proposalRepo.Repository, int64(number))
 	return err
 }
 
@@ -92,6 +101,19 @@ func (self *Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName)
 	return errors.New(messages.HostingGiteaNotImplemented)
 }
 
+func (self *Connector) UpstreamRepo() *hostingdomain.Config {
+	return self.Upstream
+}
+
+// proposalRepo provides the repository that contains the proposals for branches of the current repository.
+// This is the upstream repository for forks.
+func (self *Connector) proposalRepo() hostingdomain.Config {
+	if self.Upstream != nil {
+		return *self.Upstream
+	}
+	return self.Config
+}
+
 func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string, branch, target gitdomain.LocalBranchName) []*gitea.PullRequest {
 	result := []*gitea.PullRequest{}
 	headName := organization + "/" + branch.String()
@@ -109,7 +131,11 @@ func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string,
 func NewConnector(args NewConnectorArgs) (*Connector, error) {
 	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
 	httpClient := oauth2.NewClient(context.Background(), tokenSource)
-	giteaClient := gitea.NewClientWithHTTP("https://"+args.OriginURL.Host, httpClient)
+	apiURL := "https://" + args.OriginURL.Host
+	if args.APIURL != "" {
+		apiURL = args.APIURL.String()
+	}
+	giteaClient := gitea.NewClientWithHTTP(apiURL, httpClient)
 	return &Connector{
 		APIToken: args.APIToken,
 		Config: hostingdomain.Config{
@@ -117,14 +143,21 @@ func NewConnector(args NewConnectorArgs) (*Connector, error) {
 			Organization: args.OriginURL.Org,
 			Repository:   args.OriginURL.Repo,
 		},
-		client: giteaClient,
-		log:    args.Log,
+		Upstream: hostingdomain.NewUpstreamConfig(args.OriginURL, args.UpstreamURL),
+		client:   giteaClient,
+		log:      args.Log,
 	}, nil
 }
 
 type NewConnectorArgs struct {
 	APIToken        configdomain.GiteaToken
+	APIURL          configdomain.HostingAPIURL // base URL of the Gitea API, empty to use the hostname of the origin remote
 	HostingPlatform configdomain.HostingPlatform
 	Log             print.Logger
 	OriginURL       *giturl.Parts
+	UpstreamURL     *giturl.Parts
+}
+
+func repositoryURL(config hostingdomain.Config) string {
+	return fmt.Sprintf("https://%s/%s/%s", config.HostnameWithStandardPort(), config.Organization, config.Repository)
 }
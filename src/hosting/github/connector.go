@@ -24,6 +24,7 @@ type Connector struct {
 	hostingdomain.Config
 	APIToken   configdomain.GitHubToken
 	MainBranch gitdomain.LocalBranchName
+	Upstream   *hostingdomain.Config // the repository that the current repository is a fork of, nil if it isn't a fork
 	client     *github.Client
 	log        print.Logger
 }
@@ -33,7 +34,8 @@ func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) s
 }
 
 func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
-	pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
+	proposalRepo := self.proposalRepo()
+	pullRequests, _, err := self.client.PullRequests.List(context.Background(), proposalRepo.Organization, proposalRepo.Repository, &github.PullRequestListOptions{
 		Head:  self.Organization + ":" + branch.String(),
 		Base:  target.String(),
 		State: "open",
@@ -52,6 +54,10 @@ func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*
 }
 
 func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
+	if self.Upstream != nil {
+		toCompare := parentBranch.String() + "..." + self.Organization + ":" + branch.String()
+		return fmt.Sprintf("%s/compare/%s?expand=1", repositoryURL(*self.Upstream), url.PathEscape(toCompare)), nil
+	}
 	toCompare := branch.String()
 	if parentBranch != self.MainBranch {
 		toCompare = parentBranch.String() + "..." + branch.String()
@@ -60,7 +66,7 @@ func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranch
 }
 
 func (self *Connector) RepositoryURL() string {
-	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
+	return repositoryURL(self.Config)
 }
 
 func (self *Connector) SquashMergeProposal(number int, message string) (err error) {
@@ -69,7 +75,8 @@ func (self *Connector) SquashMergeProposal(number int, message string) (err erro
 	}
 	self.log.Start(messages.HostingGithubMergingViaAPI, number)
 	commitMessageParts := commitmessage.Split(message)
-	_, _, err = self.client.PullRequests.Merge(context.Background(), self.Organization, self.Repository, number, commitMessageParts.Body, &github.PullRequestOptions{
+	proposalRepo := self.proposalRepo()
+	_, _, err = self.client.PullRequests.Merge(context.Background(), proposalRepo.Organization, proposalRepo.Repository, number, commitMessageParts.Body, &github.PullRequestOptions{
 		MergeMethod: "squash",
 		CommitTitle: commitMessageParts.Title,
 	})
@@ -80,7 +87,8 @@ func (self *Connector) SquashMergeProposal(number int, message string) (err erro
 func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
 	self.log.Start(messages.HostingGithubUpdatePRViaAPI, number)
 	targetName := target.String()
-	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+	proposalRepo := self.proposalRepo()
+	_, _, err := self.client.PullRequests.Edit(context.Background(), proposalRepo.Organization, proposalRepo.Repository, number, &github.PullRequest{
 		Base: &github.PullRequestBranch{
 			Ref: &(targetName),
 		},
@@ -93,6 +101,19 @@ func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBr
 	return nil
 }
 
+func (self *Connector) UpstreamRepo() *hostingdomain.Config {
+	return self.Upstream
+}
+
+// proposalRepo provides the repository that contains the proposals for branches of the current repository.
+// This is the upstream repository for forks.
+func (self *Connector) proposalRepo() hostingdomain.Config {
+	if self.Upstream != nil {
+		return *self.Upstream
+	}
+	return self.Config
+}
+
 // getGitHubApiToken returns the GitHub API token to use.
 // It first checks the GITHUB_TOKEN environment variable.
 // If that is not set, it checks the GITHUB_AUTH_TOKEN environment variable.
@@ -114,6 +135,14 @@ func GetAPIToken(gitConfigToken configdomain.GitHubToken) configdomain.GitHubTok
 func NewConnector(args NewConnectorArgs) (*Connector, error) {
 	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
 	httpClient := oauth2.NewClient(context.Background(), tokenSource)
+	client := github.NewClient(httpClient)
+	if args.APIURL != "" {
+		var err error
+		client, err = client.WithEnterpriseURLs(args.APIURL.String(), args.APIURL.String())
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &Connector{
 		APIToken: args.APIToken,
 		Config: hostingdomain.Config{
@@ -122,22 +151,31 @@ func NewConnector(args NewConnectorArgs) (*Connector, error) {
 			Repository:   args.OriginURL.Repo,
 		},
 		MainBranch: args.MainBranch,
-		client:     github.NewClient(httpClient),
+		Upstream:   hostingdomain.NewUpstreamConfig(args.OriginURL, args.UpstreamURL),
+		client:     client,
 		log:        args.Log,
 	}, nil
 }
 
 type NewConnectorArgs struct {
 	APIToken        configdomain.GitHubToken
+	APIURL          configdomain.HostingAPIURL // base URL of the GitHub Enterprise API, empty to use the public GitHub API
 	HostingPlatform configdomain.HostingPlatform
 	Log             print.Logger
 	MainBranch      gitdomain.LocalBranchName
 	OriginURL       *giturl.Parts
+	UpstreamURL     *giturl.Parts
+}
+
+func repositoryURL(config hostingdomain.Config) string {
+	return fmt.Sprintf("https://%s/%s/%s", config.HostnameWithStandardPort(), config.Organization, config.Repository)
 }
 
 // parsePullRequest extracts standardized proposal data from the given GitHub pull-request.
 func parsePullRequest(pullRequest *github.PullRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         pullRequest.GetBody(),
+		Draft:        pullRequest.GetDraft(),
 		Number:       pullRequest.GetNumber(),
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.GetRef()),
 		Title:        pullRequest.GetTitle(),
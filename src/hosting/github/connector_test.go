@@ -30,24 +30,38 @@ func TestConnector(t *testing.T) {
 	t.Run("NewProposalURL", func(t *testing.T) {
 		t.Parallel()
 		tests := map[string]struct {
-			branch gitdomain.LocalBranchName
-			parent gitdomain.LocalBranchName
-			want   string
+			branch   gitdomain.LocalBranchName
+			parent   gitdomain.LocalBranchName
+			upstream *hostingdomain.Config
+			want     string
 		}{
 			"top-level branch": {
-				branch: gitdomain.NewLocalBranchName("feature"),
-				parent: gitdomain.NewLocalBranchName("main"),
-				want:   "https://github.com/organization/repo/compare/feature?expand=1",
+				branch:   gitdomain.NewLocalBranchName("feature"),
+				parent:   gitdomain.NewLocalBranchName("main"),
+				upstream: nil,
+				want:     "https://github.com/organization/repo/compare/feature?expand=1",
 			},
 			"stacked change": {
-				branch: gitdomain.NewLocalBranchName("feature-3"),
-				parent: gitdomain.NewLocalBranchName("feature-2"),
-				want:   "https://github.com/organization/repo/compare/feature-2...feature-3?expand=1",
+				branch:   gitdomain.NewLocalBranchName("feature-3"),
+				parent:   gitdomain.NewLocalBranchName("feature-2"),
+				upstream: nil,
+				want:     "https://github.com/organization/repo/compare/feature-2...feature-3?expand=1",
 			},
 			"special characters in branch name": {
-				branch: gitdomain.NewLocalBranchName("feature-#"),
+				branch:   gitdomain.NewLocalBranchName("feature-#"),
+				parent:   gitdomain.NewLocalBranchName("main"),
+				upstream: nil,
+				want:     "https://github.com/organization/repo/compare/feature-%23?expand=1",
+			},
+			"fork": {
+				branch: gitdomain.NewLocalBranchName("feature"),
 				parent: gitdomain.NewLocalBranchName("main"),
-				want:   "https://github.com/organization/repo/compare/feature-%23?expand=1",
+				upstream: &hostingdomain.Config{
+					Hostname:     "github.com",
+					Organization: "upstream-org",
+					Repository:   "upstream-repo",
+				},
+				want: "https://github.com/upstream-org/upstream-repo/compare/main...organization:feature?expand=1",
 			},
 		}
 		for name, tt := range tests {
@@ -60,6 +74,7 @@ func TestConnector(t *testing.T) {
 					},
 					APIToken:   "apiToken",
 					MainBranch: gitdomain.NewLocalBranchName("main"),
+					Upstream:   tt.upstream,
 				}
 				have, err := connector.NewProposalURL(tt.branch, tt.parent)
 				must.NoError(t, err)
@@ -90,10 +105,12 @@ func TestNewConnector(t *testing.T) {
 		t.Parallel()
 		have, err := github.NewConnector(github.NewConnectorArgs{
 			APIToken:        "apiToken",
+			APIURL:          "",
 			HostingPlatform: configdomain.HostingPlatformNone,
 			Log:             print.Logger{},
 			MainBranch:      gitdomain.NewLocalBranchName("mainBranch"),
 			OriginURL:       giturl.Parse("git@github.com:git-town/docs.git"),
+			UpstreamURL:     nil,
 		})
 		must.NoError(t, err)
 		wantConfig := hostingdomain.Config{
@@ -108,10 +125,12 @@ func TestNewConnector(t *testing.T) {
 		t.Parallel()
 		have, err := github.NewConnector(github.NewConnectorArgs{
 			APIToken:        "apiToken",
+			APIURL:          "",
 			HostingPlatform: configdomain.HostingPlatformGitHub,
 			Log:             print.Logger{},
 			MainBranch:      gitdomain.NewLocalBranchName("mainBranch"),
 			OriginURL:       giturl.Parse("git@custom-url.com:git-town/docs.git"),
+			UpstreamURL:     nil,
 		})
 		must.NoError(t, err)
 		wantConfig := hostingdomain.Config{
@@ -121,4 +140,25 @@ func TestNewConnector(t *testing.T) {
 		}
 		must.EqOp(t, wantConfig, have.Config)
 	})
+
+	t.Run("fork", func(t *testing.T) {
+		t.Parallel()
+		have, err := github.NewConnector(github.NewConnectorArgs{
+			APIToken:        "apiToken",
+			APIURL:          "",
+			HostingPlatform: configdomain.HostingPlatformNone,
+			Log:             print.Logger{},
+			MainBranch:      gitdomain.NewLocalBranchName("mainBranch"),
+			OriginURL:       giturl.Parse("git@github.com:alice/docs.git"),
+			UpstreamURL:     giturl.Parse("git@github.com:git-town/docs.git"),
+		})
+		must.NoError(t, err)
+		wantUpstream := hostingdomain.Config{
+			Hostname:     "github.com",
+			Organization: "git-town",
+			Repository:   "docs",
+		}
+		must.NotNil(t, have.Upstream)
+		must.EqOp(t, wantUpstream, *have.Upstream)
+	})
 }
@@ -75,6 +75,11 @@ func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBr
 	return nil
 }
 
+func (self *Connector) UpstreamRepo() *hostingdomain.Config {
+	// this connector finds and creates proposals only in the origin repository, also for forks
+	return nil
+}
+
 // NewGitlabConfig provides GitLab configuration data if the current repo is hosted on GitLab,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) (*Connector, error) {
@@ -86,7 +91,11 @@ func NewConnector(args NewConnectorArgs) (*Connector, error) {
 			Repository:   args.OriginURL.Repo,
 		},
 	}
-	clientOptFunc := gitlab.WithBaseURL(gitlabConfig.baseURL())
+	apiURL := gitlabConfig.baseURL()
+	if args.APIURL != "" {
+		apiURL = args.APIURL.String()
+	}
+	clientOptFunc := gitlab.WithBaseURL(apiURL)
 	httpClient := gitlab.WithHTTPClient(&http.Client{})
 	client, err := gitlab.NewOAuthClient(gitlabConfig.APIToken.String(), httpClient, clientOptFunc)
 	if err != nil {
@@ -102,6 +111,7 @@ func NewConnector(args NewConnectorArgs) (*Connector, error) {
 
 type NewConnectorArgs struct {
 	APIToken        configdomain.GitLabToken
+	APIURL          configdomain.HostingAPIURL // base URL of the GitLab API, empty to use the hostname of the origin remote
 	HostingPlatform configdomain.HostingPlatform
 	Log             print.Logger
 	OriginURL       *giturl.Parts
@@ -109,6 +119,8 @@ type NewConnectorArgs struct {
 
 func parseMergeRequest(mergeRequest *gitlab.MergeRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         mergeRequest.Description,
+		Draft:        mergeRequest.Draft,
 		Number:       mergeRequest.IID,
 		Target:       gitdomain.NewLocalBranchName(mergeRequest.TargetBranch),
 		Title:        mergeRequest.Title,
@@ -26,6 +26,8 @@ func TestGitlabConnector(t *testing.T) {
 			APIToken: "",
 		}
 		give := hostingdomain.Proposal{
+			Body:         "",
+			Draft:        false,
 			Number:       1,
 			MergeWithAPI: true,
 			Target:       gitdomain.EmptyLocalBranchName(),
@@ -86,6 +88,7 @@ func TestNewGitlabConnector(t *testing.T) {
 		t.Parallel()
 		have, err := gitlab.NewConnector(gitlab.NewConnectorArgs{
 			APIToken:        "apiToken",
+			APIURL:          "",
 			HostingPlatform: configdomain.HostingPlatformNone,
 			Log:             print.Logger{},
 			OriginURL:       giturl.Parse("git@gitlab.com:git-town/docs.git"),
@@ -106,6 +109,7 @@ func TestNewGitlabConnector(t *testing.T) {
 		t.Parallel()
 		have, err := gitlab.NewConnector(gitlab.NewConnectorArgs{
 			APIToken:        "apiToken",
+			APIURL:          "",
 			HostingPlatform: configdomain.HostingPlatformGitLab,
 			Log:             print.Logger{},
 			OriginURL:       giturl.Parse("git@custom-url.com:git-town/docs.git"),
@@ -1,6 +1,10 @@
 package hostingdomain
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/git/giturl"
+)
 
 // Config contains data needed by all platform connectors.
 type Config struct {
@@ -21,3 +25,20 @@ func (self Config) HostnameWithStandardPort() string {
 	}
 	return self.Hostname[:index]
 }
+
+// NewUpstreamConfig provides the configuration of the upstream repository
+// if the origin repository is a fork of it on the same hosting platform, otherwise nil.
+// Proposals for branches in forks get created on the upstream repository.
+func NewUpstreamConfig(originURL, upstreamURL *giturl.Parts) *Config {
+	if originURL == nil || upstreamURL == nil || originURL.Host != upstreamURL.Host {
+		return nil
+	}
+	if originURL.Org == upstreamURL.Org && originURL.Repo == upstreamURL.Repo {
+		return nil
+	}
+	return &Config{
+		Hostname:     upstreamURL.Host,
+		Organization: upstreamURL.Org,
+		Repository:   upstreamURL.Repo,
+	}
+}
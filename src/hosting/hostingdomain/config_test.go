@@ -3,6 +3,7 @@ package hostingdomain_test
 import (
 	"testing"
 
+	"github.com/git-town/git-town/v12/src/git/giturl"
 	"github.com/git-town/git-town/v12/src/hosting/hostingdomain"
 	"github.com/shoenig/test/must"
 )
@@ -34,3 +35,44 @@ func TestHostnameWithStandardPort(t *testing.T) {
 		must.EqOp(t, want, have)
 	})
 }
+
+func TestNewUpstreamConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("origin is a fork of upstream", func(t *testing.T) {
+		t.Parallel()
+		origin := giturl.Parse("git@github.com:alice/git-town.git")
+		upstream := giturl.Parse("https://github.com/git-town/git-town.git")
+		have := hostingdomain.NewUpstreamConfig(origin, upstream)
+		want := hostingdomain.Config{
+			Hostname:     "github.com",
+			Organization: "git-town",
+			Repository:   "git-town",
+		}
+		must.NotNil(t, have)
+		must.EqOp(t, want, *have)
+	})
+
+	t.Run("upstream is the same repository as origin", func(t *testing.T) {
+		t.Parallel()
+		origin := giturl.Parse("git@github.com:git-town/git-town.git")
+		upstream := giturl.Parse("https://github.com/git-town/git-town.git")
+		have := hostingdomain.NewUpstreamConfig(origin, upstream)
+		must.Nil(t, have)
+	})
+
+	t.Run("upstream on another hosting platform", func(t *testing.T) {
+		t.Parallel()
+		origin := giturl.Parse("git@github.com:alice/git-town.git")
+		upstream := giturl.Parse("https://gitlab.com/git-town/git-town.git")
+		have := hostingdomain.NewUpstreamConfig(origin, upstream)
+		must.Nil(t, have)
+	})
+
+	t.Run("no upstream", func(t *testing.T) {
+		t.Parallel()
+		origin := giturl.Parse("git@github.com:alice/git-town.git")
+		have := hostingdomain.NewUpstreamConfig(origin, nil)
+		must.Nil(t, have)
+	})
+}
@@ -26,4 +26,9 @@ type Connector interface {
 
 	// UpdateProposalTarget updates the target branch of the given proposal.
 	UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error
+
+	// UpstreamRepo provides the repository that the current repository is a fork of
+	// if this connector finds and merges proposals there.
+	// Returns nil if the current repository isn't a fork or this connector doesn't support proposals on the upstream repository.
+	UpstreamRepo() *Config
 }
@@ -5,6 +5,12 @@ import "github.com/git-town/git-town/v12/src/git/gitdomain"
 // Proposal contains information about a change request on a code hosting platform.
 // Alternative names are "pull request" or "merge request".
 type Proposal struct {
+	// textual description of the proposal
+	Body string
+
+	// whether this proposal is a draft that isn't ready for review yet
+	Draft bool
+
 	// whether this proposal can be merged via the API
 	MergeWithAPI bool
 
@@ -22,21 +22,26 @@ func NewConnector(args NewConnectorArgs) (hostingdomain.Connector, error) {
 	case configdomain.HostingPlatformGitea:
 		return gitea.NewConnector(gitea.NewConnectorArgs{
 			APIToken:        args.GiteaToken,
+			APIURL:          args.HostingAPIURL,
 			HostingPlatform: args.HostingPlatform,
 			Log:             args.Log,
 			OriginURL:       args.OriginURL,
+			UpstreamURL:     args.UpstreamURL,
 		})
 	case configdomain.HostingPlatformGitHub:
 		return github.NewConnector(github.NewConnectorArgs{
 			APIToken:        github.GetAPIToken(args.GitHubToken),
+			APIURL:          args.HostingAPIURL,
 			HostingPlatform: args.HostingPlatform,
 			Log:             args.Log,
 			MainBranch:      args.MainBranch,
 			OriginURL:       args.OriginURL,
+			UpstreamURL:     args.UpstreamURL,
 		})
 	case configdomain.HostingPlatformGitLab:
 		return gitlab.NewConnector(gitlab.NewConnectorArgs{
 			APIToken:        args.GitLabToken,
+			APIURL:          args.HostingAPIURL,
 			HostingPlatform: args.HostingPlatform,
 			Log:             args.Log,
 			OriginURL:       args.OriginURL,
@@ -52,4 +57,5 @@ type NewConnectorArgs struct {
 	HostingPlatform configdomain.HostingPlatform
 	Log             print.Logger
 	OriginURL       *giturl.Parts
+	UpstreamURL     *giturl.Parts // URL of the upstream remote, nil if there is none
 }
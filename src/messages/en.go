@@ -14,10 +14,13 @@ const (
 	BranchDiffProblem                  = "cannot determine if branch %q has unmerged commits: %w"
 	BranchDoesntContainCommit          = "branch %q does not contain commit %q. Found commits %s"
 	BranchDoesntExist                  = "there is no branch %q"
+	BranchSquashMergedDeleted          = "deleted branch %q because it was squash-merged into %q"
+	BranchSquashMergedProblem          = "cannot determine whether branch %q was squash-merged: %w"
 	BranchHasWrongSHA                  = "cannot reset branch %q to %q because it received additional commits in the meantime. It should have SHA %q but has %q"
 	BranchIsAlreadyContribution        = "branch %q is already a contribution branch"
 	BranchIsAlreadyObserved            = "branch %q is already observed"
 	BranchIsAlreadyParked              = "branch %q is already parked"
+	BranchIsAlreadyPrototype           = "branch %q is already a prototype branch"
 	BranchLocalSHAProblem              = "cannot determine SHA of local branch %q: %w"
 	BranchLocalProblem                 = "cannot determine whether the local branch %q exists: %w"
 	BranchParentChanged                = "branch %q is now a child of %q"
@@ -27,8 +30,22 @@ const (
 	CommandsRun                        = "Ran %d shell commands."
 	CommitMessageProblem               = "cannot determine last commit message: %w"
 	CompletionTypeUnknown              = "unknown completion type: %q"
+	ConfigBranchTypeUnknown            = "unknown branch type: %q, please use \"feature\", \"contribution\", \"observed\", \"parked\", or \"prototype\""
+	ConfigDeprecatedSetting            = "in %s, please rename it to %q"
+	ConfigFileAliasUnknown             = "the configuration file contains an alias for unknown command %q, please use one of %v"
 	ConfigFileCannotRead               = "cannot read the configuration file %q: %w"
+	ConfigFileInvalid                  = "the configuration file %s contains %d problem(s):\n%s"
 	ConfigFileInvalidData              = "the configuration file %q does not contain TOML-formatted content: %w"
+	ConfigFileKeyUnknown               = "unknown key %q"
+	ConfigFileKeysUnknown              = "the configuration file %s contains %d unknown key(s), Git Town ignores them:\n%s\n\n"
+	ConfigFileNotFound                 = "there is no configuration file %s"
+	ConfigFileSettingRemoved           = "removed setting %q from %s\n"
+	ConfigFileSettingSet               = "setting %q is now %q in %s\n"
+	ConfigFileSettingUnsupported       = "the configuration file does not support setting %q"
+	ConfigFileSettingsMigrated         = "migrated %d settings into %s\n"
+	ConfigFileTypeMismatch             = "%q must be of type %s, not %s"
+	ConfigFileValid                    = "The configuration file %s is valid.\n"
+	ConfigFileValueInvalid             = "invalid value for %q: %v"
 	ConfigMainbranchInConfigFile       = "please configure the main branch in the config file"
 	ConfigNeeded                       = "Git Town needs to be configured\n\n"
 	ConfigStorage                      = "Config storage: %s\n"
@@ -41,6 +58,7 @@ const (
 	ContributionBranchCannotPark       = "cannot park contribution branches"
 	ContributionBranchCannotPropose    = "cannot propose contribution branches"
 	ContributionBranchCannotShip       = "cannot ship contribution branches"
+	ContributionRegex                  = "Contribution regex: %s\n"
 	DiffConflictWithMain               = "conflicts between your uncommmitted changes and the main branch"
 	DryRun                             = "In dry run mode. No commands will be run. When run in normal mode, the command output will appear beneath the command. Some commands will only be run if necessary. For example: 'git push' will run if and only if there are local commits not on origin."
 	ValueInvalid                       = "invalid value for %s: %q. Please provide either \"yes\" or \"no\""
@@ -49,10 +67,29 @@ const (
 	ContinueNothingToDo                = "nothing to continue"
 	ContinueUnresolvedConflicts        = "you must resolve the conflicts before continuing"
 	ContinueUntrackedChanges           = "please stage or commit the untracked changes first"
+	DialogEntryNumberInvalid           = "Please enter a number between 0 and %d.\n"
+	DialogEntryNumbersInvalid          = "Please enter numbers between 0 and %d, separated by spaces or commas, \"all\", or \"none\".\n"
 	DialogUnexpectedResponse           = "unexpected response: %s"
 	DiffParentNoFeatureBranch          = "you can only diff-parent feature branches"
 	DiffProblem                        = "cannot list diff of %q and %q: %w"
 	DirCurrentProblem                  = "cannot determine the current directory"
+	DoctorBranchTypesConflicting       = "branch %q is configured as %s, Git Town treats it as %s"
+	DoctorDeprecatedSetting            = "deprecated setting %q in %s, please rename it to %q"
+	DoctorFixUnfinishedCommand         = "cannot fix problems while the %q command is unfinished, please run \"git town continue\", \"git town skip\", or \"git town undo\" first"
+	DoctorFixableProblems              = "Run \"git town doctor --fix\" to repair %d of them.\n"
+	DoctorFixingProblems               = "Repairing %d of them.\n"
+	DoctorLineageCycle                 = "the lineage contains a cycle: %s"
+	DoctorLineageMissingBranch         = "the lineage contains branch %q which doesn't exist"
+	DoctorLineageMissingParent         = "branch %q doesn't exist but is the parent of %s"
+	DoctorMainBranchHasParent          = "the main branch %q has parent %q"
+	DoctorNoProblems                   = "No problems found."
+	DoctorNothingToFix                 = "There are no problems that Git Town can fix automatically."
+	DoctorPerennialBranchHasParent     = "perennial branch %q has parent %q"
+	DoctorProblem                      = "%s: %s\n"
+	DoctorProblemsFound                = "\nFound %d problem(s).\n"
+	DoctorRunStateStale                = "the unfinished %q command ended on branch %q which doesn't exist anymore"
+	DoctorRunStateUnfinished           = "there is an unfinished %q command, please run \"git town continue\", \"git town skip\", or \"git town undo\""
+	EnvVarInvalid                      = "environment variable %s contains an invalid value: %w"
 	FileContentInvalidJSON             = "cannot parse JSON content of file %q: %w"
 	FileDeleteProblem                  = "cannot delete file %q: %w"
 	FileReadProblem                    = "cannot read file %q: %w"
@@ -82,8 +119,18 @@ END OUTPUT FROM 'git branch -vva'
 	HackTooManyArguments                  = "please provide only one branch to create"
 	HackBranchIsAlreadyFeature            = "branch %q is already a feature branch"
 	HackBranchIsNowFeature                = "branch %q is now a feature branch\n"
+	HackBranchMatchesRegex                = "branch %q matches the contribution-regex, observed-regex, or parked-regex setting and therefore cannot be a feature branch"
+	HackPrototypeExistingBranch           = "branch %q already exists, run \"git town prototype %s\" to make it a prototype branch"
 	HackCannotFeatureMainBranch           = "cannot make the main branch a feature branch"
 	HackCannotFeaturePerennialBranch      = "branch %q is a perennial branch and therefore be a feature branch"
+	HooksCannotInstall                    = "cannot install the Git hook %q: %w"
+	HooksCannotUninstall                  = "cannot uninstall the Git hook %q: %w"
+	HooksChained                          = "The existing post-checkout hook now runs before the Git Town hook.\n"
+	HooksChainedExists                    = "cannot chain the existing post-checkout hook because %q already exists"
+	HooksInstalled                        = "Installed the Git Town post-checkout hook in %s\n"
+	HooksNotInstalled                     = "The Git Town post-checkout hook is not installed in %s\n"
+	HooksParentRecorded                   = "Git Town: branch %q has parent %q\n"
+	HooksUninstalled                      = "Removed the Git Town post-checkout hook from %s\n"
 	HostingBitBucketNotImplemented        = "shipping pull requests via the Bitbucket API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
 	HostingGitlabMergingViaAPI            = "GitLab API: Merging MR !%d ... "
 	HostingGitlabUpdateMRViaAPI           = "GitLab API: Updating target branch for MR !%d to %q ... "
@@ -101,23 +148,30 @@ END OUTPUT FROM 'git branch -vva'
 	MainBranchCannotMakeContribution      = "cannot make the main branch a contribution branch"
 	MainBranchCannotObserve               = "cannot observe the main branch"
 	MainBranchCannotPark                  = "cannot park the main branch"
+	MainBranchCannotPrototype             = "cannot make the main branch a prototype branch"
 	MainBranchCannotPropose               = "cannot propose the main branch"
 	MainBranchCannotShip                  = "cannot ship the main branch"
+	MainBranchCannotSyncStrategy          = "the main branch syncs using the sync-perennial-strategy"
 	ObservedBranchCannotPark              = "cannot park observed branches"
 	ObservedBranchCannotPropose           = "cannot propose observed branches"
 	ObservedBranchCannotShip              = "cannot ship observed branches"
 	ObservedBranchIsNowObserved           = "branch %q is now an observed branch\n"
+	ObservedRegex                         = "Observed regex: %s\n"
 	OfflineNotAllowed                     = "this command requires an active internet connection"
 	OpcodeUnknown                         = "unknown opcode: %q, run \"git town status reset\" to reset it"
 	OpenChangesProblem                    = "cannot determine open changes: %w"
 	OriginHostname                        = "Origin hostname: %s\n"
 	ParentDialogSelected                  = "Selected parent branch for %q: %s\n"
+	ParentInferred                        = "branch %q: inferred parent branch %q\n"
 	ParkedBranchIsNowParked               = "branch %q is now parked\n"
+	ParkedRegex                           = "Parked regex: %s\n"
 	PerennialBranchCannotMakeContribution = "cannot make perennial branches contribution branches"
 	PerennialBranchCannotObserve          = "cannot observe perennial branches"
 	PerennialBranchCannotPark             = "cannot park perennial branches"
+	PerennialBranchCannotPrototype        = "cannot make perennial branches prototype branches"
 	PerennialBranchCannotPropose          = "cannot propose perennial branches"
 	PerennialBranchCannotShip             = "cannot ship perennial branches"
+	PerennialBranchCannotSyncStrategy     = "perennial branches sync using the sync-perennial-strategy"
 	PerennialBranches                     = "Perennial branches: %s\n"
 	PerennialRegex                        = "Perennial regex: %s\n"
 	PreviousCommandFinished               = "The previous Git Town command (%s) finished successfully.\n"
@@ -127,6 +181,8 @@ END OUTPUT FROM 'git branch -vva'
 	ProposalNotFoundForBranch             = "cannot determine proposal for branch %q: %w"
 	ProposalTargetBranchUpdateProblem     = "cannot update the target branch of proposal %d via the API"
 	ProposalURLProblem                    = "cannot determine proposal URL from %q to %q: %w"
+	PrototypeBranchCannotPropose          = "cannot propose prototype branches, run \"git town hack %s\" to make it a feature branch first"
+	PrototypeBranchIsNowPrototype         = "branch %q is now a prototype branch\n"
 	PullRequestDeprecation                = `DEPRECATION NOTICE
 
 This command has been renamed to "git town propose"
@@ -134,6 +190,7 @@ nd will be removed in future versions of Git Town.`
 	PushHook                       = "Push hook: %s\n"
 	PushNewBranches                = "Push new branches: %s\n"
 	RebaseProblem                  = "cannot determine rebase in progress: %w"
+	RemoteBranchesProblem          = "cannot determine the branches at remote %q: %w"
 	RemoteExistsProblem            = "cannot determine if remote %q exists: %w"
 	RemotesProblem                 = "cannot determine remotes: %w"
 	RenameBranchNotInSync          = "%q is not in sync with its tracking branch, please sync the branches before renaming"
@@ -149,6 +206,8 @@ nd will be removed in future versions of Git Town.`
 	RunstateSerializeProblem       = "cannot encode run-state: %w"
 	RunstatePathProblem            = "cannot determine the runstate file path: %w"
 	RunstateSaveProblem            = "cannot save run state: %w"
+	SelectBranchesNone             = "No branches selected, nothing to do."
+	SelectedBranches               = "Selected branches: %s\n"
 	SetParentNoFeatureBranch       = "the branch %q is not a feature branch. Only feature branches can have parent branches"
 	SettingDeprecatedGlobalMessage = `
 I found the deprecated global setting %q.
@@ -161,36 +220,55 @@ I am upgrading this setting to the new format %q.
 I found the deprecated local setting %q.
 I am upgrading this setting to the new format %q.
 `
-	SettingLocalCannotRemove    = "ERROR: cannot remove local Git setting %q: %v"
-	SettingLocalCannotWrite     = "ERROR: cannot write local Git setting %q: %v"
-	ShipAbortedMergeError       = "aborted because commit exited with error"
-	ShipBranchOtherWorktree     = "branch %q is active in another worktree"
-	ShipBranchNothingToDo       = "the branch %q has no shippable changes"
-	ShipChildBranch             = "shipping this branch would ship %s as well,\nplease ship %q first"
-	ShipDeletesTrackingBranches = "Ship deletes tracking branches: %s\n"
-	ShipOpenChanges             = "you have uncommitted changes. Did you mean to commit them before shipping?"
-	ShippableChangesProblem     = "cannot determine whether branch %q has shippable changes: %w"
-	SkipBranchHasConflicts      = "cannot skip branch that resulted in conflicts"
-	SkipMessage                 = `You can run "git town skip" to skip the currently failing operation.`
-	SkipNothingToDo             = "nothing to skip"
-	SquashCannotReadFile        = "cannot read squash message file %q: %w"
-	SquashCommitAuthorQuery     = "Please choose an author for the squash commit:"
-	SquashCommitAuthorProblem   = "error getting squash commit author: %w"
-	SquashCommitAuthorSelection = "Selected squash commit author: %s\n"
-	SquashMessageProblem        = "cannot comment out the squash commit message: %w"
-	StatusFileNotFound          = "No status file found for this repository."
-	SyncBeforeShip              = "Sync before ship: %s\n"
-	SyncFeatureBranches         = "Sync feature branches: %s\n"
-	SyncPerennialBranches       = "Sync perennial branches: %s\n"
-	SyncStatusNotRecognized     = "cannot determine the sync status for Git remote %q and branch name %q"
-	SyncWithUpstream            = "Sync with upstream: %s\n"
-	UndoCreateOpcodeProblem     = "cannot create undo operations for %q: %w"
-	UndoMessage                 = `You can run "git town undo" to go back to where you started.`
-	UndoNothingToDo             = "nothing to undo"
-	UnfinishedCommandHandle     = "Handle unfinished command: %s\n"
-	UnfinishedRunStateContinue  = "Continue the \"%s\" command after having resolved conflicts"
-	UnfinishedRunStateDiscard   = "Discard the unfinished state and run the new command"
-	UnfinishedRunStateQuit      = "Quit without running anything"
-	UnfinishedRunStateSkip      = "Skip the current branch and continue the \"%s\" command on the next branch"
-	UnfinishedRunStateUndo      = "Undo the previous \"%s\" command"
+	SettingLocalCannotRemove         = "ERROR: cannot remove local Git setting %q: %v"
+	SettingLocalCannotWrite          = "ERROR: cannot write local Git setting %q: %v"
+	SettingNotSet                    = "setting %q is not set in the %s configuration"
+	SettingRegexInvalid              = "invalid regular expression for setting %q: %q: %w"
+	SettingSyncStrategyIgnoreInvalid = "Notice: ignoring invalid value %q for setting %q"
+	SettingUnknown                   = "unknown setting %q, known settings are: %s"
+	SetupAliasUnknown                = "cannot alias unknown command %q, please use one of %s"
+	SetupAnswerInvalid               = "invalid answer %q, please use the format \"<setting>=<value>\""
+	SetupAnswerUnsupported           = "the setup assistant doesn't configure setting %q, please use \"git town config set\" instead"
+	SetupAnswerValueInvalid          = "unsupported value for answer %q: %v"
+	SetupAnswersCannotParse          = "cannot parse the answers file %q: %w"
+	SetupConfigStorageUnknown        = "unknown configuration storage %q, please use \"file\" or \"git\""
+	SetupMainBranchMissing           = "cannot determine the main branch, please provide it as answer \"main-branch\""
+	SetupMainBranchNotFound          = "the main branch %q doesn't exist"
+	SharedLineageInvalid             = "ignoring the shared lineage in %s because it is invalid: %v\n"
+	SharedLineageParentAdded         = "branch %q now has parent %q from the shared lineage\n"
+	ShipAbortedMergeError            = "aborted because commit exited with error"
+	ShipBranchOtherWorktree          = "branch %q is active in another worktree"
+	ShipBranchNothingToDo            = "the branch %q has no shippable changes"
+	ShipChildBranch                  = "shipping this branch would ship %s as well,\nplease ship %q first"
+	ShipDeletesTrackingBranches      = "Ship deletes tracking branches: %s\n"
+	ShipForkWithoutProposal          = "branch %q lives in a fork of %s/%s and must be shipped via a proposal on that repository, but no proposal was found"
+	ShipOpenChanges                  = "you have uncommitted changes. Did you mean to commit them before shipping?"
+	ShippableChangesProblem          = "cannot determine whether branch %q has shippable changes: %w"
+	SkipBranchHasConflicts           = "cannot skip branch that resulted in conflicts"
+	SkipMessage                      = `You can run "git town skip" to skip the currently failing operation.`
+	SkipNothingToDo                  = "nothing to skip"
+	SquashCannotReadFile             = "cannot read squash message file %q: %w"
+	SquashCommitAuthorQuery          = "Please choose an author for the squash commit:"
+	SquashCommitAuthorProblem        = "error getting squash commit author: %w"
+	SquashCommitAuthorSelection      = "Selected squash commit author: %s\n"
+	SquashMessageProblem             = "cannot comment out the squash commit message: %w"
+	StatusFileNotFound               = "No status file found for this repository."
+	SwitchBranchInOtherWorktree      = "branch %q is checked out in another worktree at:\n%s\n"
+	SyncAllWithBranch                = "cannot sync a given branch together with --all"
+	SyncBeforeShip                   = "Sync before ship: %s\n"
+	SyncFeatureBranches              = "Sync feature branches: %s\n"
+	SyncPerennialBranches            = "Sync perennial branches: %s\n"
+	SyncStatusNotRecognized          = "cannot determine the sync status for Git remote %q and branch name %q"
+	SyncStrategyIsNow                = "branch %q now syncs using the %q strategy\n"
+	SyncStrategyIsNowDefault         = "branch %q now syncs using the sync-feature-strategy\n"
+	SyncWithUpstream                 = "Sync with upstream: %s\n"
+	UndoCreateOpcodeProblem          = "cannot create undo operations for %q: %w"
+	UndoMessage                      = `You can run "git town undo" to go back to where you started.`
+	UndoNothingToDo                  = "nothing to undo"
+	UnfinishedCommandHandle          = "Handle unfinished command: %s\n"
+	UnfinishedRunStateContinue       = "Continue the \"%s\" command after having resolved conflicts"
+	UnfinishedRunStateDiscard        = "Discard the unfinished state and run the new command"
+	UnfinishedRunStateQuit           = "Quit without running anything"
+	UnfinishedRunStateSkip           = "Skip the current branch and continue the \"%s\" command on the next branch"
+	UnfinishedRunStateUndo           = "Undo the previous \"%s\" command"
 )
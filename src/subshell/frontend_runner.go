@@ -9,6 +9,7 @@ import (
 
 	"github.com/git-town/git-town/v12/src/cli/print"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/githooks"
 	"github.com/git-town/git-town/v12/src/gohacks"
 	"github.com/git-town/git-town/v12/src/messages"
 )
@@ -69,6 +70,7 @@ func (self *FrontendRunner) Run(cmd string, args ...string) (err error) {
 		cmd = "cmd"
 	}
 	subProcess := exec.Command(cmd, args...) // #nosec
+	subProcess.Env = append(subProcess.Environ(), githooks.SkipEnvVar+"=1")
 	subProcess.Stderr = os.Stderr
 	subProcess.Stdin = os.Stdin
 	subProcess.Stdout = os.Stdout
@@ -3,6 +3,7 @@ package sync
 import (
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
 	"github.com/git-town/git-town/v12/src/vm/opcodes"
 	"github.com/git-town/git-town/v12/src/vm/program"
 )
@@ -12,6 +13,8 @@ func BranchProgram(branch gitdomain.BranchInfo, args BranchProgramArgs) {
 	parentBranchInfo := args.BranchInfos.FindByLocalName(args.Config.Lineage.Parent(branch.LocalName))
 	parentOtherWorktree := parentBranchInfo != nil && parentBranchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree
 	switch {
+	case slice.Contains(args.SquashMergedBranches, branch.LocalName):
+		syncSquashMergedBranchProgram(args.Program, branch, args)
 	case branch.SyncStatus == gitdomain.SyncStatusDeletedAtRemote:
 		syncDeletedBranchProgram(args.Program, branch, parentOtherWorktree, args)
 	case branch.SyncStatus == gitdomain.SyncStatusOtherWorktree:
@@ -23,12 +26,13 @@ func BranchProgram(branch gitdomain.BranchInfo, args BranchProgramArgs) {
 }
 
 type BranchProgramArgs struct {
-	BranchInfos   gitdomain.BranchInfos
-	Config        *configdomain.FullConfig
-	InitialBranch gitdomain.LocalBranchName
-	Program       *program.Program
-	PushBranch    bool
-	Remotes       gitdomain.Remotes
+	BranchInfos          gitdomain.BranchInfos
+	Config               *configdomain.FullConfig
+	InitialBranch        gitdomain.LocalBranchName
+	Program              *program.Program
+	PushBranch           bool
+	Remotes              gitdomain.Remotes
+	SquashMergedBranches gitdomain.LocalBranchNames // branches whose changes were squash-merged into their parent branch
 }
 
 // ExistingBranchProgram provides the opcode to sync a particular branch.
@@ -40,26 +44,36 @@ func ExistingBranchProgram(list *program.Program, branch gitdomain.BranchInfo, p
 	}
 	list.Add(&opcodes.Checkout{Branch: branch.LocalName})
 	branchType := args.Config.BranchType(branch.LocalName)
-	switch branchType {
-	case configdomain.BranchTypeFeatureBranch:
+	parentSquashMerged := slice.Contains(args.SquashMergedBranches, args.Config.Lineage.Parent(branch.LocalName))
+	switch {
+	case branchType == configdomain.BranchTypeFeatureBranch && parentSquashMerged:
+		syncChildOfSquashMergedBranchProgram(list, branch, args)
+	case branchType == configdomain.BranchTypeFeatureBranch:
 		FeatureBranchProgram(featureBranchArgs{
 			branch:              branch,
 			parentOtherWorktree: parentOtherWorktree,
 			program:             list,
-			syncStrategy:        args.Config.SyncFeatureStrategy,
+			syncStrategy:        args.Config.SyncFeatureStrategyFor(branch.LocalName),
 		})
-	case configdomain.BranchTypePerennialBranch, configdomain.BranchTypeMainBranch:
+	case branchType == configdomain.BranchTypePerennialBranch, branchType == configdomain.BranchTypeMainBranch:
 		PerennialBranchProgram(branch, args)
-	case configdomain.BranchTypeParkedBranch:
+	case branchType == configdomain.BranchTypeParkedBranch:
 		ParkedBranchProgram(args.InitialBranch, featureBranchArgs{
 			branch:              branch,
 			parentOtherWorktree: parentOtherWorktree,
 			program:             list,
-			syncStrategy:        args.Config.SyncFeatureStrategy,
+			syncStrategy:        args.Config.SyncFeatureStrategyFor(branch.LocalName),
+		})
+	case branchType == configdomain.BranchTypePrototypeBranch:
+		PrototypeBranchProgram(featureBranchArgs{
+			branch:              branch,
+			parentOtherWorktree: parentOtherWorktree,
+			program:             list,
+			syncStrategy:        args.Config.SyncFeatureStrategyFor(branch.LocalName),
 		})
-	case configdomain.BranchTypeContributionBranch:
+	case branchType == configdomain.BranchTypeContributionBranch:
 		ContributionBranchProgram(args.Program, branch)
-	case configdomain.BranchTypeObservedBranch:
+	case branchType == configdomain.BranchTypeObservedBranch:
 		ObservedBranchProgram(branch, args.Program)
 	}
 	if args.PushBranch && args.Remotes.HasOrigin() && args.Config.IsOnline() && branchType.ShouldPush(branch.LocalName, args.InitialBranch) {
@@ -68,8 +82,11 @@ func ExistingBranchProgram(list *program.Program, branch gitdomain.BranchInfo, p
 			list.Add(&opcodes.CreateTrackingBranch{Branch: branch.LocalName})
 		case isMainOrPerennialBranch:
 			list.Add(&opcodes.PushCurrentBranch{CurrentBranch: branch.LocalName})
+		case parentSquashMerged:
+			// the branch no longer contains the commits of its squash-merged parent
+			list.Add(&opcodes.ForcePushCurrentBranch{})
 		default:
-			pushFeatureBranchProgram(list, branch.LocalName, args.Config.SyncFeatureStrategy)
+			pushFeatureBranchProgram(list, branch.LocalName, args.Config.SyncFeatureStrategyFor(branch.LocalName))
 		}
 	}
 }
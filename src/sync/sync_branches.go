@@ -3,18 +3,28 @@ package sync
 import (
 	"github.com/git-town/git-town/v12/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
 	"github.com/git-town/git-town/v12/src/vm/opcodes"
 )
 
 // BranchesProgram syncs all given branches.
 func BranchesProgram(args BranchesProgramArgs) {
 	for _, branch := range args.BranchesToSync {
-		BranchProgram(branch, args.BranchProgramArgs)
+		switch {
+		case !slice.Contains(args.Stack, branch.LocalName):
+			BranchProgram(branch, args.BranchProgramArgs)
+		case branch.LocalName == args.Stack[len(args.Stack)-1]:
+			// the stack gets synced in one go once all its ancestors are synced
+			syncStackProgram(args.Stack, args.BranchProgramArgs)
+		}
 	}
 	args.Program.Add(&opcodes.CheckoutIfExists{Branch: args.InitialBranch})
 	if args.Remotes.HasOrigin() && args.ShouldPushTags && args.Config.IsOnline() {
 		args.Program.Add(&opcodes.PushTags{})
 	}
+	if args.Remotes.HasOrigin() && args.Config.ShareLineage.Bool() && args.Config.IsOnline() {
+		args.Program.Add(&opcodes.PushSharedLineage{})
+	}
 	cmdhelpers.Wrap(args.Program, cmdhelpers.WrapOptions{
 		DryRun:                   args.DryRun,
 		RunInGitRoot:             true,
@@ -31,4 +41,5 @@ type BranchesProgramArgs struct {
 	InitialBranch  gitdomain.LocalBranchName
 	PreviousBranch gitdomain.LocalBranchName
 	ShouldPushTags bool
+	Stack          gitdomain.LocalBranchNames // feature branches to sync together via "git rebase --update-refs", ordered from the bottom to the top
 }
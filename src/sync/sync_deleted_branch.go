@@ -13,7 +13,7 @@ import (
 // syncDeletedBranchProgram adds opcodes that sync a branch that was deleted at origin to the given program.
 func syncDeletedBranchProgram(list *program.Program, branch gitdomain.BranchInfo, parentOtherWorktree bool, args BranchProgramArgs) {
 	switch args.Config.BranchType(branch.LocalName) {
-	case configdomain.BranchTypeFeatureBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypePrototypeBranch:
 		syncDeletedFeatureBranchProgram(list, branch, parentOtherWorktree, args)
 	case configdomain.BranchTypePerennialBranch, configdomain.BranchTypeMainBranch:
 		syncDeletedPerennialBranchProgram(list, branch, args)
@@ -30,7 +30,7 @@ func syncDeletedFeatureBranchProgram(list *program.Program, branch gitdomain.Bra
 		branch:              branch,
 		parentOtherWorktree: parentOtherWorktree,
 		program:             list,
-		syncStrategy:        args.Config.SyncFeatureStrategy,
+		syncStrategy:        args.Config.SyncFeatureStrategyFor(branch.LocalName),
 	})
 	list.Add(&opcodes.DeleteBranchIfEmptyAtRuntime{Branch: branch.LocalName})
 }
@@ -0,0 +1,7 @@
+package sync
+
+// PrototypeBranchProgram adds the opcodes to sync the prototype branch with the given name.
+// Prototype branches sync with their parent like feature branches but never get pushed.
+func PrototypeBranchProgram(args featureBranchArgs) {
+	FeatureBranchProgram(args)
+}
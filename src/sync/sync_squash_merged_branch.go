@@ -0,0 +1,55 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v12/src/config/configdomain"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/gohacks/slice"
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+	"github.com/git-town/git-town/v12/src/vm/program"
+)
+
+// syncSquashMergedBranchProgram removes the given branch, whose changes were squash-merged into its parent branch,
+// from the lineage and the local repo.
+// The parent branch must have been fully synced before calling this function.
+func syncSquashMergedBranchProgram(list *program.Program, branch gitdomain.BranchInfo, args BranchProgramArgs) {
+	parent := ExistingAncestor(branch.LocalName, args.Config.Lineage, args.SquashMergedBranches)
+	RemoveBranchFromLineage(RemoveBranchFromLineageArgs{
+		Branch:  branch.LocalName,
+		Lineage: args.Config.Lineage,
+		Parent:  parent,
+		Program: list,
+	})
+	list.Add(&opcodes.Checkout{Branch: parent})
+	list.Add(&opcodes.DeleteLocalBranch{Branch: branch.LocalName})
+	list.Add(&opcodes.QueueMessage{Message: fmt.Sprintf(messages.BranchSquashMergedDeleted, branch.LocalName, parent)})
+}
+
+// syncChildOfSquashMergedBranchProgram syncs the given feature branch whose parent branch was squash-merged.
+// It moves only the commits that this branch added on top of its former parent onto its new parent,
+// so that the commits of the squash-merged parent don't end up in this branch a second time.
+func syncChildOfSquashMergedBranchProgram(list *program.Program, branch gitdomain.BranchInfo, args BranchProgramArgs) {
+	squashMergedParent := args.BranchInfos.FindByLocalName(args.Config.Lineage.Parent(branch.LocalName))
+	if squashMergedParent == nil {
+		return
+	}
+	if branch.HasTrackingBranch() {
+		pullTrackingBranchOfCurrentFeatureBranchOpcode(list, branch.RemoteName, args.Config.SyncFeatureStrategyFor(branch.LocalName))
+	}
+	list.Add(&opcodes.RebaseOnto{
+		Branch:   ExistingAncestor(branch.LocalName, args.Config.Lineage, args.SquashMergedBranches).BranchName(),
+		Upstream: squashMergedParent.LocalSHA.Location(),
+	})
+}
+
+// ExistingAncestor provides the closest ancestor of the given branch that isn't among the given squash-merged branches.
+// This is the new parent of the given branch once Git Town has removed the squash-merged branches.
+func ExistingAncestor(branch gitdomain.LocalBranchName, lineage configdomain.Lineage, squashMergedBranches gitdomain.LocalBranchNames) gitdomain.LocalBranchName {
+	parent := lineage.Parent(branch)
+	for slice.Contains(squashMergedBranches, parent) {
+		parent = lineage.Parent(parent)
+	}
+	return parent
+}
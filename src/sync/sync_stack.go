@@ -0,0 +1,34 @@
+package sync
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/opcodes"
+)
+
+// syncStackProgram syncs the given stack of feature branches, ordered from the bottom to the top,
+// by rebasing only its topmost branch against the parent of the stack using "git rebase --update-refs".
+// Git moves the other branches of the stack along with the topmost branch,
+// so that merge conflicts need to be resolved only once and Git Town doesn't check out every branch.
+// The parent of the stack must have been fully synced before calling this function.
+func syncStackProgram(stack gitdomain.LocalBranchNames, args BranchProgramArgs) {
+	if len(stack) == 0 {
+		return
+	}
+	top := stack[len(stack)-1]
+	args.Program.Add(&opcodes.Checkout{Branch: top})
+	args.Program.Add(&opcodes.RebaseUpdateRefs{Branch: args.Config.Lineage.Parent(stack[0]).BranchName()})
+	if args.PushBranch && args.Remotes.HasOrigin() && args.Config.IsOnline() {
+		for _, branchName := range stack {
+			branch := args.BranchInfos.FindByLocalName(branchName)
+			if branch == nil || !args.Config.BranchType(branchName).ShouldPush(branchName, args.InitialBranch) {
+				continue
+			}
+			if branch.HasTrackingBranch() {
+				args.Program.Add(&opcodes.ForcePushBranch{Branch: branchName})
+			} else {
+				args.Program.Add(&opcodes.CreateTrackingBranch{Branch: branchName})
+			}
+		}
+	}
+	args.Program.Add(&opcodes.EndOfBranchProgram{})
+}
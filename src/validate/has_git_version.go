@@ -18,3 +18,8 @@ func HasAcceptableGitVersion(majorVersion, minorVersion int) error {
 func IsAcceptableGitVersion(major, minor int) bool {
 	return major > 2 || (major == 2 && minor >= 7)
 }
+
+// SupportsRebaseUpdateRefs indicates whether the given Git version supports "git rebase --update-refs".
+func SupportsRebaseUpdateRefs(major, minor int) bool {
+	return major > 2 || (major == 2 && minor >= 38)
+}
@@ -24,3 +24,22 @@ func TestIsAcceptableGitVersion(t *testing.T) {
 		must.EqOp(t, tt.want, have)
 	}
 }
+
+func TestSupportsRebaseUpdateRefs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		major int
+		minor int
+		want  bool
+	}{
+		{2, 38, true},
+		{2, 43, true},
+		{3, 0, true},
+		{2, 37, false},
+		{1, 9, false},
+	}
+	for _, tt := range tests {
+		have := validate.SupportsRebaseUpdateRefs(tt.major, tt.minor)
+		must.EqOp(t, tt.want, have)
+	}
+}
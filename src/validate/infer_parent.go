@@ -0,0 +1,76 @@
+package validate
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+)
+
+// inferParent provides the most likely parent for the given branch without lineage.
+// Provides an empty branch name if it cannot infer a parent.
+//
+// It prefers the branch recorded as the creation source of the given branch in the reflog.
+// Otherwise it selects the main, perennial, or known feature branch that the given branch
+// has the fewest own commits against.
+func inferParent(branch gitdomain.LocalBranchName, args KnowsBranchAncestorsArgs) gitdomain.LocalBranchName {
+	candidates := parentCandidates(branch, args)
+	for _, source := range creationSourceBranches(args.Backend.BranchCreationSource(branch)) {
+		if candidates.Contains(source) {
+			return source
+		}
+	}
+	result := gitdomain.EmptyLocalBranchName()
+	fewestCommits := -1
+	for _, candidate := range candidates {
+		commits, err := args.Backend.CommitsAheadCount(branch.BranchName(), candidate.BranchName())
+		if err != nil {
+			continue
+		}
+		if fewestCommits == -1 || commits < fewestCommits {
+			result = candidate
+			fewestCommits = commits
+		}
+	}
+	return result
+}
+
+// creationSourceBranches provides the names of the local branches
+// that the given creation source in the reflog might refer to.
+func creationSourceBranches(source string) gitdomain.LocalBranchNames {
+	if source == "" || source == "HEAD" {
+		return gitdomain.LocalBranchNames{}
+	}
+	if name, isLocal := strings.CutPrefix(source, "refs/heads/"); isLocal {
+		return gitdomain.NewLocalBranchNames(name)
+	}
+	if remoteBranch, isRemote := strings.CutPrefix(source, "refs/remotes/"); isRemote {
+		_, name, _ := strings.Cut(remoteBranch, "/")
+		if name == "" {
+			return gitdomain.LocalBranchNames{}
+		}
+		return gitdomain.NewLocalBranchNames(name)
+	}
+	result := gitdomain.NewLocalBranchNames(source)
+	// the source might also be a remote-tracking branch like "origin/branch"
+	if _, name, hasSlash := strings.Cut(source, "/"); hasSlash && name != "" {
+		result = append(result, gitdomain.NewLocalBranchName(name))
+	}
+	return result
+}
+
+// parentCandidates provides the branches that can be the parent of the given branch,
+// ordered by preference.
+func parentCandidates(branch gitdomain.LocalBranchName, args KnowsBranchAncestorsArgs) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{args.MainBranch}
+	for _, perennial := range args.Config.PerennialBranches {
+		if args.LocalBranches.Contains(perennial) && perennial != branch {
+			result = append(result, perennial)
+		}
+	}
+	for _, known := range args.Config.Lineage.BranchNames() {
+		if known != branch && args.LocalBranches.Contains(known) && !args.Config.Lineage.IsAncestor(branch, known) {
+			result = append(result, known)
+		}
+	}
+	return result
+}
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/git-town/git-town/v12/src/cli/dialog"
@@ -8,33 +9,52 @@ import (
 	"github.com/git-town/git-town/v12/src/config/configdomain"
 	"github.com/git-town/git-town/v12/src/git"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/messages"
 )
 
 // KnowsBranchAncestors prompts the user for all unknown ancestors of the given branch.
 func KnowsBranchAncestors(branch gitdomain.LocalBranchName, args KnowsBranchAncestorsArgs) (bool, error) {
 	currentBranch := branch
-	if args.Config.IsMainOrPerennialBranch(branch) || args.Config.IsObservedBranch(branch) || args.Config.IsContributionBranch(branch) {
+	branchType := args.Config.BranchType(branch)
+	switch branchType {
+	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeContributionBranch:
 		return false, nil
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 	}
 	updated := false
+	if branchType == configdomain.BranchTypeFeatureBranch && !args.Config.Lineage.HasParents(branch) {
+		var needsParent bool
+		var err error
+		updated, needsParent, err = applyDefaultBranchType(branch, args)
+		if err != nil || !needsParent {
+			return updated, err
+		}
+	}
 	for {
 		lineage := args.Backend.Config.FullConfig.Lineage
 		parent, hasParent := lineage[currentBranch]
 		if !hasParent { //nolint:nestif
 			var aborted bool
 			var err error
-			parent, aborted, err = dialog.Parent(dialog.ParentArgs{
-				Branch:          currentBranch,
-				DialogTestInput: args.DialogTestInputs.Next(),
-				Lineage:         args.Config.Lineage,
-				LocalBranches:   args.LocalBranches,
-				MainBranch:      args.MainBranch,
-			})
-			if err != nil {
-				return false, err
-			}
-			if aborted {
-				os.Exit(0)
+			inferredParent := inferParent(currentBranch, args)
+			if args.InferParents && !inferredParent.IsEmpty() {
+				parent = inferredParent
+				fmt.Printf(messages.ParentInferred, currentBranch, parent)
+			} else {
+				parent, aborted, err = dialog.Parent(dialog.ParentArgs{
+					Branch:          currentBranch,
+					DialogTestInput: args.DialogTestInputs.Next(),
+					Lineage:         args.Config.Lineage,
+					LocalBranches:   args.LocalBranches,
+					MainBranch:      args.MainBranch,
+					Suggestion:      inferredParent,
+				})
+				if err != nil {
+					return false, err
+				}
+				if aborted {
+					os.Exit(0)
+				}
 			}
 			if parent == dialog.PerennialBranchOption {
 				err = args.Backend.Config.AddToPerennialBranches(currentBranch)
@@ -59,9 +79,11 @@ func KnowsBranchAncestors(branch gitdomain.LocalBranchName, args KnowsBranchAnce
 }
 
 type KnowsBranchAncestorsArgs struct {
+	AllBranches      gitdomain.BranchInfos
 	Backend          *git.BackendCommands
 	Config           *configdomain.FullConfig
 	DialogTestInputs *components.TestInputs
+	InferParents     bool // whether to apply inferred parents without asking the user
 	LocalBranches    gitdomain.LocalBranchNames
 	MainBranch       gitdomain.LocalBranchName
 }
@@ -74,9 +96,11 @@ func KnowsBranchesAncestors(args KnowsBranchesAncestorsArgs) (bool, error) {
 	updated := false
 	for _, branch := range args.LocalBranches {
 		branchUpdated, err := KnowsBranchAncestors(branch.LocalName, KnowsBranchAncestorsArgs{
+			AllBranches:      args.LocalBranches,
 			Backend:          args.Backend,
 			Config:           args.Config,
 			DialogTestInputs: args.DialogTestInputs,
+			InferParents:     args.InferParents,
 			LocalBranches:    args.LocalBranches.Names(),
 			MainBranch:       args.Config.MainBranch,
 		})
@@ -94,5 +118,43 @@ type KnowsBranchesAncestorsArgs struct {
 	Backend          *git.BackendCommands
 	Config           *configdomain.FullConfig
 	DialogTestInputs *components.TestInputs
+	InferParents     bool
 	LocalBranches    gitdomain.BranchInfos
 }
+
+// applyDefaultBranchType assigns the configured default branch type to the given branch without lineage.
+// Indicates whether it changed the configuration and whether the branch still needs a parent.
+func applyDefaultBranchType(branch gitdomain.LocalBranchName, args KnowsBranchAncestorsArgs) (updated, needsParent bool, err error) {
+	switch defaultBranchType(branch, args) {
+	case configdomain.BranchTypeContributionBranch:
+		err = args.Backend.Config.AddToContributionBranches(branch)
+		fmt.Printf(messages.ContributeBranchIsNowContribution, branch)
+		return true, false, err
+	case configdomain.BranchTypeObservedBranch:
+		err = args.Backend.Config.AddToObservedBranches(branch)
+		fmt.Printf(messages.ObservedBranchIsNowObserved, branch)
+		return true, false, err
+	case configdomain.BranchTypeParkedBranch:
+		err = args.Backend.Config.AddToParkedBranches(branch)
+		fmt.Printf(messages.ParkedBranchIsNowParked, branch)
+		return true, true, err
+	case configdomain.BranchTypePrototypeBranch:
+		err = args.Backend.Config.AddToPrototypeBranches(branch)
+		fmt.Printf(messages.PrototypeBranchIsNowPrototype, branch)
+		return true, true, err
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+	}
+	return false, true, nil
+}
+
+// defaultBranchType provides the branch type that the "default-branch-type"
+// or "default-remote-branch-type" setting defines for the given branch.
+func defaultBranchType(branch gitdomain.LocalBranchName, args KnowsBranchAncestorsArgs) configdomain.BranchType {
+	if args.Config.DefaultRemoteBranchType == args.Config.DefaultBranchType {
+		return args.Config.DefaultBranchType
+	}
+	if args.Backend.CreatedFromRemoteBranch(branch) {
+		return args.Config.DefaultRemoteBranchType
+	}
+	return args.Config.DefaultBranchType
+}
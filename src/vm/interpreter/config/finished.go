@@ -16,11 +16,11 @@ func Finished(args FinishedArgs) error {
 	// TODO: extract the code to load a config snapshot into a reusable function
 	//       since it exists in multiple places
 	configGitAccess := gitconfig.Access{Runner: args.Runner.Backend.Runner}
-	globalSnapshot, _, err := configGitAccess.LoadGlobal()
+	globalSnapshot, _, _, err := configGitAccess.LoadGlobal()
 	if err != nil {
 		return err
 	}
-	localSnapshot, _, err := configGitAccess.LoadLocal()
+	localSnapshot, _, _, err := configGitAccess.LoadLocal()
 	if err != nil {
 		return err
 	}
@@ -20,11 +20,11 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 		return err
 	}
 	configGitAccess := gitconfig.Access{Runner: args.Run.Backend.Runner}
-	globalSnapshot, _, err := configGitAccess.LoadGlobal()
+	globalSnapshot, _, _, err := configGitAccess.LoadGlobal()
 	if err != nil {
 		return err
 	}
-	localSnapshot, _, err := configGitAccess.LoadLocal()
+	localSnapshot, _, _, err := configGitAccess.LoadLocal()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,16 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// AddToPrototypeBranches registers the branch with the given name as a prototype branch.
+type AddToPrototypeBranches struct {
+	Branch gitdomain.LocalBranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *AddToPrototypeBranches) Run(args shared.RunArgs) error {
+	return args.Runner.Config.AddToPrototypeBranches(self.Branch)
+}
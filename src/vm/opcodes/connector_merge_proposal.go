@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/git-town/git-town/v12/src/git/commitmessage"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/git-town/git-town/v12/src/vm/shared"
@@ -12,7 +13,10 @@ import (
 // ConnectorMergeProposal squash merges the branch with the given name into the current branch.
 type ConnectorMergeProposal struct {
 	Branch                    gitdomain.LocalBranchName
+	CoAuthors                 bool // whether to credit the other branch authors via "Co-authored-by" trailers
 	CommitMessage             string
+	DefaultCommitMessage      string // the commit message to pre-populate the editor with if no commit message is given
+	Parent                    gitdomain.LocalBranchName
 	ProposalMessage           string
 	ProposalNumber            int
 	enteredEmptyCommitMessage bool
@@ -40,13 +44,17 @@ func (self *ConnectorMergeProposal) CreateContinueProgram() []shared.Opcode {
 }
 
 func (self *ConnectorMergeProposal) Run(args shared.RunArgs) error {
+	coAuthors, err := self.coAuthors(args)
+	if err != nil {
+		return err
+	}
 	commitMessage := self.CommitMessage
 	//nolint:nestif
 	if commitMessage == "" {
 		// Allow the user to enter the commit message as if shipping without a connector
 		// then revert the commit since merging via the connector will perform the actual squash merge.
 		self.enteredEmptyCommitMessage = true
-		err := args.Runner.Frontend.SquashMerge(self.Branch)
+		err = args.Runner.Frontend.SquashMerge(self.Branch)
 		if err != nil {
 			return err
 		}
@@ -54,6 +62,18 @@ func (self *ConnectorMergeProposal) Run(args shared.RunArgs) error {
 		if err != nil {
 			return fmt.Errorf(messages.SquashMessageProblem, err)
 		}
+		if self.DefaultCommitMessage != "" {
+			err = args.Runner.Backend.PrependToSquashCommitMessage(self.DefaultCommitMessage)
+			if err != nil {
+				return fmt.Errorf(messages.SquashMessageProblem, err)
+			}
+		}
+		if len(coAuthors) > 0 {
+			err = args.Runner.Backend.AppendToSquashCommitMessage(commitmessage.AddCoAuthors("", coAuthors))
+			if err != nil {
+				return fmt.Errorf(messages.SquashMessageProblem, err)
+			}
+		}
 		err = args.Runner.Frontend.StartCommit()
 		if err != nil {
 			return err
@@ -67,11 +87,32 @@ func (self *ConnectorMergeProposal) Run(args shared.RunArgs) error {
 			return err
 		}
 		self.enteredEmptyCommitMessage = false
+	} else {
+		// the editor already offered the trailers, a message entered by the user in the editor gets used as-is
+		commitMessage = commitmessage.AddCoAuthors(commitMessage, coAuthors)
 	}
 	self.mergeError = args.Connector.SquashMergeProposal(self.ProposalNumber, commitMessage)
 	return self.mergeError
 }
 
+// coAuthors provides the branch authors that the squash commit should credit via "Co-authored-by" trailers.
+// The hosting platform attributes the squash commit to the user merging the proposal,
+// so this includes everybody who committed to the branch except that user.
+func (self *ConnectorMergeProposal) coAuthors(args shared.RunArgs) ([]string, error) {
+	if !self.CoAuthors {
+		return []string{}, nil
+	}
+	branchAuthors, err := args.Runner.Backend.BranchAuthors(self.Branch, self.Parent)
+	if err != nil {
+		return []string{}, err
+	}
+	repoAuthor, err := args.Runner.Backend.Author()
+	if err != nil {
+		return []string{}, err
+	}
+	return commitmessage.CoAuthors(branchAuthors, repoAuthor), nil
+}
+
 // ShouldAutomaticallyUndoOnError returns whether this opcode should cause the command to
 // automatically undo if it errors.
 func (self *ConnectorMergeProposal) ShouldAutomaticallyUndoOnError() bool {
@@ -48,6 +48,7 @@ func Types() []shared.Opcode {
 		&AbortMerge{},
 		&AbortRebase{},
 		&AddToPerennialBranches{},
+		&AddToPrototypeBranches{},
 		&ChangeParent{},
 		&Checkout{},
 		&CheckoutIfExists{},
@@ -64,11 +65,13 @@ func Types() []shared.Opcode {
 		&CreateTrackingBranch{},
 		&DeleteLocalBranch{},
 		&DeleteParentBranch{},
+		&DeleteRunStateFile{},
 		&DeleteTrackingBranch{},
 		&DiscardOpenChanges{},
 		&EndOfBranchProgram{},
 		&EnsureHasShippableChanges{},
 		&FetchUpstream{},
+		&ForcePushBranch{},
 		&ForcePushCurrentBranch{},
 		&DeleteBranchIfEmptyAtRuntime{},
 		&Merge{},
@@ -76,11 +79,18 @@ func Types() []shared.Opcode {
 		&PreserveCheckoutHistory{},
 		&PullCurrentBranch{},
 		&PushCurrentBranch{},
+		&PushSharedLineage{},
 		&PushTags{},
 		&RebaseBranch{},
+		&RebaseOnto{},
 		&RebaseParent{},
+		&RebaseUpdateRefs{},
 		&RemoveBranchFromLineage{},
+		&RemoveFromContributionBranches{},
+		&RemoveFromObservedBranches{},
+		&RemoveFromParkedBranches{},
 		&RemoveFromPerennialBranches{},
+		&RemoveFromPrototypeBranches{},
 		&RemoveGlobalConfig{},
 		&RemoveLocalConfig{},
 		&ResetCurrentBranchToSHA{},
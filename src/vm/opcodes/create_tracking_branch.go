@@ -5,7 +5,7 @@ import (
 	"github.com/git-town/git-town/v12/src/vm/shared"
 )
 
-// CreateTrackingBranch pushes the given local branch up to origin
+// CreateTrackingBranch pushes the given local branch up to the remote it pushes to
 // and marks it as tracking the current branch.
 type CreateTrackingBranch struct {
 	Branch gitdomain.LocalBranchName
@@ -19,5 +19,5 @@ func (self *CreateTrackingBranch) CreateContinueProgram() []shared.Opcode {
 }
 
 func (self *CreateTrackingBranch) Run(args shared.RunArgs) error {
-	return args.Runner.Frontend.CreateTrackingBranch(self.Branch, gitdomain.OriginRemote, args.Runner.Config.FullConfig.NoPushHook())
+	return args.Runner.Frontend.CreateTrackingBranch(self.Branch, args.Runner.Config.FullConfig.PushRemote(self.Branch), args.Runner.Config.FullConfig.NoPushHook())
 }
@@ -0,0 +1,23 @@
+package opcodes
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v12/src/messages"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// DeleteRunStateFile removes the persisted runstate at the given path.
+type DeleteRunStateFile struct {
+	Path string
+	undeclaredOpcodeMethods
+}
+
+func (self *DeleteRunStateFile) Run(_ shared.RunArgs) error {
+	err := os.Remove(self.Path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf(messages.FileDeleteProblem, self.Path, err)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// ForcePushBranch force-pushes the given local branch to the remote it pushes to.
+// Unlike ForcePushCurrentBranch, the branch doesn't need to be checked out.
+type ForcePushBranch struct {
+	Branch gitdomain.LocalBranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *ForcePushBranch) Run(args shared.RunArgs) error {
+	remote := args.Runner.Config.FullConfig.PushRemote(self.Branch)
+	shouldPush, err := args.Runner.Backend.ShouldPushBranch(self.Branch, self.Branch.AtRemote(remote))
+	if err != nil {
+		return err
+	}
+	if !shouldPush {
+		return nil
+	}
+	return args.Runner.Frontend.ForcePushLocalBranch(self.Branch, remote, args.Runner.Config.FullConfig.NoPushHook())
+}
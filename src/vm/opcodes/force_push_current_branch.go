@@ -1,10 +1,11 @@
 package opcodes
 
 import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/vm/shared"
 )
 
-// ForcePushCurrentBranch force-pushes the branch with the given name to the origin remote.
+// ForcePushCurrentBranch force-pushes the current branch to the remote it pushes to.
 type ForcePushCurrentBranch struct {
 	undeclaredOpcodeMethods
 }
@@ -14,12 +15,16 @@ func (self *ForcePushCurrentBranch) Run(args shared.RunArgs) error {
 	if err != nil {
 		return err
 	}
-	shouldPush, err := args.Runner.Backend.ShouldPushBranch(currentBranch, currentBranch.TrackingBranch())
+	remote := args.Runner.Config.FullConfig.PushRemote(currentBranch)
+	shouldPush, err := args.Runner.Backend.ShouldPushBranch(currentBranch, currentBranch.AtRemote(remote))
 	if err != nil {
 		return err
 	}
 	if !shouldPush {
 		return nil
 	}
+	if remote != gitdomain.OriginRemote {
+		return args.Runner.Frontend.ForcePushLocalBranch(currentBranch, remote, args.Runner.Config.FullConfig.NoPushHook())
+	}
 	return args.Runner.Frontend.ForcePushBranch(args.Runner.Config.FullConfig.NoPushHook())
 }
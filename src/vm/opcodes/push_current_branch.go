@@ -5,7 +5,7 @@ import (
 	"github.com/git-town/git-town/v12/src/vm/shared"
 )
 
-// PushCurrentBranch pushes the current branch to its existing tracking branch.
+// PushCurrentBranch pushes the current branch to the remote it pushes to.
 type PushCurrentBranch struct {
 	CurrentBranch gitdomain.LocalBranchName
 	undeclaredOpcodeMethods
@@ -18,12 +18,16 @@ func (self *PushCurrentBranch) CreateContinueProgram() []shared.Opcode {
 }
 
 func (self *PushCurrentBranch) Run(args shared.RunArgs) error {
-	shouldPush, err := args.Runner.Backend.ShouldPushBranch(self.CurrentBranch, self.CurrentBranch.TrackingBranch())
+	remote := args.Runner.Config.FullConfig.PushRemote(self.CurrentBranch)
+	shouldPush, err := args.Runner.Backend.ShouldPushBranch(self.CurrentBranch, self.CurrentBranch.AtRemote(remote))
 	if err != nil {
 		return err
 	}
 	if !shouldPush {
 		return nil
 	}
+	if remote != gitdomain.OriginRemote {
+		return args.Runner.Frontend.PushLocalBranch(self.CurrentBranch, remote, args.Runner.Config.FullConfig.NoPushHook())
+	}
 	return args.Runner.Frontend.PushCurrentBranch(args.Runner.Config.FullConfig.NoPushHook())
 }
@@ -0,0 +1,62 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/config/sharedlineage"
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// pushSharedLineageAttempts is how often PushSharedLineage tries to update the shared lineage
+// when teammates update it concurrently.
+const pushSharedLineageAttempts = 3
+
+// PushSharedLineage shares the local lineage with teammates
+// by pushing it to the shared lineage ref at the origin remote.
+type PushSharedLineage struct {
+	undeclaredOpcodeMethods
+}
+
+func (self *PushSharedLineage) Run(args shared.RunArgs) error {
+	if args.Runner.Config.DryRun {
+		return nil
+	}
+	args.Runner.Config.Reload()
+	originBranches, err := args.Runner.Backend.RemoteBranchNames(gitdomain.OriginRemote)
+	if err != nil {
+		return err
+	}
+	local := sharedlineage.FromConfig(&args.Runner.Config.FullConfig)
+	for attempt := 1; ; attempt++ {
+		// the tracking ref contains the shared lineage as it was when Git Town last fetched it,
+		// the push fails if a teammate has updated the shared lineage since then
+		sharedData := sharedlineage.EmptyData()
+		sharedContent, hasShared := args.Runner.Backend.ReadBlobRef(sharedlineage.TrackingRef)
+		expected := ""
+		if hasShared {
+			expected = sharedlineage.TrackingRef
+			decoded, err := sharedlineage.Decode(sharedContent)
+			if err == nil {
+				sharedData = decoded
+			}
+		}
+		content := sharedlineage.Overlay(sharedData, local, originBranches).Encode()
+		if hasShared && content == sharedContent {
+			return nil
+		}
+		err = args.Runner.Backend.WriteBlobRef(sharedlineage.Ref, content)
+		if err != nil {
+			return err
+		}
+		err = args.Runner.Frontend.PushRef(gitdomain.OriginRemote, sharedlineage.Ref, expected)
+		if err == nil {
+			return args.Runner.Backend.WriteBlobRef(sharedlineage.TrackingRef, content)
+		}
+		if attempt == pushSharedLineageAttempts {
+			return err
+		}
+		// a teammate has updated the shared lineage in the meantime --> merge their changes and try again
+		if args.Runner.Backend.FetchRef(gitdomain.OriginRemote, sharedlineage.Ref, sharedlineage.TrackingRef) != nil {
+			_ = args.Runner.Backend.DeleteRef(sharedlineage.TrackingRef)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// RebaseOnto rebases the current branch onto the given branch,
+// dropping all commits that are reachable from the given upstream location.
+type RebaseOnto struct {
+	Branch   gitdomain.BranchName
+	Upstream gitdomain.Location
+	undeclaredOpcodeMethods
+}
+
+func (self *RebaseOnto) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{&AbortRebase{}}
+}
+
+func (self *RebaseOnto) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ContinueRebase{},
+	}
+}
+
+func (self *RebaseOnto) Run(args shared.RunArgs) error {
+	return args.Runner.Frontend.RebaseOnto(self.Branch, self.Upstream)
+}
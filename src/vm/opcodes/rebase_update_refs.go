@@ -0,0 +1,27 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// RebaseUpdateRefs rebases the current branch against the branch with the given name
+// and moves all local branches stacked inside the current branch along with it.
+type RebaseUpdateRefs struct {
+	Branch gitdomain.BranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *RebaseUpdateRefs) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{&AbortRebase{}}
+}
+
+func (self *RebaseUpdateRefs) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ContinueRebase{},
+	}
+}
+
+func (self *RebaseUpdateRefs) Run(args shared.RunArgs) error {
+	return args.Runner.Frontend.RebaseUpdateRefs(self.Branch)
+}
@@ -0,0 +1,16 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// RemoveFromContributionBranches removes the branch with the given name as a contribution branch.
+type RemoveFromContributionBranches struct {
+	Branch gitdomain.LocalBranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *RemoveFromContributionBranches) Run(args shared.RunArgs) error {
+	return args.Runner.Config.RemoveFromContributionBranches(self.Branch)
+}
@@ -0,0 +1,16 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v12/src/git/gitdomain"
+	"github.com/git-town/git-town/v12/src/vm/shared"
+)
+
+// RemoveFromParkedBranches removes the branch with the given name as a parked branch.
+type RemoveFromParkedBranches struct {
+	Branch gitdomain.LocalBranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *RemoveFromParkedBranches) Run(args shared.RunArgs) error {
+	return args.Runner.Config.RemoveFromParkedBranches(self.Branch)
+}
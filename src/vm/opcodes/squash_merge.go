@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/git-town/git-town/v12/src/cli/dialog"
+	"github.com/git-town/git-town/v12/src/git/commitmessage"
 	"github.com/git-town/git-town/v12/src/git/gitdomain"
 	"github.com/git-town/git-town/v12/src/messages"
 	"github.com/git-town/git-town/v12/src/vm/shared"
@@ -12,9 +13,11 @@ import (
 
 // SquashMerge squash merges the branch with the given name into the current branch.
 type SquashMerge struct {
-	Branch        gitdomain.LocalBranchName
-	CommitMessage string
-	Parent        gitdomain.LocalBranchName
+	Branch               gitdomain.LocalBranchName
+	CoAuthors            bool // whether to credit the branch authors not selected as the commit author via "Co-authored-by" trailers
+	CommitMessage        string
+	DefaultCommitMessage string // the commit message to pre-populate the editor with if no commit message is given
+	Parent               gitdomain.LocalBranchName
 	undeclaredOpcodeMethods
 }
 
@@ -44,6 +47,10 @@ func (self *SquashMerge) Run(args shared.RunArgs) error {
 	if aborted {
 		return errors.New("aborted by user")
 	}
+	coAuthors := []string{}
+	if self.CoAuthors {
+		coAuthors = commitmessage.CoAuthors(branchAuthors, author)
+	}
 	repoAuthor, err := args.Runner.Backend.Author()
 	if err != nil {
 		return err
@@ -52,11 +59,25 @@ func (self *SquashMerge) Run(args shared.RunArgs) error {
 		if err = args.Runner.Backend.CommentOutSquashCommitMessage(""); err != nil {
 			return fmt.Errorf(messages.SquashMessageProblem, err)
 		}
+		if self.CommitMessage == "" && self.DefaultCommitMessage != "" {
+			if err = args.Runner.Backend.PrependToSquashCommitMessage(self.DefaultCommitMessage); err != nil {
+				return fmt.Errorf(messages.SquashMessageProblem, err)
+			}
+		}
+		if self.CommitMessage == "" && len(coAuthors) > 0 {
+			if err = args.Runner.Backend.AppendToSquashCommitMessage(commitmessage.AddCoAuthors("", coAuthors)); err != nil {
+				return fmt.Errorf(messages.SquashMessageProblem, err)
+			}
+		}
 	}
 	if repoAuthor == author {
 		author = ""
 	}
-	err = args.Runner.Frontend.Commit(self.CommitMessage, author)
+	commitMessage := self.CommitMessage
+	if commitMessage != "" {
+		commitMessage = commitmessage.AddCoAuthors(commitMessage, coAuthors)
+	}
+	err = args.Runner.Frontend.Commit(commitMessage, author)
 	if err != nil {
 		return err
 	}
@@ -50,6 +50,7 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.AbortMerge{},
 				&opcodes.AbortRebase{},
 				&opcodes.AddToPerennialBranches{Branch: gitdomain.NewLocalBranchName("branch")},
+				&opcodes.AddToPrototypeBranches{Branch: gitdomain.NewLocalBranchName("branch")},
 				&opcodes.ChangeParent{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 					Parent: gitdomain.NewLocalBranchName("parent"),
@@ -57,10 +58,13 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("branch")},
 				&opcodes.CommitOpenChanges{},
 				&opcodes.ConnectorMergeProposal{
-					Branch:          gitdomain.NewLocalBranchName("branch"),
-					CommitMessage:   "commit message",
-					ProposalMessage: "proposal message",
-					ProposalNumber:  123,
+					Branch:               gitdomain.NewLocalBranchName("branch"),
+					CoAuthors:            true,
+					CommitMessage:        "commit message",
+					DefaultCommitMessage: "default commit message",
+					Parent:               gitdomain.NewLocalBranchName("parent"),
+					ProposalMessage:      "proposal message",
+					ProposalNumber:       123,
 				},
 				&opcodes.ContinueMerge{},
 				&opcodes.ContinueRebase{},
@@ -80,6 +84,7 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.DeleteParentBranch{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 				},
+				&opcodes.DeleteRunStateFile{Path: "/tmp/runstate.json"},
 				&opcodes.DeleteTrackingBranch{
 					Branch: gitdomain.NewRemoteBranchName("origin/branch"),
 				},
@@ -92,6 +97,7 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.FetchUpstream{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 				},
+				&opcodes.ForcePushBranch{Branch: gitdomain.NewLocalBranchName("branch")},
 				&opcodes.ForcePushCurrentBranch{},
 				&opcodes.Merge{Branch: gitdomain.NewBranchName("branch")},
 				&opcodes.MergeParent{
@@ -105,15 +111,33 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.PushCurrentBranch{
 					CurrentBranch: gitdomain.NewLocalBranchName("branch"),
 				},
+				&opcodes.PushSharedLineage{},
 				&opcodes.PushTags{},
 				&opcodes.RebaseBranch{Branch: gitdomain.NewBranchName("branch")},
+				&opcodes.RebaseOnto{
+					Branch:   gitdomain.NewBranchName("branch"),
+					Upstream: gitdomain.NewSHA("123456").Location(),
+				},
 				&opcodes.RebaseParent{
 					CurrentBranch:               gitdomain.NewLocalBranchName("branch"),
 					ParentActiveInOtherWorktree: true,
 				},
+				&opcodes.RebaseUpdateRefs{Branch: gitdomain.NewBranchName("branch")},
+				&opcodes.RemoveFromContributionBranches{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+				},
+				&opcodes.RemoveFromObservedBranches{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+				},
+				&opcodes.RemoveFromParkedBranches{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+				},
 				&opcodes.RemoveFromPerennialBranches{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 				},
+				&opcodes.RemoveFromPrototypeBranches{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+				},
 				&opcodes.RemoveGlobalConfig{
 					Key: gitconfig.KeyOffline,
 				},
@@ -147,9 +171,11 @@ func TestLoadSave(t *testing.T) {
 				},
 				&opcodes.SkipCurrentBranch{},
 				&opcodes.SquashMerge{
-					Branch:        gitdomain.NewLocalBranchName("branch"),
-					CommitMessage: "commit message",
-					Parent:        gitdomain.NewLocalBranchName("parent"),
+					Branch:               gitdomain.NewLocalBranchName("branch"),
+					CoAuthors:            true,
+					CommitMessage:        "commit message",
+					DefaultCommitMessage: "default commit message",
+					Parent:               gitdomain.NewLocalBranchName("parent"),
 				},
 				&opcodes.StashOpenChanges{},
 				&opcodes.UpdateProposalTarget{
@@ -205,6 +231,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "AddToPerennialBranches"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "AddToPrototypeBranches"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -225,7 +257,10 @@ func TestLoadSave(t *testing.T) {
     {
       "data": {
         "Branch": "branch",
+        "CoAuthors": true,
         "CommitMessage": "commit message",
+        "DefaultCommitMessage": "default commit message",
+        "Parent": "parent",
         "ProposalMessage": "proposal message",
         "ProposalNumber": 123
       },
@@ -277,6 +312,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "DeleteParentBranch"
     },
+    {
+      "data": {
+        "Path": "/tmp/runstate.json"
+      },
+      "type": "DeleteRunStateFile"
+    },
     {
       "data": {
         "Branch": "origin/branch"
@@ -304,6 +345,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "FetchUpstream"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "ForcePushBranch"
+    },
     {
       "data": {},
       "type": "ForcePushCurrentBranch"
@@ -339,6 +386,10 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "PushCurrentBranch"
     },
+    {
+      "data": {},
+      "type": "PushSharedLineage"
+    },
     {
       "data": {},
       "type": "PushTags"
@@ -349,6 +400,13 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RebaseBranch"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "Upstream": "123456"
+      },
+      "type": "RebaseOnto"
+    },
     {
       "data": {
         "CurrentBranch": "branch",
@@ -356,12 +414,42 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RebaseParent"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "RebaseUpdateRefs"
+    },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "RemoveFromContributionBranches"
+    },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "RemoveFromObservedBranches"
+    },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "RemoveFromParkedBranches"
+    },
     {
       "data": {
         "Branch": "branch"
       },
       "type": "RemoveFromPerennialBranches"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "RemoveFromPrototypeBranches"
+    },
     {
       "data": {
         "Key": "git-town.offline"
@@ -427,7 +515,9 @@ func TestLoadSave(t *testing.T) {
     {
       "data": {
         "Branch": "branch",
+        "CoAuthors": true,
         "CommitMessage": "commit message",
+        "DefaultCommitMessage": "default commit message",
         "Parent": "parent"
       },
       "type": "SquashMerge"
@@ -178,6 +178,14 @@ func (self *TestCommands) CreateParkedBranches(names ...gitdomain.LocalBranchNam
 	asserts.NoError(self.Config.AddToParkedBranches(names...))
 }
 
+// CreatePrototypeBranches creates prototype branches with the given names in this repository.
+func (self *TestCommands) CreatePrototypeBranches(names ...gitdomain.LocalBranchName) {
+	for _, name := range names {
+		self.CreateFeatureBranch(name)
+	}
+	asserts.NoError(self.Config.AddToPrototypeBranches(names...))
+}
+
 // CreatePerennialBranches creates perennial branches with the given names in this repository.
 func (self *TestCommands) CreatePerennialBranches(names ...gitdomain.LocalBranchName) {
 	main := gitdomain.NewLocalBranchName("main")
@@ -300,6 +308,11 @@ func (self *TestCommands) HasFile(name, content string) string {
 	return ""
 }
 
+// LastCommitMessage provides the full message of the latest commit in the given branch.
+func (self *TestCommands) LastCommitMessage(branch gitdomain.LocalBranchName) string {
+	return strings.TrimSpace(self.MustQuery("git", "log", "-1", "--format=%B", branch.String()))
+}
+
 // LineageTable provides the currently configured lineage information as a DataTable.
 func (self *TestCommands) LineageTable() datatable.DataTable {
 	result := datatable.DataTable{}
@@ -350,6 +363,12 @@ func (self *TestCommands) MergeBranch(branch gitdomain.LocalBranchName) error {
 	return self.Run("git", "merge", branch.String())
 }
 
+// SquashMergeBranch squash-merges the given branch into the current branch, similar to the "squash and merge" button in web UIs.
+func (self *TestCommands) SquashMergeBranch(branch gitdomain.LocalBranchName) {
+	self.MustRun("git", "merge", "--squash", branch.String())
+	self.MustRun("git", "commit", "-m", "squashed "+branch.String())
+}
+
 func (self *TestCommands) PushBranch() {
 	self.MustRun("git", "push")
 }
@@ -90,6 +90,15 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^a branch "([^"]*)" with a tracking branch$`, func(branchText string) error {
+		branch := gitdomain.NewLocalBranchName(branchText)
+		state.fixture.DevRepo.CreateBranch(branch, gitdomain.NewLocalBranchName("main"))
+		state.initialLocalBranches = append(state.initialLocalBranches, branch)
+		state.fixture.DevRepo.PushBranchToRemote(branch, gitdomain.OriginRemote)
+		state.initialRemoteBranches = append(state.initialRemoteBranches, branch)
+		return nil
+	})
+
 	suite.Step(`^a coworker clones the repository$`, func() error {
 		state.fixture.AddCoworkerRepo()
 		return nil
@@ -145,6 +154,11 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^a file "([^"]+)" with content:$`, func(name string, content *messages.PickleStepArgument_PickleDocString) error {
+		state.fixture.DevRepo.CreateFile(name, content.Content)
+		return nil
+	})
+
 	suite.Step(`^a perennial branch "([^"]+)"$`, func(branchText string) error {
 		branch := gitdomain.NewLocalBranchName(branchText)
 		state.fixture.DevRepo.CreatePerennialBranches(branch)
@@ -252,6 +266,37 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^branch "([^"]+)" has the sync strategy "([^"]+)"$`, func(name, strategy string) error {
+		return state.fixture.DevRepo.Config.GitConfig.SetLocalConfigValue(gitconfig.NewSyncStrategyKey(gitdomain.NewLocalBranchName(name)), strategy)
+	})
+
+	suite.Step(`^branch "([^"]+)" (?:now|still) has no sync strategy$`, func(name string) error {
+		have := state.fixture.DevRepo.TestCommands.LocalGitConfig(gitconfig.NewSyncStrategyKey(gitdomain.NewLocalBranchName(name)))
+		if have != nil {
+			return fmt.Errorf("expected branch %q to have no sync strategy but it has %q", name, *have)
+		}
+		return nil
+	})
+
+	suite.Step(`^branch "([^"]+)" (?:now|still) has the sync strategy "([^"]+)"$`, func(name, want string) error {
+		have := state.fixture.DevRepo.TestCommands.LocalGitConfig(gitconfig.NewSyncStrategyKey(gitdomain.NewLocalBranchName(name)))
+		if have == nil {
+			return fmt.Errorf("expected branch %q to have the sync strategy %q but it has none", name, want)
+		}
+		if *have != want {
+			return fmt.Errorf("expected branch %q to have the sync strategy %q but it has %q", name, want, *have)
+		}
+		return nil
+	})
+
+	suite.Step(`^a prototype branch "([^"]+)"$`, func(branchText string) error {
+		branch := gitdomain.NewLocalBranchName(branchText)
+		state.fixture.DevRepo.CreatePrototypeBranches(branch)
+		state.initialLocalBranches = append(state.initialLocalBranches, branch)
+		state.initialLineage.AddRow(branchText, "main")
+		return nil
+	})
+
 	suite.Step(`^branch "([^"]+)" is now parked`, func(name string) error {
 		branch := gitdomain.NewLocalBranchName(name)
 		if !state.fixture.DevRepo.Config.FullConfig.IsParkedBranch(branch) {
@@ -264,6 +309,18 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^branch "([^"]+)" is (?:now|still) a prototype branch$`, func(name string) error {
+		branch := gitdomain.NewLocalBranchName(name)
+		if !state.fixture.DevRepo.Config.FullConfig.IsPrototypeBranch(branch) {
+			return fmt.Errorf(
+				"branch %q isn't a prototype branch as expected.\nPrototype branches: %s",
+				branch,
+				strings.Join(state.fixture.DevRepo.Config.FullConfig.PrototypeBranches.Strings(), ", "),
+			)
+		}
+		return nil
+	})
+
 	suite.Step(`^branch "([^"]+)" is (?:now|still) perennial`, func(name string) error {
 		branch := gitdomain.NewLocalBranchName(name)
 		if !state.fixture.DevRepo.Config.FullConfig.IsPerennialBranch(branch) {
@@ -290,6 +347,9 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		if state.fixture.DevRepo.Config.FullConfig.IsPerennialBranch(branch) {
 			return fmt.Errorf("branch %q is perennial", branch)
 		}
+		if state.fixture.DevRepo.Config.FullConfig.IsPrototypeBranch(branch) {
+			return fmt.Errorf("branch %q is a prototype branch", branch)
+		}
 		return nil
 	})
 
@@ -346,11 +406,23 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return state.fixture.DevRepo.Config.GitConfig.SetLocalConfigValue(configKey, value)
 	})
 
+	suite.Step(`^local Git setting "branch\.([^.]+)\.pushRemote" is "([^"]*)"$`, func(branch, value string) error {
+		return state.fixture.DevRepo.Config.GitConfig.SetLocalConfigValue(gitconfig.NewPushRemoteKey(gitdomain.NewLocalBranchName(branch)), value)
+	})
+
 	suite.Step(`^local Git setting "init.defaultbranch" is "([^"]*)"$`, func(value string) error {
 		state.fixture.DevRepo.SetDefaultGitBranch(gitdomain.NewLocalBranchName(value))
 		return nil
 	})
 
+	suite.Step(`^local Git setting "remote.pushDefault" is "([^"]*)"$`, func(value string) error {
+		return state.fixture.DevRepo.Config.GitConfig.SetLocalConfigValue(gitconfig.KeyGitPushDefault, value)
+	})
+
+	suite.Step(`^local Git setting "remote.upstream.url" is "([^"]*)"$`, func(value string) error {
+		return state.fixture.DevRepo.Config.GitConfig.SetLocalConfigValue(gitconfig.KeyGitUpstreamURL, value)
+	})
+
 	suite.Step(`^global Git setting "alias\.(.*?)" is "([^"]*)"$`, func(name, value string) error {
 		key := gitconfig.ParseKey("alias." + name)
 		if key == nil {
@@ -622,6 +694,17 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^I run "([^"]+)" with these environment variables:$`, func(cmd string, table *messages.PickleStepArgument_PickleTable) error {
+		updateInitialSHAs(state)
+		env := os.Environ()
+		for _, row := range table.Rows[1:] {
+			env = append(env, row.Cells[0].Value+"="+row.Cells[1].Value)
+		}
+		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCodeWith(cmd, &subshell.Options{Env: env})
+		state.fixture.DevRepo.Config.Reload()
+		return nil
+	})
+
 	suite.Step(`^I run "([^"]+)" in the "([^"]+)" folder$`, func(cmd, folderName string) error {
 		updateInitialSHAs(state)
 		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCodeWith(cmd, &subshell.Options{Dir: folderName})
@@ -876,7 +959,7 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return fmt.Errorf(`unexpected local setting "push-hook" %v`, have)
 	})
 
-	suite.Step(`^local Git Town setting "push-hook" is now "([^"]*)"$`, func(wantStr string) error {
+	suite.Step(`^local Git Town setting "push-hook" is (?:now|still) "([^"]*)"$`, func(wantStr string) error {
 		have := state.fixture.DevRepo.Config.LocalGitConfig.PushHook
 		wantBool, err := strconv.ParseBool(wantStr)
 		asserts.NoError(err)
@@ -1014,7 +1097,13 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 
 	suite.Step(`^my repo's "([^"]*)" remote is "([^"]*)"$`, func(remoteName, remoteURL string) error {
 		remote := gitdomain.Remote(remoteName)
-		state.fixture.DevRepo.RemoveRemote(remote)
+		remotes, err := state.fixture.DevRepo.Remotes()
+		if err != nil {
+			return err
+		}
+		if slice.Contains(remotes, remote) {
+			state.fixture.DevRepo.RemoveRemote(remote)
+		}
 		state.fixture.DevRepo.AddRemote(remote, remoteURL)
 		return nil
 	})
@@ -1095,6 +1184,12 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^origin squash-merges the "([^"]*)" branch$`, func(branch string) error {
+		state.fixture.OriginRepo.CheckoutBranch(gitdomain.NewLocalBranchName("main"))
+		state.fixture.OriginRepo.SquashMergeBranch(gitdomain.NewLocalBranchName(branch))
+		return nil
+	})
+
 	suite.Step(`^the branches "([^"]+)" and "([^"]+)"$`, func(branch1, branch2 string) error {
 		for _, branchName := range []string{branch1, branch2} {
 			branch := gitdomain.NewLocalBranchName(branchName)
@@ -1138,6 +1233,23 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^the user configuration file:$`, func(content *messages.PickleStepArgument_PickleDocString) error {
+		filePath := filepath.Join(state.fixture.DevRepo.HomeDir, ".config", "git-town", "config.toml")
+		asserts.NoError(os.MkdirAll(filepath.Dir(filePath), 0o700))
+		return os.WriteFile(filePath, []byte(content.Content), 0o600)
+	})
+
+	suite.Step(`^the configuration file now contains:$`, func(content *messages.PickleStepArgument_PickleDocString) error {
+		have, err := state.fixture.DevRepo.FileContentErr(configfile.FileName)
+		if err != nil {
+			return errors.New("no configuration file found")
+		}
+		if !strings.Contains(have, content.Content) {
+			return fmt.Errorf("configuration file doesn't contain:\n%s\n\nactual content:\n%s", content.Content, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^the configuration file is (?:now|still):$`, func(content *messages.PickleStepArgument_PickleDocString) error {
 		have, err := state.fixture.DevRepo.FileContentErr(configfile.FileName)
 		if err != nil {
@@ -1203,7 +1315,7 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
-	suite.Step(`^the current branch is an? (local )?(feature|perennial|parked|contribution|observed) branch "([^"]*)"$`, func(localStr, branchType, branchName string) error {
+	suite.Step(`^the current branch is an? (local )?(feature|perennial|parked|prototype|contribution|observed) branch "([^"]*)"$`, func(localStr, branchType, branchName string) error {
 		branch := gitdomain.NewLocalBranchName(branchName)
 		isLocal := localStr != ""
 		switch branchType {
@@ -1215,6 +1327,9 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		case "parked":
 			state.fixture.DevRepo.CreateParkedBranches(branch)
 			state.initialLineage.AddRow(branchName, "main")
+		case "prototype":
+			state.fixture.DevRepo.CreatePrototypeBranches(branch)
+			state.initialLineage.AddRow(branchName, "main")
 		case "contribution":
 			state.fixture.DevRepo.CreateContributionBranches(branch)
 		case "observed":
@@ -1310,6 +1425,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return state.compareTable(state.initialCommits)
 	})
 
+	suite.Step(`^the last commit on the "([^"]+)" branch now has the message:$`, func(branchName string, want *messages.PickleStepArgument_PickleDocString) error {
+		have := state.fixture.DevRepo.LastCommitMessage(gitdomain.NewLocalBranchName(branchName))
+		if have != want.Content {
+			return fmt.Errorf("expected commit message:\n%s\n\nbut found:\n%s", want.Content, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^the (local )?feature branches "([^"]+)" and "([^"]+)"$`, func(localStr, branch1, branch2 string) error {
 		isLocal := localStr != ""
 		for _, branchText := range []string{branch1, branch2} {
@@ -1459,6 +1582,10 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^the prototype branches "([^"]+)" and "([^"]+)"$`, func(branch1, branch2 string) error {
+		return state.fixture.DevRepo.Config.SetPrototypeBranches(gitdomain.NewLocalBranchNames(branch1, branch2))
+	})
+
 	suite.Step(`^there are (?:now|still) no contribution branches$`, func() error {
 		branches := state.fixture.DevRepo.Config.LocalGitConfig.ContributionBranches
 		if branches != nil && len(*branches) > 0 {
@@ -1483,6 +1610,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^there are (?:now|still) no prototype branches$`, func() error {
+		branches := state.fixture.DevRepo.Config.LocalGitConfig.PrototypeBranches
+		if branches != nil && len(*branches) > 0 {
+			return fmt.Errorf("expected no prototype branches, got %q", branches)
+		}
+		return nil
+	})
+
 	suite.Step(`^there are (?:now|still) no perennial branches$`, func() error {
 		branches := state.fixture.DevRepo.Config.LocalGitConfig.PerennialBranches
 		if branches != nil && len(*branches) > 0 {
@@ -193,6 +193,8 @@ func (self *TestRunner) QueryWithCode(opts *Options, cmd string, args ...string)
 	}
 	// set HOME to the given global directory so that Git puts the global configuration there.
 	opts.Env = envvars.Replace(opts.Env, "HOME", self.HomeDir)
+	// place the user-level Git Town configuration file into the global directory as well
+	opts.Env = envvars.Replace(opts.Env, "XDG_CONFIG_HOME", filepath.Join(self.HomeDir, ".config"))
 	// add the custom origin
 	if self.testOrigin != "" {
 		opts.Env = envvars.Replace(opts.Env, "GIT_TOWN_REMOTE", self.testOrigin)
@@ -78,11 +78,13 @@ func New(workingDir, homeDir, binDir string) TestRuntime {
 		BinDir:     binDir,
 	}
 	config, err := config.NewConfig(config.NewConfigArgs{
-		ConfigFile:   nil,
-		DryRun:       false,
-		GlobalConfig: configdomain.EmptyPartialConfig(),
-		LocalConfig:  configdomain.EmptyPartialConfig(),
-		Runner:       &runner,
+		ConfigFile:     nil,
+		DryRun:         false,
+		EnvConfig:      configdomain.EmptyPartialConfig(),
+		GlobalConfig:   configdomain.EmptyPartialConfig(),
+		LocalConfig:    configdomain.EmptyPartialConfig(),
+		Runner:         &runner,
+		UserConfigFile: nil,
 	})
 	if err != nil {
 		panic(err)